@@ -8,4 +8,6 @@ const (
 	// IndieAuth https://indieauth.spec.indieweb.org/.
 	IndieAuth Type = "indieauth"
 	Fediverse Type = "fediverse"
+	// Nostr https://github.com/nostr-protocol/nips/blob/master/42.md.
+	Nostr Type = "nostr"
 )
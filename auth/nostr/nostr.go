@@ -0,0 +1,110 @@
+package nostr
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	nostrlib "github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ChallengeRegistration represents a single pending login challenge.
+type ChallengeRegistration struct {
+	Timestamp       time.Time
+	UserID          string
+	UserDisplayName string
+	Challenge       string
+}
+
+// Key by access token to limit one challenge for a person
+// to be active at a time.
+var (
+	pendingAuthRequests = make(map[string]ChallengeRegistration)
+	lock                = sync.Mutex{}
+)
+
+const (
+	registrationTimeout = time.Minute * 10
+	maxPendingRequests  = 1000
+)
+
+func init() {
+	go setupExpiredRequestPruner()
+}
+
+// Clear out any pending requests that have been pending for greater than
+// the specified timeout value.
+func setupExpiredRequestPruner() {
+	pruneExpiredRequestsTimer := time.NewTicker(registrationTimeout)
+
+	for range pruneExpiredRequestsTimer.C {
+		lock.Lock()
+		log.Debugln("Pruning expired Nostr auth challenges.")
+		for k, v := range pendingAuthRequests {
+			if time.Since(v.Timestamp) > registrationTimeout {
+				delete(pendingAuthRequests, k)
+			}
+		}
+		lock.Unlock()
+	}
+}
+
+// RegisterChallenge will start the challenge flow for a user, creating a new
+// challenge to be signed by the client's Nostr key.
+func RegisterChallenge(accessToken, userID, userDisplayName string) (ChallengeRegistration, error) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	// If a request is already registered and has not expired then return that
+	// existing request.
+	if request, exists := pendingAuthRequests[accessToken]; exists && time.Since(request.Timestamp) < registrationTimeout {
+		return request, nil
+	}
+
+	if len(pendingAuthRequests)+1 > maxPendingRequests {
+		return ChallengeRegistration{}, errors.New("please try again later, too many pending requests")
+	}
+
+	challenge, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return ChallengeRegistration{}, err
+	}
+
+	r := ChallengeRegistration{
+		Challenge:       challenge,
+		UserID:          userID,
+		UserDisplayName: userDisplayName,
+		Timestamp:       time.Now(),
+	}
+	pendingAuthRequests[accessToken] = r
+
+	return r, nil
+}
+
+// ValidateChallenge will verify a signed auth event for a pending challenge
+// and return the registration along with the authenticated pubkey. The
+// event must name one of the server URLs as its relay, so an event signed
+// for another relay that passed our challenge on is not accepted.
+func ValidateChallenge(accessToken string, event *nostrlib.Event, serverURLs []string) (*ChallengeRegistration, string, error) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	request, ok := pendingAuthRequests[accessToken]
+	if !ok || time.Since(request.Timestamp) > registrationTimeout {
+		return nil, "", errors.New("no pending challenge for this user")
+	}
+
+	if event == nil {
+		return nil, "", errors.New("auth event is missing")
+	}
+
+	if err := nostrlib.VerifyClientAuth(event, request.Challenge, serverURLs); err != nil {
+		return nil, "", err
+	}
+
+	delete(pendingAuthRequests, accessToken)
+	return &request, strings.ToLower(event.PubKey), nil
+}
@@ -0,0 +1,90 @@
+package nostr
+
+import (
+	"testing"
+	"time"
+
+	nostrlib "github.com/TekkadanPlays/oni/nostr"
+)
+
+const (
+	accessToken     = "fake-access-token"
+	userID          = "fake-user-id"
+	userDisplayName = "fake-user-display-name"
+	serverURL       = "https://example.com"
+)
+
+func signedChallenge(t *testing.T, privateKey string, kind int, challenge string) *nostrlib.Event {
+	t.Helper()
+
+	event := &nostrlib.Event{
+		Kind:      kind,
+		CreatedAt: time.Now().Unix(),
+		Tags:      nostrlib.Tags{{"challenge", challenge}, {"relay", serverURL}},
+	}
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	return event
+}
+
+func TestChallengeFlowValidation(t *testing.T) {
+	privateKey, _ := nostrlib.GeneratePrivateKey()
+	pubkey, _ := nostrlib.GetPublicKey(privateKey)
+
+	r, err := RegisterChallenge(accessToken, userID, userDisplayName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Challenge == "" {
+		t.Error("Challenge is empty")
+	}
+
+	again, _ := RegisterChallenge(accessToken, userID, userDisplayName)
+	if again.Challenge != r.Challenge {
+		t.Error("Only one pending challenge should exist per access token.")
+	}
+
+	if _, _, err := ValidateChallenge(accessToken, signedChallenge(t, privateKey, 1, r.Challenge), []string{serverURL}); err == nil {
+		t.Error("Wrong event kind should be rejected.")
+	}
+
+	if _, _, err := ValidateChallenge(accessToken, signedChallenge(t, privateKey, nostrlib.KindClientAuth, "wrong"), []string{serverURL}); err == nil {
+		t.Error("Wrong challenge should be rejected.")
+	}
+
+	forged := signedChallenge(t, privateKey, nostrlib.KindClientAuth, r.Challenge)
+	otherKey, _ := nostrlib.GeneratePrivateKey()
+	forged.PubKey, _ = nostrlib.GetPublicKey(otherKey)
+	if _, _, err := ValidateChallenge(accessToken, forged, []string{serverURL}); err == nil {
+		t.Error("Forged event should be rejected.")
+	}
+
+	otherRelay := signedChallenge(t, privateKey, nostrlib.KindClientAuth, r.Challenge)
+	otherRelay.Tags = nostrlib.Tags{{"challenge", r.Challenge}, {"relay", "wss://relay.example.org"}}
+	if err := otherRelay.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := ValidateChallenge(accessToken, otherRelay, []string{serverURL}); err == nil {
+		t.Error("An event signed for another relay should be rejected.")
+	}
+
+	registration, authedPubkey, err := ValidateChallenge(accessToken, signedChallenge(t, privateKey, nostrlib.KindClientAuth, r.Challenge), []string{serverURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if authedPubkey != pubkey {
+		t.Errorf("pubkey = %s, want %s", authedPubkey, pubkey)
+	}
+
+	if registration.UserID != userID || registration.UserDisplayName != userDisplayName {
+		t.Error("Registration user is not set correctly")
+	}
+
+	if _, _, err := ValidateChallenge(accessToken, signedChallenge(t, privateKey, nostrlib.KindClientAuth, r.Challenge), []string{serverURL}); err == nil {
+		t.Error("A challenge should only be usable once.")
+	}
+}
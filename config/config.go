@@ -43,6 +43,18 @@ var EnableAutoUpdate = false
 // A temporary stream key that can be set via the command line.
 var TemporaryStreamKey = ""
 
+// AdminSetupToken is the one-time token required to claim the first admin Nostr pubkey.
+// It is empty once an admin pubkey has been configured.
+var AdminSetupToken = ""
+
+// NostrKeyPassphrase is used to encrypt the server's Nostr private key at rest.
+// When empty the secret in NostrKeyFile is used instead.
+var NostrKeyPassphrase = ""
+
+// NostrKeyFile holds the secret used to encrypt the server's Nostr private key
+// when no passphrase is given. It is created if it does not exist.
+var NostrKeyFile = "data/nostr.secret"
+
 // GetCommit will return an identifier used for identifying the point in time this build took place.
 func GetCommit() string {
 	if GitCommit == "" {
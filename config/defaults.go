@@ -32,8 +32,13 @@ type Defaults struct {
 
 	Tags               []string
 	RTMPServerPort     int
+	RTMPSServerPort    int
+	SRTServerPort      int
+	WebRTCServerPort   int
 	SegmentsInPlaylist int
 
+	PublishCallbackCacheSeconds int
+
 	SegmentLengthSeconds int
 	WebServerPort        int
 
@@ -78,9 +83,14 @@ func GetDefaults() Defaults {
 		YPEnabled: false,
 		YPServer:  "https://owncast.directory",
 
-		WebServerPort:  8080,
-		WebServerIP:    "0.0.0.0",
-		RTMPServerPort: 1935,
+		WebServerPort:    8080,
+		WebServerIP:      "0.0.0.0",
+		RTMPServerPort:   1935,
+		RTMPSServerPort:  1936,
+		SRTServerPort:    9000,
+		WebRTCServerPort: 8189,
+
+		PublishCallbackCacheSeconds: 10,
 
 		ChatEstablishedUserModeTimeDuration: time.Minute * 15,
 
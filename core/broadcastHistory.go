@@ -0,0 +1,49 @@
+package core
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/broadcastrepository"
+)
+
+// The broadcast history entry of the current broadcast.
+var _broadcastHistoryID string
+
+// recordBroadcastStarted will record which stream key started the broadcast.
+func recordBroadcastStarted() {
+	streamKey, remoteAddr := ingest.StreamKey()
+	label, hint := ingest.DescribeStreamKey(streamKey)
+
+	entry := &models.BroadcastHistoryEntry{
+		ID:             shortid.MustGenerate(),
+		StreamKeyLabel: label,
+		StreamKeyHint:  hint,
+		Protocol:       ingest.Current(),
+		RemoteAddr:     remoteAddr,
+		StartedAt:      time.Now(),
+	}
+
+	if err := broadcastrepository.Get().AddBroadcast(entry); err != nil {
+		log.Errorln("unable to record the broadcast in the history", err)
+		return
+	}
+
+	_broadcastHistoryID = entry.ID
+}
+
+// recordBroadcastEnded will record when the current broadcast ended.
+func recordBroadcastEnded() {
+	if _broadcastHistoryID == "" {
+		return
+	}
+
+	if err := broadcastrepository.Get().EndBroadcast(_broadcastHistoryID, time.Now()); err != nil {
+		log.Errorln("unable to record the end of the broadcast in the history", err)
+	}
+	_broadcastHistoryID = ""
+}
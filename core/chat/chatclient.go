@@ -81,7 +81,8 @@ func (c *Client) sendConnectedClientInfo() {
 		Event: events.Event{
 			Type: events.ConnectedUserInfo,
 		},
-		User: c.User,
+		User:                 c.User,
+		SuggestedDisplayName: suggestedDisplayName(c.User),
 	}
 
 	payload.SetDefaults()
@@ -176,6 +176,12 @@ func (s *Server) userMessageSent(eventData chatClientEvent) {
 		return
 	}
 
+	// Drop messages with words from the admin's Nostr mute list and remove the sender.
+	if word, muted := findNostrMutedWord(event.Body); muted && !event.User.IsModerator() {
+		go muteUserForWord(event.User.ID, word)
+		return
+	}
+
 	payload := event.GetBroadcastPayload()
 	if err := s.Broadcast(payload); err != nil {
 		log.Errorln("error broadcasting UserMessageEvent payload", err)
@@ -188,6 +194,8 @@ func (s *Server) userMessageSent(eventData chatClientEvent) {
 	chatMessageRepository := chatmessagerepository.Get()
 	chatMessageRepository.SaveUserMessage(event)
 	eventData.client.MessageCount++
+
+	publishChatMessageToNostr(&event)
 }
 
 func logSanitize(userValue string) string {
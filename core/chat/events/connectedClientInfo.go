@@ -5,5 +5,8 @@ import "github.com/TekkadanPlays/oni/models"
 // ConnectedClientInfo represents the information about a connected client.
 type ConnectedClientInfo struct {
 	User *models.User `json:"user"`
+	// SuggestedDisplayName is the name from the user's Nostr profile, offered
+	// when it differs from their display name.
+	SuggestedDisplayName string `json:"suggestedDisplayName,omitempty"`
 	Event
 }
@@ -46,4 +46,8 @@ const (
 	FediverseEngagementLike EventType = "FEDIVERSE_ENGAGEMENT_LIKE"
 	// FediverseEngagementRepost is an event representing a re-post action that took place on the fediverse.
 	FediverseEngagementRepost EventType = "FEDIVERSE_ENGAGEMENT_REPOST"
+	// NostrMessageSent is a chat message sent from a Nostr client to the stream's live activity.
+	NostrMessageSent EventType = "NOSTR_CHAT"
+	// ZapReceived is a NIP-57 Lightning zap sent to the stream's live activity.
+	ZapReceived EventType = "ZAP"
 )
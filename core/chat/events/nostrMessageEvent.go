@@ -0,0 +1,51 @@
+package events
+
+import (
+	"github.com/TekkadanPlays/oni/config"
+)
+
+// NostrMessageEvent is a chat message received from a Nostr client as a
+// NIP-53 live chat message.
+type NostrMessageEvent struct {
+	Event
+	MessageEvent
+	Image        *string `json:"image,omitempty"`
+	Pubkey       string  `json:"pubkey"`
+	Npub         string  `json:"npub"`
+	DisplayName  string  `json:"displayName"`
+	NostrEventID string  `json:"nostrEventId"`
+}
+
+// GetBroadcastPayload will return the object to send to all chat users.
+func (e *NostrMessageEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"id":           e.ID,
+		"timestamp":    e.Timestamp,
+		"body":         e.Body,
+		"type":         NostrMessageSent,
+		"visible":      true,
+		"nostrEventId": e.NostrEventID,
+		"user": EventPayload{
+			"id":           "nostr:" + e.Pubkey,
+			"displayName":  e.DisplayName,
+			"displayColor": e.DisplayColor(),
+			"image":        e.Image,
+			"pubkey":       e.Pubkey,
+			"npub":         e.Npub,
+		},
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *NostrMessageEvent) GetMessageType() EventType {
+	return NostrMessageSent
+}
+
+// DisplayColor returns a stable chat color for the author's pubkey.
+func (e *NostrMessageEvent) DisplayColor() int {
+	sum := 0
+	for _, c := range e.Pubkey {
+		sum += int(c)
+	}
+	return sum % (config.MaxUserColor + 1)
+}
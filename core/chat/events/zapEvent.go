@@ -0,0 +1,43 @@
+package events
+
+import (
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+// ZapEvent is a message displayed in chat when someone zaps the stream with
+// a NIP-57 Lightning zap.
+type ZapEvent struct {
+	Event
+	MessageEvent
+	Image      *string `json:"image"`
+	SenderName string  `json:"title"`
+	Pubkey     string  `json:"pubkey"`
+	Npub       string  `json:"npub"`
+	AmountMsat int64   `json:"amountMsat"`
+}
+
+// GetBroadcastPayload will return the object to send to all chat users.
+func (e *ZapEvent) GetBroadcastPayload() EventPayload {
+	configRepository := configrepository.Get()
+
+	return EventPayload{
+		"id":         e.ID,
+		"timestamp":  e.Timestamp,
+		"body":       e.Body,
+		"image":      e.Image,
+		"type":       ZapReceived,
+		"title":      e.SenderName,
+		"amount":     e.AmountMsat / 1000,
+		"amountMsat": e.AmountMsat,
+		"pubkey":     e.Pubkey,
+		"npub":       e.Npub,
+		"user": EventPayload{
+			"displayName": configRepository.GetServerName(),
+		},
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *ZapEvent) GetMessageType() EventType {
+	return ZapReceived
+}
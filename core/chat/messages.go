@@ -33,5 +33,10 @@ func SetMessagesVisibility(messageIDs []string, visibility bool) error {
 
 	webhooks.SendChatEventSetMessageVisibility(event)
 
+	// Messages bridged to Nostr can't be hidden there, only deleted.
+	if !visibility {
+		go deleteChatMessagesFromNostr(messageIDs)
+	}
+
 	return nil
 }
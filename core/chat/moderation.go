@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"fmt"
+
+	"github.com/TekkadanPlays/oni/persistence/authrepository"
+	"github.com/TekkadanPlays/oni/persistence/chatmessagerepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetUserEnabled will enable or disable a chat user. Disabling a user also
+// hides the messages they have sent.
+func SetUserEnabled(userID string, enabled bool) error {
+	userRepository := userrepository.Get()
+	chatMessageRepository := chatmessagerepository.Get()
+
+	if err := userRepository.SetEnabled(userID, enabled); err != nil {
+		log.Errorln("error changing user enabled status", err)
+		return err
+	}
+
+	messageIDs, err := chatMessageRepository.GetMessageIdsForUserID(userID)
+	if err != nil {
+		return errors.Wrap(err, "error fetching user messages")
+	}
+
+	if !enabled && len(messageIDs) > 0 {
+		if err := SetMessagesVisibility(messageIDs, enabled); err != nil {
+			log.Errorln("error changing user messages visibility", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveDisabledUser will disconnect a disabled user from chat and ban the
+// IP addresses they were connected from, returning the banned addresses.
+func RemoveDisabledUser(userID string) ([]string, error) {
+	clients, err := GetClientsForUser(userID)
+	if len(clients) == 0 {
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Errorln("error fetching clients for user: ", err)
+		return nil, err
+	}
+
+	DisconnectClients(clients)
+	userRepository := userrepository.Get()
+	disconnectedUser := userRepository.GetUserByID(userID)
+	_ = SendSystemAction(fmt.Sprintf("**%s** has been removed from chat.", disconnectedUser.DisplayName), true)
+
+	localIP4Address := "127.0.0.1"
+	localIP6Address := "::1"
+
+	banned := []string{}
+	authRepository := authrepository.Get()
+	for _, client := range clients {
+		ipAddress := client.IPAddress
+		if ipAddress != localIP4Address && ipAddress != localIP6Address {
+			reason := fmt.Sprintf("Banning of %s", disconnectedUser.DisplayName)
+			if err := authRepository.BanIPAddress(ipAddress, reason); err != nil {
+				log.Errorln("error banning IP address: ", err)
+				continue
+			}
+			banned = append(banned, ipAddress)
+		}
+	}
+	return banned, nil
+}
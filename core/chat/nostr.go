@@ -0,0 +1,326 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/chat/events"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/live"
+	"github.com/TekkadanPlays/oni/nostr/profiles"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/chatmessagerepository"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How long relays have to acknowledge a bridged chat message.
+	nostrPublishTimeout = 10 * time.Second
+
+	// How long to wait for an author's profile before showing their npub instead.
+	nostrProfileTimeout = 3 * time.Second
+
+	// The most Nostr authors watched for deletion requests during a stream.
+	maxWatchedNostrAuthors = 500
+)
+
+// nostrBridge relays NIP-53 live chat messages and NIP-57 zaps for the
+// current stream's live activity into chat.
+type nostrBridge struct {
+	messages  *relay.Subscription
+	zaps      *relay.Subscription
+	deletions *relay.Subscription
+	authors   map[string]struct{}
+	address   string
+	since     int64
+	lock      sync.Mutex
+}
+
+var (
+	_nostrBridge     *nostrBridge
+	_nostrBridgeLock sync.Mutex
+)
+
+// StartNostrBridge will start relaying chat messages and zaps sent from
+// Nostr clients to the live activity of the current stream. Does nothing if
+// there is no live activity.
+func StartNostrBridge() {
+	address := live.ActivityAddress()
+	if address == "" {
+		return
+	}
+
+	_nostrBridgeLock.Lock()
+	defer _nostrBridgeLock.Unlock()
+
+	if _nostrBridge != nil {
+		_nostrBridge.stop()
+	}
+
+	b := &nostrBridge{
+		address: address,
+		since:   time.Now().Unix(),
+		authors: map[string]struct{}{},
+	}
+	b.messages = relay.Get().Subscribe(nostr.Filter{
+		Kinds: []int{nostr.KindLiveChatMessage},
+		Tags:  map[string][]string{"a": {address}},
+		Since: b.since,
+	})
+	go b.consume(b.messages, b.handleChatMessage)
+
+	b.zaps = relay.Get().Subscribe(nostr.Filter{
+		Kinds: []int{nostr.KindZapReceipt},
+		Tags:  map[string][]string{"a": {address}},
+		Since: b.since,
+	})
+	go b.consume(b.zaps, b.handleZapReceipt)
+
+	_nostrBridge = b
+}
+
+// StopNostrBridge will stop relaying chat messages from Nostr clients.
+func StopNostrBridge() {
+	_nostrBridgeLock.Lock()
+	defer _nostrBridgeLock.Unlock()
+
+	if _nostrBridge != nil {
+		_nostrBridge.stop()
+		_nostrBridge = nil
+	}
+}
+
+func (b *nostrBridge) stop() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.messages.Close()
+	b.zaps.Close()
+	if b.deletions != nil {
+		b.deletions.Close()
+	}
+}
+
+func (b *nostrBridge) consume(sub *relay.Subscription, handler func(*nostr.Event)) {
+	for event := range sub.Events {
+		handler(event)
+	}
+}
+
+func (b *nostrBridge) handleChatMessage(event *nostr.Event) {
+	if event.PubKey == identity.PublicKey() {
+		// Our own bridged messages coming back to us.
+		return
+	}
+
+	if IsNostrPubkeyBanned(event.PubKey) {
+		return
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	if chatMessageRepository.GetMessageIDForNostrEvent(event.ID, event.PubKey) != "" {
+		return
+	}
+
+	message := events.NostrMessageEvent{
+		MessageEvent: events.MessageEvent{
+			Body: event.Content,
+		},
+		Pubkey:       event.PubKey,
+		NostrEventID: event.ID,
+	}
+	message.SetDefaults()
+	message.RenderAndSanitizeMessageBody()
+	if message.Empty() {
+		return
+	}
+
+	if _, muted := findNostrMutedWord(event.Content); muted {
+		return
+	}
+
+	message.DisplayName, message.Npub, message.Image = lookupNostrAuthor(event.PubKey)
+
+	if err := Broadcast(&message); err != nil {
+		log.Errorln("error broadcasting Nostr chat message", err)
+		return
+	}
+
+	chatMessageRepository.SaveNostrMessage(message)
+	b.watchAuthor(event.PubKey)
+}
+
+// lookupNostrAuthor returns the name and picture to show in chat for a
+// Nostr pubkey, falling back to the start of its npub when no profile can
+// be found in time.
+func lookupNostrAuthor(pubkey string) (name string, npub string, image *string) {
+	npub, _ = nostr.EncodePublicKey(pubkey)
+	name = npub[:min(len(npub), 16)]
+
+	ctx, cancel := context.WithTimeout(context.Background(), nostrProfileTimeout)
+	profile := profiles.Get(ctx, pubkey)
+	cancel()
+	if profile != nil {
+		if profileName := utils.MakeSafeStringOfLength(profile.BestName(), config.MaxChatDisplayNameLength); profileName != "" {
+			name = profileName
+		}
+		if profile.Picture != "" {
+			image = &profile.Picture
+		}
+	}
+
+	return name, npub, image
+}
+
+// watchAuthor subscribes to deletion requests from the author so messages
+// they delete on Nostr are hidden in chat as well.
+func (b *nostrBridge) watchAuthor(pubkey string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, exists := b.authors[pubkey]; exists || len(b.authors) >= maxWatchedNostrAuthors {
+		return
+	}
+	b.authors[pubkey] = struct{}{}
+
+	authors := make([]string, 0, len(b.authors))
+	for author := range b.authors {
+		authors = append(authors, author)
+	}
+
+	if b.deletions != nil {
+		b.deletions.Close()
+	}
+	b.deletions = relay.Get().Subscribe(nostr.Filter{
+		Kinds:   []int{nostr.KindDeletion},
+		Authors: authors,
+		Since:   b.since,
+	})
+	go b.consume(b.deletions, b.handleDeletion)
+}
+
+func (b *nostrBridge) handleDeletion(event *nostr.Event) {
+	chatMessageRepository := chatmessagerepository.Get()
+
+	messageIDs := []string{}
+	for _, eventID := range nostr.DeletedEventIDs(event) {
+		// Only the author can delete their messages.
+		if id := chatMessageRepository.GetMessageIDForNostrEvent(eventID, event.PubKey); id != "" {
+			messageIDs = append(messageIDs, id)
+		}
+	}
+
+	if len(messageIDs) == 0 {
+		return
+	}
+
+	if err := SetMessagesVisibility(messageIDs, false); err != nil {
+		log.Errorln("error hiding messages deleted on Nostr", err)
+	}
+}
+
+// publishChatMessageToNostr will send a chat message to the live activity of
+// the current stream as a NIP-53 live chat message signed by the server.
+func publishChatMessageToNostr(event *events.UserMessageEvent) {
+	address := live.ActivityAddress()
+	if address == "" || len(relay.Get().Relays()) == 0 {
+		return
+	}
+
+	if pubkey := userrepository.Get().GetAuthForUser(event.User.ID, models.Nostr); pubkey != "" && IsNostrPubkeyBanned(pubkey) {
+		return
+	}
+
+	message := nostr.LiveChatMessage(address, fmt.Sprintf("%s: %s", event.User.DisplayName, event.RawBody))
+	if err := identity.Sign(message); err != nil {
+		log.Debugln("Unable to sign Nostr chat message", err)
+		return
+	}
+
+	if err := chatmessagerepository.Get().SetNostrEventID(event.ID, message.ID); err != nil {
+		log.Errorln("error saving Nostr event for chat message", err)
+	}
+
+	relay.Get().PublishInBackground(message, nostrPublishTimeout)
+}
+
+// deleteChatMessagesFromNostr will ask relays to delete the live chat
+// messages the given chat messages were published as.
+func deleteChatMessagesFromNostr(messageIDs []string) {
+	eventIDs, err := chatmessagerepository.Get().GetNostrEventIDsForMessageIDs(messageIDs)
+	if err != nil {
+		log.Errorln("error fetching Nostr events for chat messages", err)
+		return
+	}
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		ids = append(ids, id)
+	}
+
+	deletion := nostr.DeletionRequest(ids, nostr.KindLiveChatMessage, "hidden by a moderator")
+	if err := identity.Sign(deletion); err != nil {
+		log.Debugln("Unable to sign Nostr deletion request", err)
+		return
+	}
+
+	relay.Get().PublishInBackground(deletion, nostrPublishTimeout)
+}
+
+// IsNostrPubkeyBanned will return if chat messages from the Nostr pubkey
+// should be dropped, either because it was banned, is on the admin's mute
+// list or belongs to a chat user who has been disabled.
+func IsNostrPubkeyBanned(pubkey string) bool {
+	if slices.Contains(configrepository.Get().GetNostrBannedPubkeys(), pubkey) || isNostrPubkeyMuted(pubkey) {
+		return true
+	}
+
+	user := userrepository.Get().GetUserByAuth(pubkey, models.Nostr)
+	return user != nil && !user.IsEnabled()
+}
+
+// BanNostrPubkey will drop future chat messages from the Nostr pubkey and
+// hide the ones that were already received.
+func BanNostrPubkey(pubkey string) error {
+	configRepository := configrepository.Get()
+
+	banned := configRepository.GetNostrBannedPubkeys()
+	if !slices.Contains(banned, pubkey) {
+		if err := configRepository.SetNostrBannedPubkeys(append(banned, pubkey)); err != nil {
+			return err
+		}
+	}
+
+	messageIDs, err := chatmessagerepository.Get().GetMessageIDsForNostrPubkey(pubkey)
+	if err != nil {
+		return err
+	}
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	return SetMessagesVisibility(messageIDs, false)
+}
+
+// UnbanNostrPubkey will allow chat messages from the Nostr pubkey again.
+func UnbanNostrPubkey(pubkey string) error {
+	configRepository := configrepository.Get()
+
+	banned := slices.DeleteFunc(configRepository.GetNostrBannedPubkeys(), func(p string) bool {
+		return p == pubkey
+	})
+
+	return configRepository.SetNostrBannedPubkeys(banned)
+}
@@ -0,0 +1,218 @@
+package chat
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/auth"
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/chat/events"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/core/webhooks"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/live"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/relaytest"
+	"github.com/TekkadanPlays/oni/persistence/chatmessagerepository"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-chat-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	auth.Setup(data.GetDatastore())
+	config.NostrKeyFile = filepath.Join(dir, "nostr.secret")
+	if err := identity.Setup(); err != nil {
+		panic(err)
+	}
+	_ = configrepository.Get().SetServerURL("https://oni.example.com")
+
+	getStatus := func() models.Status { return models.Status{Online: true} }
+	webhooks.SetupWebhooks(getStatus)
+	if err := Start(getStatus); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func setupNostrBridgeTest(t *testing.T) *relaytest.Relay {
+	t.Helper()
+
+	fake := relaytest.NewRelay()
+	t.Cleanup(fake.Close)
+	relay.Get().SetRelays([]string{fake.URL()})
+	t.Cleanup(func() { relay.Get().SetRelays(nil) })
+
+	waitUntil(t, "relay connection", func() bool {
+		health := relay.Get().Health()
+		return len(health) == 1 && health[0].Status == relay.StatusConnected
+	})
+
+	live.StreamStarted()
+	t.Cleanup(live.StreamEnded)
+	StartNostrBridge()
+	t.Cleanup(StopNostrBridge)
+
+	return fake
+}
+
+func waitUntil(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func signedNostrEvent(t *testing.T, privateKey string, event *nostr.Event) *nostr.Event {
+	t.Helper()
+
+	if event.CreatedAt == 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+func isMessageHidden(t *testing.T, id string) bool {
+	t.Helper()
+
+	var hiddenAt *time.Time
+	if err := _datastore.DB.QueryRow("SELECT hidden_at FROM messages WHERE id = ?", id).Scan(&hiddenAt); err != nil {
+		t.Fatal(err)
+	}
+	return hiddenAt != nil
+}
+
+func TestNostrBridge(t *testing.T) {
+	fake := setupNostrBridgeTest(t)
+	address := live.ActivityAddress()
+	chatMessageRepository := chatmessagerepository.Get()
+
+	viewerKey, _ := nostr.GeneratePrivateKey()
+	viewer, _ := nostr.GetPublicKey(viewerKey)
+	fake.Store(signedNostrEvent(t, viewerKey, &nostr.Event{
+		Kind:    nostr.KindProfileMetadata,
+		Content: `{"name":"viewer","display_name":"Nostr Viewer"}`,
+	}))
+
+	// Inbound messages are added to chat.
+	inbound := signedNostrEvent(t, viewerKey, nostr.LiveChatMessage(address, "hello from nostr"))
+	fake.Store(inbound)
+
+	var messageID string
+	waitUntil(t, "inbound message", func() bool {
+		messageID = chatMessageRepository.GetMessageIDForNostrEvent(inbound.ID, viewer)
+		return messageID != ""
+	})
+
+	var title string
+	_ = _datastore.DB.QueryRow("SELECT title FROM messages WHERE id = ?", messageID).Scan(&title)
+	if title != "Nostr Viewer" {
+		t.Errorf("author name = %q, want the profile display name", title)
+	}
+
+	// Deleting the message on Nostr hides it in chat.
+	fake.Store(signedNostrEvent(t, viewerKey, nostr.DeletionRequest([]string{inbound.ID}, nostr.KindLiveChatMessage, "")))
+	waitUntil(t, "deleted message to be hidden", func() bool {
+		return isMessageHidden(t, messageID)
+	})
+
+	// Messages from banned pubkeys are dropped.
+	bannedKey, _ := nostr.GeneratePrivateKey()
+	banned, _ := nostr.GetPublicKey(bannedKey)
+	if err := BanNostrPubkey(banned); err != nil {
+		t.Fatal(err)
+	}
+	if !IsNostrPubkeyBanned(banned) {
+		t.Fatal("pubkey was not banned")
+	}
+	fake.Store(signedNostrEvent(t, bannedKey, nostr.LiveChatMessage(address, "spam")))
+
+	// Ordering is preserved, so once this arrives the banned message was handled.
+	marker := signedNostrEvent(t, viewerKey, nostr.LiveChatMessage(address, "marker"))
+	fake.Store(marker)
+	waitUntil(t, "marker message", func() bool {
+		return chatMessageRepository.GetMessageIDForNostrEvent(marker.ID, viewer) != ""
+	})
+	if ids, _ := chatMessageRepository.GetMessageIDsForNostrPubkey(banned); len(ids) != 0 {
+		t.Error("message from banned pubkey was added to chat")
+	}
+
+	if err := UnbanNostrPubkey(banned); err != nil || IsNostrPubkeyBanned(banned) {
+		t.Error("pubkey was not unbanned")
+	}
+}
+
+func TestNostrBridgeOutbound(t *testing.T) {
+	fake := setupNostrBridgeTest(t)
+	address := live.ActivityAddress()
+
+	user, _, err := userrepository.Get().CreateAnonymousUser("chatter")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := events.UserMessageEvent{
+		UserEvent:    events.UserEvent{User: user},
+		MessageEvent: events.MessageEvent{Body: "hello nostr"},
+	}
+	message.SetDefaults()
+	message.Type = events.MessageSent
+	chatmessagerepository.Get().SaveUserMessage(message)
+
+	publishChatMessageToNostr(&message)
+
+	var published *nostr.Event
+	waitUntil(t, "published chat message", func() bool {
+		for _, e := range fake.Events() {
+			if e.Kind == nostr.KindLiveChatMessage && e.PubKey == identity.PublicKey() {
+				published = e
+				return true
+			}
+		}
+		return false
+	})
+
+	if published.Content != "chatter: hello nostr" {
+		t.Errorf("content = %q", published.Content)
+	}
+	if published.Tags.Value("a") != address {
+		t.Errorf("message does not reference the live activity: %v", published.Tags)
+	}
+
+	// Hiding the message deletes it on Nostr.
+	if err := SetMessagesVisibility([]string{message.ID}, false); err != nil {
+		t.Fatal(err)
+	}
+	waitUntil(t, "deletion request", func() bool {
+		for _, e := range fake.Events() {
+			if e.Kind == nostr.KindDeletion && slices.Contains(nostr.DeletedEventIDs(e), published.ID) {
+				return true
+			}
+		}
+		return false
+	})
+}
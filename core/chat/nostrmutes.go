@@ -0,0 +1,262 @@
+package chat
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/authrepository"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+	log "github.com/sirupsen/logrus"
+)
+
+// nostrMuteListSync keeps chat moderation in step with the public entries
+// of the admin's NIP-51 mute list.
+type nostrMuteListSync struct {
+	sub  *relay.Subscription
+	list nostr.MuteList
+	// Guards the subscription and the list, which chat messages are checked
+	// against, so it is not held while chat users are disabled or enabled.
+	lock sync.Mutex
+
+	// Held while chat users are disabled or enabled for the list, so the
+	// changes are made one list at a time. Taken before lock.
+	applyLock sync.Mutex
+}
+
+var _nostrMuteListSync = &nostrMuteListSync{}
+
+// StartNostrMuteListSync will apply the admin's Nostr mute list to chat,
+// disabling users whose linked pubkey or messages are muted, now and every
+// time the admin publishes a new list. Call it again when the admin pubkey
+// changes.
+func StartNostrMuteListSync() {
+	s := _nostrMuteListSync
+	s.applyLock.Lock()
+	defer s.applyLock.Unlock()
+
+	s.lock.Lock()
+	if s.sub != nil {
+		s.sub.Close()
+		s.sub = nil
+	}
+
+	admin := configrepository.Get().GetAdminNostrPubkey()
+	stored := configrepository.Get().GetNostrMuteList()
+	previousAdmin := stored.Author != admin && stored.CreatedAt > 0
+	if previousAdmin {
+		stored = models.NostrMuteList{Author: admin}
+		if err := configrepository.Get().SetNostrMuteList(stored); err != nil {
+			log.Errorln("error saving Nostr mute list", err)
+		}
+	}
+	s.list = nostr.MuteList{Pubkeys: stored.Pubkeys, Words: stored.Words}
+
+	if nostr.IsValidPublicKey(admin) {
+		s.sub = relay.Get().Subscribe(nostr.Filter{
+			Kinds:   []int{nostr.KindMuteList},
+			Authors: []string{admin},
+		})
+		go s.consume(s.sub, admin)
+	}
+	s.lock.Unlock()
+
+	// The list belongs to a previous admin, so undo what it did.
+	if previousAdmin {
+		s.apply(stored)
+	}
+}
+
+// CheckNostrMuteList will disable the chat user if the pubkey they linked
+// is on the admin's Nostr mute list.
+func CheckNostrMuteList(userID, pubkey string) {
+	s := _nostrMuteListSync
+	s.applyLock.Lock()
+	defer s.applyLock.Unlock()
+
+	if isNostrPubkeyMuted(pubkey) {
+		s.mute(userID, models.NostrMutePubkey, pubkey)
+	}
+}
+
+// isNostrPubkeyMuted returns if the pubkey is on the admin's Nostr mute list.
+func isNostrPubkeyMuted(pubkey string) bool {
+	s := _nostrMuteListSync
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return slices.Contains(s.list.Pubkeys, pubkey)
+}
+
+// findNostrMutedWord returns the first word of the admin's Nostr mute list
+// found in the text.
+func findNostrMutedWord(text string) (string, bool) {
+	s := _nostrMuteListSync
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.list.ContainsMutedWord(text)
+}
+
+// muteUserForWord disables the chat user for using a muted word.
+func muteUserForWord(userID, word string) {
+	s := _nostrMuteListSync
+	s.applyLock.Lock()
+	defer s.applyLock.Unlock()
+
+	s.mute(userID, models.NostrMuteWord, word)
+}
+
+func (s *nostrMuteListSync) consume(sub *relay.Subscription, admin string) {
+	for event := range sub.Events {
+		if event.Kind != nostr.KindMuteList || event.PubKey != admin {
+			continue
+		}
+
+		s.applyLock.Lock()
+		s.lock.Lock()
+		configRepository := configrepository.Get()
+		stored := configRepository.GetNostrMuteList()
+		newer := stored.Author != admin || event.CreatedAt > stored.CreatedAt
+		var list models.NostrMuteList
+		if newer {
+			parsed := nostr.ParseMuteList(event)
+			list = models.NostrMuteList{
+				Author:    admin,
+				Pubkeys:   parsed.Pubkeys,
+				Words:     parsed.Words,
+				CreatedAt: event.CreatedAt,
+			}
+			if err := configRepository.SetNostrMuteList(list); err != nil {
+				log.Errorln("error saving Nostr mute list", err)
+			}
+			s.list = parsed
+		}
+		s.lock.Unlock()
+
+		// Chat users are updated from the copy of the list, while chat
+		// messages can be checked against it.
+		if newer {
+			s.apply(list)
+		}
+		s.applyLock.Unlock()
+	}
+}
+
+// apply disables the users matching the list and re-enables the ones the
+// list disabled before but no longer matches. Users disabled by a
+// moderator are left alone. Must be called with the apply lock held.
+func (s *nostrMuteListSync) apply(list models.NostrMuteList) {
+	userRepository := userrepository.Get()
+
+	existing, err := userRepository.GetNostrMutes()
+	if err != nil {
+		log.Errorln("error fetching Nostr mutes", err)
+		return
+	}
+
+	matched := map[string]*models.NostrMute{}
+
+	pubkeyUsers, err := userRepository.GetUserIDsForNostrPubkeys(list.Pubkeys)
+	if err != nil {
+		log.Errorln("error fetching users for muted Nostr pubkeys", err)
+		return
+	}
+	for userID, pubkey := range pubkeyUsers {
+		matched[userID] = &models.NostrMute{UserID: userID, Type: models.NostrMutePubkey, Value: pubkey}
+	}
+
+	// Users muted for a word stay muted while the word is on the list.
+	for _, mute := range existing {
+		if _, ok := matched[mute.UserID]; !ok && mute.Type == models.NostrMuteWord && slices.Contains(list.Words, mute.Value) {
+			matched[mute.UserID] = mute
+		}
+	}
+
+	muted := nostr.MuteList{Words: list.Words}
+	for _, client := range GetClients() {
+		if _, ok := matched[client.User.ID]; ok || client.User.IsModerator() {
+			continue
+		}
+		if word, ok := muted.ContainsMutedWord(client.User.DisplayName); ok {
+			matched[client.User.ID] = &models.NostrMute{UserID: client.User.ID, Type: models.NostrMuteWord, Value: word}
+		}
+	}
+
+	for _, mute := range existing {
+		if _, ok := matched[mute.UserID]; !ok {
+			s.unmute(mute)
+		}
+	}
+
+	for userID, mute := range matched {
+		s.mute(userID, mute.Type, mute.Value)
+	}
+}
+
+// mute disables the chat user the same way a moderator would and records
+// that the Nostr mute list did it.
+func (s *nostrMuteListSync) mute(userID, muteType, value string) {
+	userRepository := userrepository.Get()
+	user := userRepository.GetUserByID(userID)
+	if user == nil {
+		return
+	}
+
+	if !user.IsEnabled() {
+		// Already disabled, by a moderator or by the list itself.
+		if current := userRepository.GetNostrMuteForUser(userID); current != nil && (current.Type != muteType || current.Value != value) {
+			current.Type, current.Value = muteType, value
+			if err := userRepository.SetNostrMute(current); err != nil {
+				log.Errorln("error saving Nostr mute", err)
+			}
+		}
+		return
+	}
+
+	if err := SetUserEnabled(userID, false); err != nil {
+		log.Errorln("error disabling Nostr muted user", err)
+		return
+	}
+
+	ipAddresses, err := RemoveDisabledUser(userID)
+	if err != nil {
+		log.Errorln("error removing Nostr muted user", err)
+	}
+
+	if err := userRepository.SetNostrMute(&models.NostrMute{
+		UserID:      userID,
+		Type:        muteType,
+		Value:       value,
+		IPAddresses: ipAddresses,
+		CreatedAt:   time.Now(),
+	}); err != nil {
+		log.Errorln("error saving Nostr mute", err)
+	}
+
+	log.Infoln("Disabled chat user", user.DisplayName, "from the Nostr mute list", muteType, value)
+}
+
+// unmute re-enables a chat user the Nostr mute list disabled and lifts the
+// IP address bans made at the time.
+func (s *nostrMuteListSync) unmute(mute *models.NostrMute) {
+	if err := SetUserEnabled(mute.UserID, true); err != nil {
+		log.Errorln("error enabling Nostr unmuted user", err)
+		return
+	}
+
+	authRepository := authrepository.Get()
+	for _, ipAddress := range mute.IPAddresses {
+		if err := authRepository.RemoveIPAddressBan(ipAddress); err != nil {
+			log.Errorln("error removing IP address ban", err)
+		}
+	}
+
+	if err := userrepository.Get().RemoveNostrMute(mute.UserID); err != nil {
+		log.Errorln("error removing Nostr mute", err)
+	}
+}
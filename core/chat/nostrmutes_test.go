@@ -0,0 +1,105 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+)
+
+func TestNostrMuteList(t *testing.T) {
+	fake := setupNostrBridgeTest(t)
+	userRepository := userrepository.Get()
+	configRepository := configrepository.Get()
+
+	adminKey, _ := nostr.GeneratePrivateKey()
+	admin, _ := nostr.GetPublicKey(adminKey)
+	_ = configRepository.SetAdminNostrPubkey(admin)
+	t.Cleanup(func() {
+		_ = configRepository.SetAdminNostrPubkey("")
+		StartNostrMuteListSync()
+	})
+
+	newLinkedUser := func(name string) (*models.User, string) {
+		key, _ := nostr.GeneratePrivateKey()
+		pubkey, _ := nostr.GetPublicKey(key)
+		user, _, err := userRepository.CreateAnonymousUser(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := userRepository.AddAuth(user.ID, pubkey, models.Nostr); err != nil {
+			t.Fatal(err)
+		}
+		return user, pubkey
+	}
+
+	muted, mutedPubkey := newLinkedUser("muted")
+	banned, bannedPubkey := newLinkedUser("banned")
+	if err := SetUserEnabled(banned.ID, false); err != nil {
+		t.Fatal(err)
+	}
+	spammer, _, _ := userRepository.CreateAnonymousUser("spammer")
+
+	now := time.Now().Unix()
+	fake.Store(signedNostrEvent(t, adminKey, &nostr.Event{
+		CreatedAt: now - 10,
+		Kind:      nostr.KindMuteList,
+		Tags:      nostr.Tags{{"p", mutedPubkey}, {"p", bannedPubkey}, {"word", "Spam"}},
+	}))
+	StartNostrMuteListSync()
+
+	waitUntil(t, "muted user to be disabled", func() bool {
+		return !userRepository.GetUserByID(muted.ID).IsEnabled()
+	})
+	if mute := userRepository.GetNostrMuteForUser(muted.ID); mute == nil || mute.Type != models.NostrMutePubkey || mute.Value != mutedPubkey {
+		t.Errorf("unexpected mute %+v", mute)
+	}
+	if mute := userRepository.GetNostrMuteForUser(banned.ID); mute != nil {
+		t.Errorf("manually disabled user should not be recorded as muted, got %+v", mute)
+	}
+	if !IsNostrPubkeyBanned(mutedPubkey) {
+		t.Error("expected the muted pubkey to be dropped from the Nostr chat bridge")
+	}
+
+	if word, ok := findNostrMutedWord("buy my SPAM"); !ok || word != "spam" {
+		t.Fatalf("expected the muted word to be found, got %q %v", word, ok)
+	}
+	muteUserForWord(spammer.ID, "spam")
+	if user := userRepository.GetUserByID(spammer.ID); user.IsEnabled() {
+		t.Error("expected the user to be disabled for a muted word")
+	}
+
+	disabled := userRepository.GetDisabledUsers()
+	var listed *models.User
+	for _, user := range disabled {
+		if user.ID == spammer.ID {
+			listed = user
+		}
+	}
+	if listed == nil || listed.NostrMute == nil || listed.NostrMute.Type != models.NostrMuteWord {
+		t.Errorf("expected disabled users to show the mute list entry, got %+v", listed)
+	}
+
+	// Unmuting re-enables the users the list disabled, but not the others.
+	fake.Store(signedNostrEvent(t, adminKey, &nostr.Event{
+		CreatedAt: now,
+		Kind:      nostr.KindMuteList,
+		Tags:      nostr.Tags{{"p", bannedPubkey}},
+	}))
+
+	waitUntil(t, "unmuted users to be enabled", func() bool {
+		return userRepository.GetUserByID(muted.ID).IsEnabled() && userRepository.GetUserByID(spammer.ID).IsEnabled()
+	})
+	if userRepository.GetUserByID(banned.ID).IsEnabled() {
+		t.Error("manually disabled user should stay disabled")
+	}
+	if mute := userRepository.GetNostrMuteForUser(muted.ID); mute != nil {
+		t.Errorf("expected the mute to be removed, got %+v", mute)
+	}
+	if list := configRepository.GetNostrMuteList(); list.CreatedAt != now || len(list.Words) != 0 {
+		t.Errorf("unexpected stored mute list %+v", list)
+	}
+}
@@ -0,0 +1,250 @@
+package chat
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/nip05"
+	"github.com/TekkadanPlays/oni/nostr/profiles"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How often synced profiles are checked for ones that need a refresh.
+	nostrProfileRefreshInterval = 15 * time.Minute
+
+	// How old a synced profile gets before it is fetched again.
+	nostrProfileMaxAge = 6 * time.Hour
+
+	// The most profiles refreshed at a time.
+	nostrProfileRefreshBatchSize = 100
+
+	// How long to wait for relays and NIP-05 domains when syncing a profile.
+	nostrProfileSyncTimeout = 10 * time.Second
+
+	// The most pubkeys watched for new profiles.
+	maxWatchedNostrProfiles = 1000
+
+	// The longest avatar URL accepted from a profile.
+	maxNostrAvatarURLLength = 2048
+)
+
+// nostrProfileWatcher subscribes to new kind 0 profiles of the pubkeys
+// linked to chat users.
+type nostrProfileWatcher struct {
+	sub     *relay.Subscription
+	pubkeys map[string]struct{}
+	lock    sync.Mutex
+}
+
+var _nostrProfileWatcher = &nostrProfileWatcher{pubkeys: map[string]struct{}{}}
+
+// StartNostrProfileSync will keep the Nostr profiles of chat users with a
+// linked pubkey up to date, refreshing them periodically and whenever the
+// relays send a newer profile.
+func StartNostrProfileSync() {
+	pubkeys, err := userrepository.Get().GetRecentNostrProfilePubkeys(maxWatchedNostrProfiles)
+	if err != nil {
+		log.Errorln("error fetching Nostr profiles to watch", err)
+	}
+	_nostrProfileWatcher.watch(pubkeys...)
+
+	go func() {
+		refreshNostrProfiles()
+		for range time.Tick(nostrProfileRefreshInterval) {
+			refreshNostrProfiles()
+		}
+	}()
+}
+
+// SyncNostrProfile will fetch the latest profile of the pubkey linked to
+// the chat user, save it and update the user's connected clients.
+func SyncNostrProfile(userID, pubkey string) {
+	ctx, cancel := context.WithTimeout(context.Background(), nostrProfileSyncTimeout)
+	profile := profiles.Fetch(ctx, pubkey)
+	cancel()
+
+	applyNostrProfile(userID, pubkey, profile)
+	_nostrProfileWatcher.watch(pubkey)
+}
+
+func refreshNostrProfiles() {
+	stale, err := userrepository.Get().GetNostrProfilesToRefresh(time.Now().Add(-nostrProfileMaxAge), nostrProfileRefreshBatchSize)
+	if err != nil {
+		log.Errorln("error fetching Nostr profiles to refresh", err)
+		return
+	}
+
+	for _, profile := range stale {
+		SyncNostrProfile(profile.UserID, profile.Pubkey)
+	}
+}
+
+// applyNostrProfile saves the profile for the chat user, verifying its
+// NIP-05 identifier, and updates the user's connected clients if anything
+// they show changed. A nil profile keeps what was synced before.
+func applyNostrProfile(userID, pubkey string, profile *nostr.Profile) {
+	userRepository := userrepository.Get()
+	stored := userRepository.GetNostrProfile(userID)
+	if stored != nil && stored.Pubkey != pubkey {
+		stored = nil
+	}
+
+	synced := &models.NostrProfile{
+		UserID:    userID,
+		Pubkey:    pubkey,
+		FetchedAt: time.Now(),
+	}
+
+	switch {
+	case profile != nil && (stored == nil || profile.CreatedAt >= stored.CreatedAt):
+		synced.Name = utils.MakeSafeStringOfLength(profile.BestName(), config.MaxChatDisplayNameLength)
+		synced.Picture = safeAvatarURL(profile.Picture)
+		synced.NIP05 = strings.ToLower(strings.TrimSpace(profile.NIP05))
+		synced.CreatedAt = profile.CreatedAt
+	case stored != nil:
+		synced.Name = stored.Name
+		synced.Picture = stored.Picture
+		synced.NIP05 = stored.NIP05
+		synced.CreatedAt = stored.CreatedAt
+	}
+
+	// Identifiers are checked again on every sync as domains can drop them.
+	if synced.NIP05 != "" {
+		synced.NIP05Verified = verifyNostrNIP05(synced.NIP05, pubkey)
+	}
+
+	if err := userRepository.SetNostrProfile(synced); err != nil {
+		log.Errorln("error saving Nostr profile", err)
+		return
+	}
+
+	if stored == nil || stored.Name != synced.Name || stored.Picture != synced.Picture || stored.NIP05 != synced.NIP05 || stored.NIP05Verified != synced.NIP05Verified {
+		updateClientsNostrProfile(userID, synced)
+	}
+}
+
+// verifyNostrNIP05 returns if the identifier points at the pubkey.
+// Identifiers on this server are checked without a request to ourselves.
+func verifyNostrNIP05(identifier, pubkey string) bool {
+	name, domain, err := nostr.ParseNIP05Identifier(identifier)
+	if err != nil {
+		return false
+	}
+
+	if domain == utils.GetHostnameFromURLString(configrepository.Get().GetServerURL()) {
+		return nip05.Document(name).Names[name] == pubkey
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), nostrProfileSyncTimeout)
+	defer cancel()
+
+	verified, err := nostr.VerifyNIP05(ctx, identifier, pubkey)
+	if err != nil {
+		log.Debugln("Unable to verify NIP-05 identifier", identifier, err)
+	}
+
+	return verified
+}
+
+// updateClientsNostrProfile sends the user's connected clients their new
+// avatar and NIP-05 state, and the profile name to offer as display name.
+func updateClientsNostrProfile(userID string, profile *models.NostrProfile) {
+	clients, err := GetClientsForUser(userID)
+	if err != nil {
+		return
+	}
+
+	for _, client := range clients {
+		user := *client.User
+		user.Avatar = profile.Picture
+		user.NIP05 = profile.NIP05
+		user.NIP05Verified = profile.NIP05Verified
+		client.User = &user
+		client.sendConnectedClientInfo()
+	}
+}
+
+// suggestedDisplayName returns the name from the user's Nostr profile when
+// it differs from their current display name.
+func suggestedDisplayName(user *models.User) string {
+	profile := userrepository.Get().GetNostrProfile(user.ID)
+	if profile == nil || strings.TrimSpace(profile.Name) == "" || profile.Name == user.DisplayName {
+		return ""
+	}
+
+	return profile.Name
+}
+
+func safeAvatarURL(picture string) string {
+	picture = strings.TrimSpace(picture)
+	if len(picture) > maxNostrAvatarURLLength {
+		return ""
+	}
+
+	u, err := url.Parse(picture)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return ""
+	}
+
+	return u.String()
+}
+
+// watch subscribes to new profiles of the pubkeys as well as the ones
+// already watched.
+func (w *nostrProfileWatcher) watch(pubkeys ...string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	added := false
+	for _, pubkey := range pubkeys {
+		if _, exists := w.pubkeys[pubkey]; exists || len(w.pubkeys) >= maxWatchedNostrProfiles {
+			continue
+		}
+		w.pubkeys[pubkey] = struct{}{}
+		added = true
+	}
+	if !added {
+		return
+	}
+
+	authors := make([]string, 0, len(w.pubkeys))
+	for pubkey := range w.pubkeys {
+		authors = append(authors, pubkey)
+	}
+
+	if w.sub != nil {
+		w.sub.Close()
+	}
+	w.sub = relay.Get().Subscribe(nostr.Filter{
+		Kinds:   []int{nostr.KindProfileMetadata},
+		Authors: authors,
+		Since:   time.Now().Unix(),
+	})
+	go w.consume(w.sub)
+}
+
+func (w *nostrProfileWatcher) consume(sub *relay.Subscription) {
+	for event := range sub.Events {
+		profile, err := nostr.ParseProfile(event)
+		if err != nil {
+			log.Debugln("Ignoring invalid Nostr profile", event.ID, err)
+			continue
+		}
+		profiles.Set(event.PubKey, profile)
+
+		if user := userrepository.Get().GetUserByAuth(event.PubKey, models.Nostr); user != nil {
+			applyNostrProfile(user.ID, event.PubKey, profile)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+)
+
+func TestSyncNostrProfile(t *testing.T) {
+	fake := setupNostrBridgeTest(t)
+	userRepository := userrepository.Get()
+
+	key, _ := nostr.GeneratePrivateKey()
+	pubkey, _ := nostr.GetPublicKey(key)
+	fake.Store(signedNostrEvent(t, key, &nostr.Event{
+		Kind:    nostr.KindProfileMetadata,
+		Content: `{"name":"alice","display_name":"Alice","picture":"https://example.com/alice.png","nip05":"alice@unreachable.invalid"}`,
+	}))
+
+	user, _, err := userRepository.CreateAnonymousUser("guest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := userRepository.AddAuth(user.ID, pubkey, models.Nostr); err != nil {
+		t.Fatal(err)
+	}
+
+	SyncNostrProfile(user.ID, pubkey)
+
+	synced := userRepository.GetUserByID(user.ID)
+	if synced.Avatar != "https://example.com/alice.png" {
+		t.Errorf("avatar = %q", synced.Avatar)
+	}
+	if synced.NIP05 != "alice@unreachable.invalid" || synced.NIP05Verified {
+		t.Errorf("expected an unverified NIP-05 identifier, got %q verified %v", synced.NIP05, synced.NIP05Verified)
+	}
+	if name := suggestedDisplayName(synced); name != "Alice" {
+		t.Errorf("suggested display name = %q", name)
+	}
+
+	synced.DisplayName = "Alice"
+	if name := suggestedDisplayName(synced); name != "" {
+		t.Errorf("expected no suggestion once the name matches, got %q", name)
+	}
+
+	// Users with a profile are picked up by the periodic refresh only once stale.
+	stale, err := userRepository.GetNostrProfilesToRefresh(synced.CreatedAt, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, profile := range stale {
+		if profile.UserID == user.ID {
+			t.Error("freshly synced profile should not need a refresh")
+		}
+	}
+}
+
+func TestSafeAvatarURL(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/a.png":   "https://example.com/a.png",
+		" http://example.com/a.png ":  "http://example.com/a.png",
+		"javascript:alert(1)":         "",
+		"data:image/png;base64,AAAA":  "",
+		"//example.com/no-scheme.png": "",
+		"":                            "",
+	}
+
+	for picture, expected := range tests {
+		if got := safeAvatarURL(picture); got != expected {
+			t.Errorf("safeAvatarURL(%q) = %q, want %q", picture, got, expected)
+		}
+	}
+}
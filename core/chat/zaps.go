@@ -0,0 +1,82 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/TekkadanPlays/oni/core/chat/events"
+	"github.com/TekkadanPlays/oni/core/webhooks"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
+	"github.com/TekkadanPlays/oni/persistence/chatmessagerepository"
+	"github.com/TekkadanPlays/oni/persistence/zaprepository"
+	log "github.com/sirupsen/logrus"
+)
+
+// handleZapReceipt validates a zap receipt for the live activity against
+// its zap request and shows the zap in chat.
+func (b *nostrBridge) handleZapReceipt(event *nostr.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), nostrProfileTimeout)
+	provider, err := zaps.ProviderPubkey(ctx, event.Tags.Value("p"))
+	cancel()
+	if err != nil {
+		log.Debugln("Ignoring zap receipt", event.ID, err)
+		return
+	}
+
+	zap, err := nostr.ParseZapReceipt(event, provider)
+	if err != nil {
+		log.Debugln("Ignoring invalid zap receipt", event.ID, err)
+		return
+	}
+
+	if zap.Address != b.address {
+		return
+	}
+
+	record := models.Zap{
+		Timestamp:  zap.PaidAt,
+		ID:         event.ID,
+		StreamID:   nostr.AddressIdentifier(zap.Address),
+		Sender:     zap.Sender,
+		Recipient:  zap.Recipient,
+		Comment:    zap.Comment,
+		AmountMsat: zap.AmountMsat,
+	}
+
+	zapRepository := zaprepository.Get()
+	saved, err := zapRepository.SaveZap(record)
+	if err != nil {
+		log.Errorln("error saving zap", err)
+		return
+	}
+	if !saved {
+		// Already seen from another relay or an earlier subscription.
+		return
+	}
+
+	message := events.ZapEvent{
+		MessageEvent: events.MessageEvent{
+			Body: zap.Comment,
+		},
+		Pubkey:     zap.Sender,
+		AmountMsat: zap.AmountMsat,
+	}
+	message.SetDefaults()
+	message.RenderAndSanitizeMessageBody()
+	message.SenderName, message.Npub, message.Image = lookupNostrAuthor(zap.Sender)
+
+	if err := Broadcast(&message); err != nil {
+		log.Errorln("error broadcasting zap", err)
+	}
+
+	amount := strconv.FormatInt(zap.AmountMsat, 10)
+	chatmessagerepository.Get().SaveEvent(message.ID, nil, message.Body, message.GetMessageType(), nil, message.Timestamp, message.Image, &event.ID, &message.SenderName, &amount)
+
+	total, err := zapRepository.GetZapTotalForStream(record.StreamID)
+	if err != nil {
+		log.Errorln("error fetching zap totals", err)
+	}
+	webhooks.SendZapEvent(record, message.SenderName, total)
+}
@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/live"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
+	"github.com/TekkadanPlays/oni/nostr/zaptest"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/zaprepository"
+)
+
+func TestNostrZaps(t *testing.T) {
+	provider := zaptest.NewProvider()
+	defer provider.Close()
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetNostrLightningAddress(provider.Address("streamer")); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = configRepository.SetNostrLightningAddress("") }()
+
+	fake := setupNostrBridgeTest(t)
+	address := live.ActivityAddress()
+	streamID := nostr.AddressIdentifier(address)
+
+	viewerKey, _ := nostr.GeneratePrivateKey()
+	zap := func(amountMsat int64, comment string) *nostr.Event {
+		request := signedNostrEvent(t, viewerKey, &nostr.Event{
+			Kind: nostr.KindZapRequest,
+			Tags: nostr.Tags{
+				{"p", identity.PublicKey()},
+				{"a", address},
+				{"relays", fake.URL()},
+				{"amount", strconv.FormatInt(amountMsat, 10)},
+			},
+			Content: comment,
+		})
+		b, _ := json.Marshal(request)
+
+		bolt11, err := zaps.RequestStreamInvoice(context.Background(), amountMsat, string(b), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := provider.Pay(bolt11)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+
+	// Receipts not signed by the stream's lightning provider are ignored.
+	forgerKey, _ := nostr.GeneratePrivateKey()
+	forged := zap(1_000_000, "forged")
+	forged.ID = ""
+	fake.Store(signedNostrEvent(t, forgerKey, forged))
+
+	first := zap(21_000, "great stream")
+	fake.Store(first)
+	// Relays may send the same receipt more than once.
+	fake.Store(first)
+	fake.Store(zap(42_000, ""))
+
+	zapRepository := zaprepository.Get()
+	waitUntil(t, "zaps to be recorded", func() bool {
+		total, _ := zapRepository.GetZapTotalForStream(streamID)
+		return total.Count >= 2
+	})
+
+	total, err := zapRepository.GetZapTotalForStream(streamID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Count != 2 || total.AmountMsat != 63_000 {
+		t.Errorf("total = %d zaps for %d msat, want 2 zaps for 63000 msat", total.Count, total.AmountMsat)
+	}
+
+	recorded, _ := zapRepository.GetZapsForStream(streamID)
+	found := false
+	for _, z := range recorded {
+		found = found || (z.ID == first.ID && z.Comment == "great stream")
+	}
+	if !found {
+		t.Error("zap comment was not recorded")
+	}
+
+	var body string
+	if err := _datastore.DB.QueryRow("SELECT body FROM messages WHERE link = ?", first.ID).Scan(&body); err != nil {
+		t.Fatal("zap was not added to chat", err)
+	}
+}
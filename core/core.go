@@ -12,9 +12,16 @@ import (
 	"github.com/TekkadanPlays/oni/core/chat"
 	"github.com/TekkadanPlays/oni/core/data"
 	"github.com/TekkadanPlays/oni/core/rtmp"
+	"github.com/TekkadanPlays/oni/core/srt"
 	"github.com/TekkadanPlays/oni/core/transcoder"
 	"github.com/TekkadanPlays/oni/core/webhooks"
+	"github.com/TekkadanPlays/oni/core/whip"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/live"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/relayserver"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
 	"github.com/TekkadanPlays/oni/notifications"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/persistence/tables"
@@ -81,7 +88,24 @@ func Start() error {
 		log.Infof("RTMP is accepting inbound streams on port %d.", rtmpPort)
 	}
 
+	// start the srt server
+	go srt.Start(setStreamAsConnected, setBroadcaster)
+
+	// accept WHIP broadcasters on the web server
+	whip.Start(setStreamAsConnected, setBroadcaster)
+
 	webhooks.SetupWebhooks(GetStatus)
+	if err := identity.Setup(); err != nil {
+		log.Errorln("Unable to load the server Nostr identity. Nostr events will not be published.", err)
+	}
+	relay.Get().SetRelays(configRepository.GetNostrRelays())
+	relayserver.Setup(chat.IsNostrPubkeyBanned)
+	if err := zaps.SetupWallet(); err != nil {
+		log.Errorln("Unable to connect to the Nostr Wallet Connect wallet.", err)
+	}
+	chat.StartNostrProfileSync()
+	chat.StartNostrMuteListSync()
+	live.Setup(GetStatus)
 
 	notifications.Setup(data.GetStore())
 
@@ -79,6 +79,15 @@ func SetupPersistence(file string) error {
 	tables.CreateWebhooksTable(db)
 	tables.CreateUsersTable(db)
 	tables.CreateAccessTokenTable(db)
+	tables.CreateZapsTable(db)
+	tables.CreateNIP05NamesTable(db)
+	tables.CreateNostrProfilesTable(db)
+	tables.CreateNostrMutesTable(db)
+	tables.CreateAdminPubkeysTable(db)
+	tables.CreateAdminAuditLogTable(db)
+	tables.CreateRecordingsTable(db)
+	tables.CreateBroadcastHistoryTable(db)
+	tables.CreateNostrRelayTables(db)
 
 	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS config (
 		"key" string NOT NULL PRIMARY KEY,
@@ -0,0 +1,104 @@
+// Package ingest tracks which protocol is feeding the inbound stream, as
+// only one broadcaster can be streaming at a time.
+package ingest
+
+import (
+	"sync"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+// Protocols a broadcaster can stream with. RTMPS broadcasters claim the
+// stream as RTMP, as either may take over from the other.
+const (
+	RTMP  = "rtmp"
+	RTMPS = "rtmps"
+	SRT   = "srt"
+	WHIP  = "whip"
+)
+
+var (
+	_current string
+	_lock    sync.Mutex
+
+	// The stream key the current broadcast was started with, and the
+	// address of the broadcaster who started it.
+	_streamKey  string
+	_remoteAddr string
+
+	// The output variants and latency level chosen for the current
+	// broadcast, if they are not the configured ones.
+	_outputVariants []models.StreamOutputVariant
+	_latencyLevel   *models.LatencyLevel
+)
+
+// Claim will reserve the inbound stream for the protocol. Returns false if
+// a broadcaster is already streaming with another protocol.
+func Claim(protocol string) bool {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if _current != "" && _current != protocol {
+		return false
+	}
+
+	_current = protocol
+	return true
+}
+
+// Release will free the inbound stream once the protocol's broadcaster
+// disconnected.
+func Release(protocol string) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if _current == protocol {
+		_current = ""
+		_streamKey = ""
+		_remoteAddr = ""
+		_outputVariants = nil
+		_latencyLevel = nil
+	}
+}
+
+// Current returns the protocol of the connected broadcaster, if any.
+func Current() string {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	return _current
+}
+
+// SetOutputVariants will use the variants for the current broadcast instead
+// of the configured ones, until the broadcaster disconnects.
+func SetOutputVariants(variants []models.StreamOutputVariant) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	_outputVariants = variants
+}
+
+// OutputVariants returns the output variants of the current broadcast.
+func OutputVariants() []models.StreamOutputVariant {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if len(_outputVariants) > 0 {
+		return _outputVariants
+	}
+
+	return configrepository.Get().GetStreamOutputVariants()
+}
+
+// LatencyLevel returns the latency level of the current broadcast.
+func LatencyLevel() models.LatencyLevel {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if _latencyLevel != nil {
+		return *_latencyLevel
+	}
+
+	return configrepository.Get().GetStreamLatencyLevel()
+}
@@ -0,0 +1,209 @@
+package ingest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+// How long the publish callback has to answer.
+const publishCallbackTimeout = 5 * time.Second
+
+var _publishCallbackClient = &http.Client{Timeout: publishCallbackTimeout}
+
+// PublishRequest is sent to the publish callback when a broadcaster asks
+// to stream.
+type PublishRequest struct {
+	Metadata   *models.InboundStreamDetails `json:"metadata,omitempty"`
+	StreamKey  string                       `json:"streamKey"`
+	RemoteAddr string                       `json:"remoteAddr"`
+	Protocol   string                       `json:"protocol"`
+}
+
+// publishResponse is the answer of the publish callback. Every field is
+// optional: any 2xx answer allows the broadcaster unless allow is false,
+// and any 4xx answer denies them.
+type publishResponse struct {
+	Allow       *bool    `json:"allow"`
+	Reason      string   `json:"reason"`
+	StreamTitle string   `json:"streamTitle"`
+	Variants    []string `json:"variants"`
+}
+
+// PublishDecision is if a broadcaster may stream, and how.
+type PublishDecision struct {
+	Reason      string
+	StreamTitle string
+	// The output variants the broadcast uses instead of the configured
+	// ones, when the callback chose them.
+	OutputVariants []models.StreamOutputVariant
+	Allowed        bool
+}
+
+type cachedDecision struct {
+	expires  time.Time
+	decision PublishDecision
+}
+
+var (
+	_decisions     = map[string]cachedDecision{}
+	_decisionsLock sync.Mutex
+)
+
+// PublishCallbackEnabled returns if the publish callback decides who may
+// stream, instead of the configured stream keys.
+func PublishCallbackEnabled() bool {
+	return configrepository.Get().GetPublishCallbackConfig().Enabled
+}
+
+// AuthorizePublish asks the publish callback if the broadcaster may stream.
+func AuthorizePublish(request PublishRequest) PublishDecision {
+	configRepository := configrepository.Get()
+	return authorizePublish(configRepository.GetPublishCallbackConfig(), request, configRepository.GetStreamOutputVariants())
+}
+
+func authorizePublish(callback models.PublishCallbackConfiguration, request PublishRequest, configuredVariants []models.StreamOutputVariant) PublishDecision {
+	cacheKey := decisionCacheKey(callback.URL, request)
+	if decision, ok := cachedPublishDecision(cacheKey); ok {
+		return decision
+	}
+
+	response, err := askPublishCallback(callback.URL, request)
+	if err != nil {
+		log.Errorln("Unable to reach the publish callback.", err)
+		// Failing open still requires one of the configured stream keys.
+		if callback.FailOpen && isValidStreamKey(request.StreamKey) {
+			return PublishDecision{Allowed: true, Reason: "publish callback unreachable, failing open"}
+		}
+		return PublishDecision{Reason: "publish callback unreachable"}
+	}
+
+	decision := PublishDecision{
+		Allowed:        response.Allow == nil || *response.Allow,
+		Reason:         response.Reason,
+		StreamTitle:    response.StreamTitle,
+		OutputVariants: chooseOutputVariants(response.Variants, configuredVariants),
+	}
+
+	if callback.CacheSeconds > 0 {
+		cachePublishDecision(cacheKey, decision, time.Duration(callback.CacheSeconds)*time.Second)
+	}
+
+	return decision
+}
+
+// ApplyPublishDecision sets up the broadcast the way the publish callback
+// chose.
+func ApplyPublishDecision(decision PublishDecision) {
+	if decision.StreamTitle != "" {
+		if err := configrepository.Get().SetStreamTitle(decision.StreamTitle); err != nil {
+			log.Errorln("unable to set the stream title chosen by the publish callback", err)
+		}
+	}
+
+	if len(decision.OutputVariants) > 0 {
+		SetOutputVariants(decision.OutputVariants)
+	}
+}
+
+// askPublishCallback sends the request to the callback. An error means the
+// callback could not give an answer.
+func askPublishCallback(url string, request PublishRequest) (publishResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return publishResponse{}, err
+	}
+
+	resp, err := _publishCallbackClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return publishResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		// An empty body simply allows the broadcaster.
+		var response publishResponse
+		if err := json.NewDecoder(resp.Body).Decode(&response); err != nil && !errors.Is(err, io.EOF) {
+			return publishResponse{}, fmt.Errorf("invalid publish callback response: %w", err)
+		}
+		return response, nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		allow := false
+		response := publishResponse{Allow: &allow, Reason: resp.Status}
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		response.Allow = &allow
+		return response, nil
+	default:
+		return publishResponse{}, fmt.Errorf("publish callback returned %s", resp.Status)
+	}
+}
+
+// chooseOutputVariants returns the configured variants with the names the
+// callback asked for, in their configured order.
+func chooseOutputVariants(names []string, configuredVariants []models.StreamOutputVariant) []models.StreamOutputVariant {
+	if len(names) == 0 {
+		return nil
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	variants := []models.StreamOutputVariant{}
+	for _, variant := range configuredVariants {
+		if wanted[variant.GetName()] {
+			variants = append(variants, variant)
+		}
+	}
+
+	if len(variants) == 0 {
+		log.Warnln("The publish callback chose output variants that are not configured, using all of them.", names)
+		return nil
+	}
+
+	return variants
+}
+
+// decisionCacheKey identifies a publish request by the callback, stream
+// key and address it came from, ignoring the port.
+func decisionCacheKey(url string, request PublishRequest) string {
+	return url + "\x00" + request.Protocol + "\x00" + request.StreamKey + "\x00" + addressHost(request.RemoteAddr)
+}
+
+func cachedPublishDecision(key string) (PublishDecision, bool) {
+	_decisionsLock.Lock()
+	defer _decisionsLock.Unlock()
+
+	cached, ok := _decisions[key]
+	if !ok || time.Now().After(cached.expires) {
+		return PublishDecision{}, false
+	}
+
+	return cached.decision, true
+}
+
+func cachePublishDecision(key string, decision PublishDecision, ttl time.Duration) {
+	_decisionsLock.Lock()
+	defer _decisionsLock.Unlock()
+
+	now := time.Now()
+	for k, cached := range _decisions {
+		if now.After(cached.expires) {
+			delete(_decisions, k)
+		}
+	}
+
+	_decisions[key] = cachedDecision{decision: decision, expires: now.Add(ttl)}
+}
@@ -0,0 +1,139 @@
+package ingest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/models"
+)
+
+var configuredVariants = []models.StreamOutputVariant{
+	{Name: "high", VideoBitrate: 4000},
+	{Name: "medium", VideoBitrate: 1500},
+	{Name: "low", VideoBitrate: 600},
+}
+
+// callback starts a publish callback that answers with the handler and
+// counts the requests it received.
+func callback(t *testing.T, handler http.HandlerFunc) (string, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, &requests
+}
+
+func TestPublishCallbackAllow(t *testing.T) {
+	url, _ := callback(t, func(w http.ResponseWriter, r *http.Request) {
+		var request PublishRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		if request.StreamKey != "abc123" || request.RemoteAddr != "10.0.0.1:5000" || request.Protocol != RTMP {
+			t.Errorf("unexpected publish request %+v", request)
+		}
+		if request.Metadata == nil || request.Metadata.Encoder != "OBS" {
+			t.Errorf("expected the encoder metadata, got %+v", request.Metadata)
+		}
+
+		_, _ = w.Write([]byte(`{"streamTitle": "Late night", "variants": ["low", "high"]}`))
+	})
+
+	decision := authorizePublish(models.PublishCallbackConfiguration{URL: url}, PublishRequest{
+		StreamKey:  "abc123",
+		RemoteAddr: "10.0.0.1:5000",
+		Protocol:   RTMP,
+		Metadata:   &models.InboundStreamDetails{Encoder: "OBS"},
+	}, configuredVariants)
+
+	if !decision.Allowed || decision.StreamTitle != "Late night" {
+		t.Errorf("expected to be allowed with a title, got %+v", decision)
+	}
+
+	// Variants keep their configured order.
+	if len(decision.OutputVariants) != 2 || decision.OutputVariants[0].Name != "high" || decision.OutputVariants[1].Name != "low" {
+		t.Errorf("expected the high and low variants, got %+v", decision.OutputVariants)
+	}
+}
+
+func TestPublishCallbackDeny(t *testing.T) {
+	url, _ := callback(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"reason": "unknown key"}`))
+	})
+
+	decision := authorizePublish(models.PublishCallbackConfiguration{URL: url, FailOpen: true}, PublishRequest{StreamKey: "deny"}, configuredVariants)
+	if decision.Allowed || decision.Reason != "unknown key" {
+		t.Errorf("expected to be denied, got %+v", decision)
+	}
+
+	url, _ = callback(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"allow": false}`))
+	})
+
+	decision = authorizePublish(models.PublishCallbackConfiguration{URL: url}, PublishRequest{StreamKey: "deny"}, configuredVariants)
+	if decision.Allowed {
+		t.Errorf("expected allow false to deny, got %+v", decision)
+	}
+}
+
+func TestPublishCallbackUnreachable(t *testing.T) {
+	url, requests := callback(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	closed := authorizePublish(models.PublishCallbackConfiguration{URL: url, CacheSeconds: 60}, PublishRequest{StreamKey: "unreachable"}, configuredVariants)
+	if closed.Allowed {
+		t.Errorf("expected to fail closed, got %+v", closed)
+	}
+
+	config.TemporaryStreamKey = "unreachable"
+	defer func() { config.TemporaryStreamKey = "" }()
+
+	open := authorizePublish(models.PublishCallbackConfiguration{URL: url, CacheSeconds: 60, FailOpen: true}, PublishRequest{StreamKey: "unreachable"}, configuredVariants)
+	if !open.Allowed {
+		t.Errorf("expected to fail open, got %+v", open)
+	}
+
+	// Failing open still requires a valid stream key.
+	invalid := authorizePublish(models.PublishCallbackConfiguration{URL: url, CacheSeconds: 60, FailOpen: true}, PublishRequest{StreamKey: "invalid"}, configuredVariants)
+	if invalid.Allowed {
+		t.Errorf("expected an invalid stream key to be denied, got %+v", invalid)
+	}
+
+	// Failures are not cached, the callback is asked again next time.
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 requests to the callback, got %d", got)
+	}
+}
+
+func TestPublishCallbackCache(t *testing.T) {
+	url, requests := callback(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	config := models.PublishCallbackConfiguration{URL: url, CacheSeconds: 60}
+	for _, remoteAddr := range []string{"10.0.0.2:5000", "10.0.0.2:5001"} {
+		decision := authorizePublish(config, PublishRequest{StreamKey: "cached", RemoteAddr: remoteAddr}, configuredVariants)
+		if !decision.Allowed {
+			t.Errorf("expected an empty answer to allow, got %+v", decision)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected the decision to be cached for the address, got %d requests", got)
+	}
+
+	authorizePublish(config, PublishRequest{StreamKey: "cached", RemoteAddr: "10.0.0.3:5000"}, configuredVariants)
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected another address to ask again, got %d requests", got)
+	}
+}
@@ -0,0 +1,259 @@
+package ingest
+
+import (
+	"crypto/subtle"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
+	log "github.com/sirupsen/logrus"
+)
+
+// How many characters of a key are kept in the broadcast history, so keys
+// with the same label can be told apart. Shorter keys are not hinted at.
+const (
+	streamKeyHintLength   = 4
+	minHintedStreamKeyLen = 8
+)
+
+// Guards saving the stream keys, as their usage is recorded as they are
+// streamed with.
+var _keysLock sync.Mutex
+
+// StreamKeys returns the keys a broadcaster may stream with right now.
+func StreamKeys() []string {
+	// If a stream key override was specified then use that instead.
+	if config.TemporaryStreamKey != "" {
+		return []string{config.TemporaryStreamKey}
+	}
+
+	now := time.Now()
+	keys := []string{}
+	for _, key := range configrepository.Get().GetStreamKeys() {
+		if streamKeyActive(key, now) {
+			keys = append(keys, *key.Key)
+		}
+	}
+
+	return keys
+}
+
+// isValidStreamKey returns if the key is one the broadcaster may stream with.
+func isValidStreamKey(streamKey string) bool {
+	for _, key := range StreamKeys() {
+		if subtle.ConstantTimeCompare([]byte(streamKey), []byte(key)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// streamKeyActive returns if the key may be streamed with at the time.
+func streamKeyActive(key generated.StreamKey, now time.Time) bool {
+	if key.Key == nil || *key.Key == "" {
+		return false
+	}
+
+	if key.NotBefore != nil && now.Before(*key.NotBefore) {
+		return false
+	}
+
+	if key.ExpiresAt != nil && !now.Before(*key.ExpiresAt) {
+		return false
+	}
+
+	return true
+}
+
+// SetStreamKey will record that the broadcaster who claimed the stream
+// uses the key, and use the key's overrides for the broadcast until they
+// disconnect.
+func SetStreamKey(streamKey string, remoteAddr string) {
+	key, found := findStreamKey(streamKey)
+
+	_lock.Lock()
+	_streamKey = streamKey
+	_remoteAddr = remoteAddr
+	_outputVariants = nil
+	_latencyLevel = nil
+	if found {
+		if key.OutputVariants != nil {
+			_outputVariants = outputVariantsFromAPI(*key.OutputVariants)
+		}
+		if key.LatencyLevel != nil {
+			level := models.GetLatencyLevel(*key.LatencyLevel)
+			_latencyLevel = &level
+		}
+	}
+	_lock.Unlock()
+
+	StreamKeyUsed(streamKey, remoteAddr)
+}
+
+// MatchesCurrentOutput returns if a broadcaster streaming with the key, and
+// the publish callback decision if there is one, gets the output variants
+// and latency level of the current broadcast. These can not be changed
+// while the transcoder is running.
+func MatchesCurrentOutput(streamKey string, decision *PublishDecision) bool {
+	configRepository := configrepository.Get()
+	variants := configRepository.GetStreamOutputVariants()
+	latencyLevel := configRepository.GetStreamLatencyLevel()
+	if key, found := findStreamKey(streamKey); found {
+		if key.OutputVariants != nil && len(*key.OutputVariants) > 0 {
+			variants = outputVariantsFromAPI(*key.OutputVariants)
+		}
+		if key.LatencyLevel != nil {
+			latencyLevel = models.GetLatencyLevel(*key.LatencyLevel)
+		}
+	}
+	if decision != nil && len(decision.OutputVariants) > 0 {
+		variants = decision.OutputVariants
+	}
+
+	return slices.Equal(variants, OutputVariants()) && latencyLevel == LatencyLevel()
+}
+
+// StreamKey returns the stream key the current broadcast was started with,
+// and the address of the broadcaster who started it.
+func StreamKey() (streamKey string, remoteAddr string) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	return _streamKey, _remoteAddr
+}
+
+// StreamKeyUsed will record when and from where the key was last streamed
+// with.
+func StreamKeyUsed(streamKey string, remoteAddr string) {
+	// The temporary stream key is not one of the configured keys.
+	if config.TemporaryStreamKey != "" {
+		return
+	}
+
+	_keysLock.Lock()
+	defer _keysLock.Unlock()
+
+	configRepository := configrepository.Get()
+	keys := configRepository.GetStreamKeys()
+	for i := range keys {
+		if keys[i].Key == nil || *keys[i].Key != streamKey {
+			continue
+		}
+
+		now := time.Now()
+		address := addressHost(remoteAddr)
+		keys[i].LastUsedAt = &now
+		keys[i].LastUsedAddress = &address
+		if err := configRepository.SetStreamKeys(keys); err != nil {
+			log.Errorln("unable to record the use of a stream key", err)
+		}
+		return
+	}
+}
+
+// SaveStreamKeys will save the keys broadcasters may stream with, keeping
+// the usage recorded for the keys that stay.
+func SaveStreamKeys(keys []generated.StreamKey) error {
+	_keysLock.Lock()
+	defer _keysLock.Unlock()
+
+	configRepository := configrepository.Get()
+	used := map[string]generated.StreamKey{}
+	for _, key := range configRepository.GetStreamKeys() {
+		if key.Key != nil {
+			used[*key.Key] = key
+		}
+	}
+
+	for i := range keys {
+		previous := used[*keys[i].Key]
+		keys[i].LastUsedAt = previous.LastUsedAt
+		keys[i].LastUsedAddress = previous.LastUsedAddress
+	}
+
+	return configRepository.SetStreamKeys(keys)
+}
+
+// DescribeStreamKey returns the label of the stream key and the last few
+// characters of it, without giving the key away.
+func DescribeStreamKey(streamKey string) (label string, hint string) {
+	if len(streamKey) >= minHintedStreamKeyLen {
+		hint = streamKey[len(streamKey)-streamKeyHintLength:]
+	}
+
+	if config.TemporaryStreamKey != "" && streamKey == config.TemporaryStreamKey {
+		return "Temporary stream key", hint
+	}
+
+	key, found := findStreamKey(streamKey)
+	if !found {
+		return "", hint
+	}
+
+	if key.Label != nil && *key.Label != "" {
+		return *key.Label, hint
+	}
+	if key.Comment != nil {
+		return *key.Comment, hint
+	}
+
+	return "", hint
+}
+
+func findStreamKey(streamKey string) (generated.StreamKey, bool) {
+	if config.TemporaryStreamKey != "" {
+		return generated.StreamKey{}, false
+	}
+
+	for _, key := range configrepository.Get().GetStreamKeys() {
+		if key.Key != nil && *key.Key == streamKey {
+			return key, true
+		}
+	}
+
+	return generated.StreamKey{}, false
+}
+
+// addressHost returns the IP address of a host and port.
+func addressHost(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+
+	return host
+}
+
+func outputVariantsFromAPI(variants []generated.StreamOutputVariant) []models.StreamOutputVariant {
+	converted := make([]models.StreamOutputVariant, 0, len(variants))
+	for _, variant := range variants {
+		converted = append(converted, models.StreamOutputVariant{
+			Name:               valueOf(variant.Name),
+			IsVideoPassthrough: valueOf(variant.VideoPassthrough),
+			IsAudioPassthrough: valueOf(variant.AudioPassthrough),
+			VideoBitrate:       valueOf(variant.VideoBitrate),
+			AudioBitrate:       valueOf(variant.AudioBitrate),
+			ScaledWidth:        valueOf(variant.ScaledWidth),
+			ScaledHeight:       valueOf(variant.ScaledHeight),
+			Framerate:          valueOf(variant.Framerate),
+			CPUUsageLevel:      valueOf(variant.CpuUsageLevel),
+		})
+	}
+
+	return converted
+}
+
+func valueOf[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+
+	return *value
+}
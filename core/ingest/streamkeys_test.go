@@ -0,0 +1,57 @@
+package ingest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
+)
+
+func TestStreamKeyActive(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+	key := "abc123"
+	empty := ""
+
+	tests := []struct {
+		name   string
+		key    generated.StreamKey
+		active bool
+	}{
+		{"no window", generated.StreamKey{Key: &key}, true},
+		{"inside the window", generated.StreamKey{Key: &key, NotBefore: &before, ExpiresAt: &after}, true},
+		{"not yet valid", generated.StreamKey{Key: &key, NotBefore: &after}, false},
+		{"expired", generated.StreamKey{Key: &key, ExpiresAt: &before}, false},
+		{"expires now", generated.StreamKey{Key: &key, ExpiresAt: &now}, false},
+		{"no key", generated.StreamKey{}, false},
+		{"empty key", generated.StreamKey{Key: &empty}, false},
+	}
+
+	for _, test := range tests {
+		if got := streamKeyActive(test.key, now); got != test.active {
+			t.Errorf("%s: expected %v, got %v", test.name, test.active, got)
+		}
+	}
+}
+
+func TestOutputVariantsFromAPI(t *testing.T) {
+	name := "720p"
+	bitrate := 2500
+	passthrough := true
+
+	variants := outputVariantsFromAPI([]generated.StreamOutputVariant{
+		{Name: &name, VideoBitrate: &bitrate},
+		{VideoPassthrough: &passthrough},
+	})
+
+	if len(variants) != 2 {
+		t.Fatalf("expected 2 variants, got %d", len(variants))
+	}
+	if variants[0].Name != name || variants[0].VideoBitrate != bitrate || variants[0].IsVideoPassthrough {
+		t.Errorf("unexpected first variant %+v", variants[0])
+	}
+	if !variants[1].IsVideoPassthrough || variants[1].Name != "" {
+		t.Errorf("unexpected second variant %+v", variants[1])
+	}
+}
@@ -0,0 +1,115 @@
+package playlist
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Interstitial keeps a live variant playlist going without the transcoder
+// by appending a looping clip after the segments it last wrote.
+type Interstitial struct {
+	header                []string
+	segments              []segment
+	uri                   string
+	duration              float64
+	window                int
+	targetDuration        int
+	mediaSequence         uint64
+	discontinuitySequence uint64
+}
+
+type segment struct {
+	lines         []string
+	discontinuity bool
+}
+
+// NewInterstitial returns an interstitial continuing the existing media
+// playlist, keeping at most window segments and looping the clip at uri.
+func NewInterstitial(existing string, window int, uri string, duration float64) *Interstitial {
+	p := &Interstitial{
+		uri:            uri,
+		duration:       duration,
+		window:         window,
+		targetDuration: int(math.Ceil(duration)),
+	}
+
+	current := segment{}
+	for _, line := range strings.Split(existing, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "", line == "#EXT-X-ENDLIST":
+		case strings.HasPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"):
+			p.mediaSequence, _ = strconv.ParseUint(strings.TrimPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"), 10, 64)
+		case strings.HasPrefix(line, "#EXT-X-DISCONTINUITY-SEQUENCE:"):
+			p.discontinuitySequence, _ = strconv.ParseUint(strings.TrimPrefix(line, "#EXT-X-DISCONTINUITY-SEQUENCE:"), 10, 64)
+		case strings.HasPrefix(line, "#EXT-X-TARGETDURATION:"):
+			if target, err := strconv.Atoi(strings.TrimPrefix(line, "#EXT-X-TARGETDURATION:")); err == nil && target > p.targetDuration {
+				p.targetDuration = target
+			}
+		case line == "#EXTM3U", strings.HasPrefix(line, "#EXT-X-VERSION:"), line == "#EXT-X-INDEPENDENT-SEGMENTS":
+			p.header = append(p.header, line)
+		case line == "#EXT-X-DISCONTINUITY":
+			current.discontinuity = true
+			current.lines = append(current.lines, line)
+		case strings.HasPrefix(line, "#"):
+			current.lines = append(current.lines, line)
+		default:
+			current.lines = append(current.lines, line)
+			p.segments = append(p.segments, current)
+			current = segment{}
+		}
+	}
+
+	if len(p.header) == 0 || p.header[0] != "#EXTM3U" {
+		p.header = append([]string{"#EXTM3U"}, p.header...)
+	}
+
+	return p
+}
+
+// Append adds another loop of the clip, removing the oldest segments
+// beyond the window.
+func (p *Interstitial) Append() {
+	// The clip's timestamps start over every time it plays.
+	p.segments = append(p.segments, segment{
+		lines: []string{
+			"#EXT-X-DISCONTINUITY",
+			fmt.Sprintf("#EXTINF:%f,", p.duration),
+			p.uri,
+		},
+		discontinuity: true,
+	})
+
+	for len(p.segments) > p.window && len(p.segments) > 1 {
+		if p.segments[0].discontinuity {
+			p.discontinuitySequence++
+		}
+		p.segments = p.segments[1:]
+		p.mediaSequence++
+	}
+}
+
+// String returns the playlist, without an end so players keep reloading it.
+func (p *Interstitial) String() string {
+	var b strings.Builder
+
+	for _, line := range p.header {
+		b.WriteString(line + "\n")
+	}
+	fmt.Fprintf(&b, "#EXT-X-TARGETDURATION:%d\n", p.targetDuration)
+	fmt.Fprintf(&b, "#EXT-X-MEDIA-SEQUENCE:%d\n", p.mediaSequence)
+	if p.discontinuitySequence > 0 {
+		fmt.Fprintf(&b, "#EXT-X-DISCONTINUITY-SEQUENCE:%d\n", p.discontinuitySequence)
+	}
+
+	for _, s := range p.segments {
+		for _, line := range s.lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
+	return b.String()
+}
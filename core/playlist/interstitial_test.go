@@ -0,0 +1,57 @@
+package playlist
+
+import (
+	"strings"
+	"testing"
+)
+
+const transcoderPlaylist = `#EXTM3U
+#EXT-X-VERSION:6
+#EXT-X-TARGETDURATION:3
+#EXT-X-MEDIA-SEQUENCE:100
+#EXT-X-INDEPENDENT-SEGMENTS
+#EXT-X-PROGRAM-DATE-TIME:2026-10-17T10:00:00.000+0000
+#EXTINF:3.000000,
+stream-abc-100.ts
+#EXTINF:3.000000,
+stream-abc-101.ts
+#EXTINF:3.000000,
+stream-abc-102.ts
+`
+
+func TestInterstitial(t *testing.T) {
+	p := NewInterstitial(transcoderPlaylist, 3, "reconnecting.ts", 8)
+	p.Append()
+
+	got := p.String()
+	if strings.Contains(got, "#EXT-X-ENDLIST") {
+		t.Error("expected the playlist to stay live")
+	}
+	for _, want := range []string{
+		"#EXT-X-VERSION:6\n",
+		"#EXT-X-INDEPENDENT-SEGMENTS\n",
+		"#EXT-X-TARGETDURATION:8\n",
+		"#EXT-X-MEDIA-SEQUENCE:101\n",
+		"stream-abc-102.ts\n#EXT-X-DISCONTINUITY\n#EXTINF:8.000000,\nreconnecting.ts\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "stream-abc-100.ts") || strings.Contains(got, "2026-10-17T10:00:00") {
+		t.Errorf("expected the oldest segment and its tags to slide out of the window\n%s", got)
+	}
+
+	// Slide the first discontinuity out of the window.
+	p.Append()
+	p.Append()
+	p.Append()
+
+	got = p.String()
+	if !strings.Contains(got, "#EXT-X-MEDIA-SEQUENCE:104\n") || !strings.Contains(got, "#EXT-X-DISCONTINUITY-SEQUENCE:1\n") {
+		t.Errorf("expected the sequence numbers to follow the removed segments\n%s", got)
+	}
+	if count := strings.Count(got, "reconnecting.ts"); count != 3 {
+		t.Errorf("expected the window to hold 3 loops of the clip, got %d", count)
+	}
+}
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/playlist"
+	"github.com/TekkadanPlays/oni/core/rtmp"
+	"github.com/TekkadanPlays/oni/core/srt"
+	"github.com/TekkadanPlays/oni/core/transcoder"
+	"github.com/TekkadanPlays/oni/core/whip"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/static"
+	"github.com/TekkadanPlays/oni/utils"
+)
+
+const reconnectingFilename = "reconnecting.ts"
+
+// If the reconnecting clip gets changed then change the duration below.
+const reconnectingClipDuration = 8
+
+var (
+	_reconnectLock       sync.Mutex
+	_reconnecting        bool
+	_disconnectRequested bool
+	_reconnectGraceTimer *time.Timer
+	_interstitial        *reconnectingInterstitial
+)
+
+// DisconnectInboundConnection will end the broadcast without waiting for
+// the broadcaster to reconnect.
+func DisconnectInboundConnection() {
+	_reconnectLock.Lock()
+	reconnecting := _reconnecting
+	// Nothing would take the request if no broadcast is running, and it
+	// would otherwise stop the next one from waiting for a reconnect.
+	_disconnectRequested = !reconnecting && _currentBroadcast != nil
+	_reconnectLock.Unlock()
+
+	if reconnecting {
+		endReconnectGracePeriod()
+		return
+	}
+
+	rtmp.Disconnect()
+	srt.Disconnect()
+	whip.Disconnect()
+}
+
+// IsReconnecting returns if the broadcast is waiting for the broadcaster
+// to reconnect.
+func IsReconnecting() bool {
+	_reconnectLock.Lock()
+	defer _reconnectLock.Unlock()
+
+	return _reconnecting
+}
+
+// startReconnectGracePeriod will keep the broadcast alive after the
+// inbound stream ended, showing the reconnecting clip until the
+// broadcaster returns or the grace period is over. Returns false if the
+// stream should go offline right away instead.
+func startReconnectGracePeriod() bool {
+	gracePeriod := configrepository.Get().GetReconnectGracePeriod()
+
+	_reconnectLock.Lock()
+	defer _reconnectLock.Unlock()
+
+	disconnectRequested := _disconnectRequested
+	_disconnectRequested = false
+	if gracePeriod <= 0 || disconnectRequested || _currentBroadcast == nil {
+		return false
+	}
+
+	log.Infoln("Inbound stream ended, waiting", gracePeriod, "for the broadcaster to reconnect.")
+
+	// The transcoder may have stopped on its own, so make the broadcaster
+	// reconnect with a fresh connection.
+	rtmp.Disconnect()
+	srt.Disconnect()
+	whip.Disconnect()
+	transcoder.StopThumbnailGenerator()
+
+	_reconnecting = true
+	_interstitial = startReconnectingInterstitial(_currentBroadcast.LatencyLevel.SegmentCount, len(_currentBroadcast.OutputSettings))
+	_reconnectGraceTimer = time.AfterFunc(gracePeriod, endReconnectGracePeriod)
+
+	return true
+}
+
+// endReconnectGracePeriod will take the broadcast offline when the
+// broadcaster did not reconnect in time.
+func endReconnectGracePeriod() {
+	_reconnectLock.Lock()
+	if !_reconnecting {
+		_reconnectLock.Unlock()
+		return
+	}
+	stopReconnecting()
+	_reconnectLock.Unlock()
+
+	log.Infoln("The broadcaster did not reconnect, ending the stream.")
+	SetStreamAsDisconnected()
+	_currentBroadcast = nil
+}
+
+// resumeBroadcast will continue the broadcast with the new inbound stream
+// if it is waiting for the broadcaster to reconnect.
+func resumeBroadcast(rtmpOut *io.PipeReader) bool {
+	_reconnectLock.Lock()
+	if !_reconnecting {
+		_reconnectLock.Unlock()
+		return false
+	}
+	stopReconnecting()
+	_reconnectLock.Unlock()
+
+	log.Infoln("The broadcaster reconnected, resuming the stream.")
+
+	startTranscoder(rtmpOut, true)
+	selectedThumbnailVideoQualityIndex, isVideoPassthrough := configrepository.Get().FindHighestVideoQualityIndex(_currentBroadcast.OutputSettings)
+	transcoder.StartThumbnailGenerator(config.HLSStoragePath, selectedThumbnailVideoQualityIndex, isVideoPassthrough)
+
+	return true
+}
+
+// Must be called with the lock held.
+func stopReconnecting() {
+	_reconnecting = false
+	if _reconnectGraceTimer != nil {
+		_reconnectGraceTimer.Stop()
+	}
+	if _interstitial != nil {
+		_interstitial.stop()
+		_interstitial = nil
+	}
+}
+
+// reconnectingInterstitial loops the reconnecting clip in the variant
+// playlists.
+type reconnectingInterstitial struct {
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func startReconnectingInterstitial(segmentCount int, variantCount int) *reconnectingInterstitial {
+	i := &reconnectingInterstitial{
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	clipFilePath, err := saveReconnectingClipToDisk()
+	if err != nil {
+		log.Errorln(err)
+		close(i.stopped)
+		return i
+	}
+
+	playlists := map[string]*playlist.Interstitial{}
+	for index := 0; index < variantCount; index++ {
+		playlistFilePath := fmt.Sprintf(filepath.Join(config.HLSStoragePath, "%d/stream.m3u8"), index)
+		segmentFilePath := fmt.Sprintf(filepath.Join(config.HLSStoragePath, "%d/%s"), index, reconnectingFilename)
+
+		existingPlaylistContents, err := os.ReadFile(playlistFilePath) // nolint: gosec
+		if err != nil {
+			log.Debugln("unable to read existing playlist file", err)
+			continue
+		}
+
+		if err := utils.Copy(clipFilePath, segmentFilePath); err != nil {
+			log.Warnln(err)
+			continue
+		}
+		if _, err := _storage.Save(segmentFilePath, 0); err != nil {
+			log.Warnln(err)
+		}
+
+		playlists[playlistFilePath] = playlist.NewInterstitial(string(existingPlaylistContents), segmentCount, reconnectingFilename, reconnectingClipDuration)
+	}
+
+	go func() {
+		defer close(i.stopped)
+
+		ticker := time.NewTicker(reconnectingClipDuration * time.Second)
+		defer ticker.Stop()
+
+		for {
+			for playlistFilePath, p := range playlists {
+				p.Append()
+				if err := playlist.WritePlaylist(p.String(), playlistFilePath); err != nil {
+					log.Errorln(err)
+					continue
+				}
+				if _, err := _storage.Save(playlistFilePath, 0); err != nil {
+					log.Warnln(err)
+				}
+			}
+
+			select {
+			case <-i.done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return i
+}
+
+// stop will stop looping the clip, returning once the playlists are no
+// longer being written.
+func (i *reconnectingInterstitial) stop() {
+	close(i.done)
+	<-i.stopped
+}
+
+func saveReconnectingClipToDisk() (string, error) {
+	clipTmpFile, err := os.CreateTemp(config.TempDir, reconnectingFilename)
+	if err != nil {
+		return "", fmt.Errorf("unable to create temp file for reconnecting video segment: %s", err)
+	}
+	defer clipTmpFile.Close()
+
+	if _, err = clipTmpFile.Write(static.GetReconnectingSegment()); err != nil {
+		return "", fmt.Errorf("unable to write reconnecting segment to disk: %s", err)
+	}
+
+	return filepath.Abs(clipTmpFile.Name())
+}
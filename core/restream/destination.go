@@ -0,0 +1,311 @@
+package restream
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nareix/joy5/av"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/TekkadanPlays/oni/core/webhooks"
+	"github.com/TekkadanPlays/oni/models"
+)
+
+// States a destination can be in.
+const (
+	StateConnecting   = "connecting"
+	StateConnected    = "connected"
+	StateReconnecting = "reconnecting"
+)
+
+const (
+	// How many packets are buffered for a destination before it misses
+	// packets. Around ten seconds of a typical stream.
+	packetBufferSize = 1024
+
+	// How long to wait before reconnecting to a failed destination. The
+	// wait doubles with every failure, and starts over once a connection
+	// stayed up for stableConnection.
+	minBackoff       = 1 * time.Second
+	maxBackoff       = 60 * time.Second
+	stableConnection = 30 * time.Second
+
+	// How often the forwarded bitrate is calculated.
+	bitrateInterval = 2 * time.Second
+)
+
+// sendWebhook sends destination status changes to webhooks.
+var sendWebhook = webhooks.SendRestreamEvent
+
+// destination forwards the stream to a single external service,
+// reconnecting on its own when the connection fails.
+type destination struct {
+	config  models.RestreamDestination
+	headers func() []av.Packet
+	packets chan av.Packet
+	quit    chan struct{}
+
+	// Set when the destination missed packets, so it skips ahead to the
+	// next keyframe. Only used by the inbound stream.
+	resync bool
+	// If the destination is connected, so missed packets are counted.
+	forwarding atomic.Bool
+
+	status         models.RestreamStatus
+	conn           *connection
+	stopped        bool
+	failing        bool
+	bytes          int
+	bitrateSampled time.Time
+	lock           sync.Mutex
+}
+
+func newDestination(config models.RestreamDestination, headers func() []av.Packet) *destination {
+	return &destination{
+		config:  config,
+		headers: headers,
+		packets: make(chan av.Packet, packetBufferSize),
+		quit:    make(chan struct{}),
+		status: models.RestreamStatus{
+			ID:    config.ID,
+			Name:  config.Name,
+			URL:   config.URL,
+			State: StateConnecting,
+		},
+	}
+}
+
+// offer queues a packet for the destination without waiting for it.
+func (d *destination) offer(pkt av.Packet) {
+	if d.resync && !isHeader(pkt) {
+		if !isKeyframe(pkt) {
+			d.dropped()
+			return
+		}
+		d.resync = false
+	}
+
+	select {
+	case d.packets <- pkt:
+	default:
+		d.resync = true
+		d.dropped()
+	}
+}
+
+func (d *destination) dropped() {
+	if !d.forwarding.Load() {
+		return
+	}
+
+	d.lock.Lock()
+	d.status.DroppedPackets++
+	d.lock.Unlock()
+}
+
+// stop ends forwarding to the destination. It does not wait for the
+// connection to close.
+func (d *destination) stop() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.stopped {
+		return
+	}
+
+	d.stopped = true
+	close(d.quit)
+	if d.conn != nil {
+		_ = d.conn.Close()
+	}
+}
+
+func (d *destination) isStopped() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.stopped
+}
+
+func (d *destination) run() {
+	backoff := minBackoff
+
+	for {
+		connectedAt, err := d.forward()
+		if d.isStopped() {
+			d.disconnected(nil, connectedAt)
+			return
+		}
+
+		if !connectedAt.IsZero() && time.Since(connectedAt) >= stableConnection {
+			backoff = minBackoff
+		}
+
+		log.Warnf("Restreaming to %s failed, retrying in %s: %s", d.config.Name, backoff, err)
+		d.disconnected(err, connectedAt)
+
+		if !d.wait(backoff) {
+			return
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// wait discards the stream until it is time to reconnect. Returns false if
+// the destination was stopped in the meantime.
+func (d *destination) wait(backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-d.quit:
+			return false
+		case <-d.packets:
+		case <-timer.C:
+			d.setState(StateConnecting)
+			return true
+		}
+	}
+}
+
+// forward connects to the destination and sends it the stream until the
+// connection fails or the destination is stopped. Returns when the
+// connection was established, if it was.
+func (d *destination) forward() (time.Time, error) {
+	c, err := dial(d.config)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer c.Close()
+
+	d.lock.Lock()
+	if d.stopped {
+		d.lock.Unlock()
+		return time.Time{}, nil
+	}
+	d.conn = c
+	d.lock.Unlock()
+
+	connectedAt := time.Now()
+	d.connected(connectedAt)
+
+	// Skip what queued up while connecting, so the destination is not
+	// behind the inbound stream.
+	for len(d.packets) > 0 {
+		<-d.packets
+	}
+
+	for _, header := range d.headers() {
+		header.Time = 0
+		if err := d.write(c, header); err != nil {
+			return connectedAt, err
+		}
+	}
+
+	// Each connection starts at a keyframe, with timestamps from zero.
+	var offset time.Duration
+	started := false
+
+	for {
+		select {
+		case <-d.quit:
+			return connectedAt, nil
+		case pkt := <-d.packets:
+			switch {
+			case started:
+				pkt.Time = max(pkt.Time-offset, 0)
+			case isHeader(pkt):
+				pkt.Time = 0
+			case isKeyframe(pkt):
+				started = true
+				offset = pkt.Time
+				pkt.Time = 0
+			default:
+				continue
+			}
+
+			if err := d.write(c, pkt); err != nil {
+				return connectedAt, err
+			}
+		}
+	}
+}
+
+func (d *destination) write(c *connection, pkt av.Packet) error {
+	if err := c.writePacket(pkt); err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.bytes += len(pkt.Data)
+	if elapsed := time.Since(d.bitrateSampled); elapsed >= bitrateInterval {
+		d.status.Bitrate = int(float64(d.bytes*8) / elapsed.Seconds() / 1000)
+		d.bytes = 0
+		d.bitrateSampled = time.Now()
+	}
+
+	return nil
+}
+
+func (d *destination) setState(state string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.status.State = state
+}
+
+func (d *destination) connected(connectedAt time.Time) {
+	d.lock.Lock()
+	d.status.State = StateConnected
+	d.status.ConnectedAt = &connectedAt
+	d.status.LastError = ""
+	d.status.Bitrate = 0
+	d.bytes = 0
+	d.bitrateSampled = connectedAt
+	d.failing = false
+	status := d.status
+	d.lock.Unlock()
+
+	d.forwarding.Store(true)
+	log.Infof("Restreaming to %s.", d.config.Name)
+	go sendWebhook(models.RestreamConnected, status)
+}
+
+// disconnected records why the connection ended. Only the first failure
+// of an outage is sent to webhooks, not every failed reconnection.
+func (d *destination) disconnected(err error, connectedAt time.Time) {
+	d.forwarding.Store(false)
+
+	d.lock.Lock()
+	d.conn = nil
+	d.status.ConnectedAt = nil
+	d.status.Bitrate = 0
+	wasConnected := !connectedAt.IsZero()
+	notify := wasConnected || !d.failing
+	if err != nil {
+		d.status.State = StateReconnecting
+		d.status.LastError = err.Error()
+		d.status.Reconnects++
+		d.failing = true
+	}
+	status := d.status
+	d.lock.Unlock()
+
+	if err == nil && !wasConnected {
+		return
+	}
+	if notify {
+		go sendWebhook(models.RestreamDisconnected, status)
+	}
+}
+
+func (d *destination) getStatus() models.RestreamStatus {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.status
+}
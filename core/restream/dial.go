@@ -0,0 +1,110 @@
+package restream
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nareix/joy5/av"
+	"github.com/nareix/joy5/format/rtmp"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+const (
+	// How long connecting to a destination, or sending it a packet, may
+	// take before it is treated as failed.
+	dialTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+var errUnsupportedScheme = errors.New("destination url must start with rtmp:// or rtmps://")
+
+// connection is an RTMP connection publishing to a destination.
+type connection struct {
+	net.Conn
+	rtmp   *rtmp.Conn
+	writer *bufio.Writer
+}
+
+// bufferedConn buffers the RTMP connection, like the joy5 client does, but
+// lets every packet be flushed as soon as it is written.
+type bufferedConn struct {
+	*bufio.Reader
+	*bufio.Writer
+}
+
+// writePacket sends the packet to the destination right away.
+func (c *connection) writePacket(pkt av.Packet) error {
+	if err := c.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	if err := c.rtmp.WritePacket(pkt); err != nil {
+		return err
+	}
+
+	return c.writer.Flush()
+}
+
+// publishURL returns the URL to publish to, with the stream key as the
+// last path segment.
+func publishURL(config models.RestreamDestination) string {
+	if config.StreamKey == "" {
+		return config.URL
+	}
+
+	return strings.TrimRight(config.URL, "/") + "/" + config.StreamKey
+}
+
+// dial connects to the destination and starts publishing to it.
+func dial(config models.RestreamDestination) (*connection, error) {
+	u, err := url.Parse(publishURL(config))
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	host := rtmp.UrlGetHost(u)
+
+	var nc net.Conn
+	switch u.Scheme {
+	case "rtmp":
+		nc, err = dialer.Dial("tcp", host)
+	case "rtmps":
+		nc, err = tls.DialWithDialer(dialer, "tcp", host, &tls.Config{
+			ServerName: u.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		})
+	default:
+		err = errUnsupportedScheme
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	writer := bufio.NewWriterSize(nc, rtmp.BufioSize)
+	conn := rtmp.NewConn(&bufferedConn{
+		Reader: bufio.NewReaderSize(nc, rtmp.BufioSize),
+		Writer: writer,
+	})
+	conn.URL = u
+
+	if err := nc.SetDeadline(time.Now().Add(dialTimeout)); err != nil {
+		_ = nc.Close()
+		return nil, err
+	}
+	if err := conn.Prepare(rtmp.StageGotPublishOrPlayCommand, rtmp.PrepareWriting); err != nil {
+		_ = nc.Close()
+		return nil, err
+	}
+	if err := nc.SetDeadline(time.Time{}); err != nil {
+		_ = nc.Close()
+		return nil, err
+	}
+
+	return &connection{Conn: nc, rtmp: conn, writer: writer}, nil
+}
@@ -0,0 +1,149 @@
+// Package restream forwards the inbound RTMP stream to external RTMP and
+// RTMPS services, so a broadcast can go out to other platforms as well.
+package restream
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nareix/joy5/av"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+var (
+	_destinations = map[string]*destination{}
+	_live         bool
+	_lock         sync.Mutex
+
+	// The latest decoder configuration and metadata packets, which every
+	// new connection to a destination starts with.
+	_headers []av.Packet
+)
+
+// Start will begin forwarding the stream to the enabled destinations.
+func Start() {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	_live = true
+	_headers = nil
+	startDestinations(configrepository.Get().GetRestreamDestinations())
+}
+
+// Stop will stop forwarding the stream to every destination.
+func Stop() {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	_live = false
+	_headers = nil
+	for id, d := range _destinations {
+		d.stop()
+		delete(_destinations, id)
+	}
+}
+
+// Reload will apply changed destinations to the running stream, leaving
+// the unchanged ones connected.
+func Reload() {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if !_live {
+		return
+	}
+
+	configured := configrepository.Get().GetRestreamDestinations()
+	enabled := map[string]models.RestreamDestination{}
+	for _, config := range configured {
+		if config.Enabled {
+			enabled[config.ID] = config
+		}
+	}
+
+	for id, d := range _destinations {
+		if config, ok := enabled[id]; !ok || config != d.config {
+			d.stop()
+			delete(_destinations, id)
+		}
+	}
+
+	startDestinations(configured)
+}
+
+func startDestinations(configured []models.RestreamDestination) {
+	for _, config := range configured {
+		if _, running := _destinations[config.ID]; running || !config.Enabled {
+			continue
+		}
+
+		d := newDestination(config, currentHeaders)
+		_destinations[config.ID] = d
+		go d.run()
+	}
+}
+
+// WritePacket will forward a packet of the inbound stream to every
+// destination. It never blocks, a destination that can not keep up misses
+// packets instead.
+func WritePacket(pkt av.Packet) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if !_live {
+		return
+	}
+
+	if isHeader(pkt) {
+		saveHeader(pkt)
+	}
+
+	for _, d := range _destinations {
+		d.offer(pkt)
+	}
+}
+
+// Status returns the state of every destination the stream is forwarded to.
+func Status() []models.RestreamStatus {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	statuses := []models.RestreamStatus{}
+	for _, d := range _destinations {
+		statuses = append(statuses, d.getStatus())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+// saveHeader replaces the saved header of the same type as the packet.
+func saveHeader(pkt av.Packet) {
+	for i, header := range _headers {
+		if header.Type == pkt.Type {
+			_headers[i] = pkt
+			return
+		}
+	}
+
+	_headers = append(_headers, pkt)
+}
+
+func currentHeaders() []av.Packet {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	return append([]av.Packet{}, _headers...)
+}
+
+func isHeader(pkt av.Packet) bool {
+	return pkt.Type == av.H264DecoderConfig || pkt.Type == av.AACDecoderConfig || pkt.Type == av.Metadata
+}
+
+func isKeyframe(pkt av.Packet) bool {
+	return pkt.Type == av.H264 && pkt.IsKeyFrame
+}
@@ -0,0 +1,259 @@
+package restream
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nareix/joy5/av"
+	"github.com/nareix/joy5/format/rtmp"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+// recordWebhooks captures the webhook events sent during the test.
+func recordWebhooks(t *testing.T) func() []models.EventType {
+	t.Helper()
+
+	var events []models.EventType
+	var lock sync.Mutex
+
+	previous := sendWebhook
+	sendWebhook = func(eventType models.EventType, _ models.RestreamStatus) {
+		lock.Lock()
+		events = append(events, eventType)
+		lock.Unlock()
+	}
+	t.Cleanup(func() { sendWebhook = previous })
+
+	return func() []models.EventType {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return append([]models.EventType{}, events...)
+	}
+}
+
+// listen starts an RTMP server that sends the packets it receives to the
+// returned channel.
+func listen(t *testing.T) (string, chan av.Packet) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = lis.Close() })
+
+	received := make(chan av.Packet, 16)
+	s := rtmp.NewServer()
+	s.HandleConn = func(c *rtmp.Conn, nc net.Conn) {
+		defer nc.Close()
+		if c.URL.Path != "/live/abc123" {
+			return
+		}
+		for {
+			pkt, err := c.ReadPacket()
+			if err != nil {
+				return
+			}
+			received <- pkt
+		}
+	}
+
+	go func() {
+		for {
+			nc, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go s.HandleNetConn(nc)
+		}
+	}()
+
+	return lis.Addr().String(), received
+}
+
+// start runs the destination until the test ends.
+func start(t *testing.T, d *destination) {
+	t.Helper()
+
+	finished := make(chan struct{})
+	go func() {
+		d.run()
+		close(finished)
+	}()
+	t.Cleanup(func() {
+		d.stop()
+		<-finished
+	})
+}
+
+func waitForState(t *testing.T, d *destination, state string) models.RestreamStatus {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if status := d.getStatus(); status.State == state {
+			return status
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("destination did not become %s, it is %s", state, d.getStatus().State)
+
+	return models.RestreamStatus{}
+}
+
+func TestForwarding(t *testing.T) {
+	events := recordWebhooks(t)
+	addr, received := listen(t)
+
+	header := av.Packet{Type: av.H264DecoderConfig, Data: []byte{0x01, 0x64, 0x00, 0x1F}}
+	d := newDestination(models.RestreamDestination{
+		ID:        "local",
+		Name:      "Local",
+		URL:       "rtmp://" + addr + "/live/",
+		StreamKey: "abc123",
+		Enabled:   true,
+	}, func() []av.Packet { return []av.Packet{header} })
+	start(t, d)
+
+	waitForState(t, d, StateConnected)
+
+	// The stream is forwarded from the next keyframe, with timestamps
+	// starting from zero.
+	d.offer(av.Packet{Type: av.H264, Time: 5 * time.Second, Data: []byte{0x01}})
+	d.offer(av.Packet{Type: av.H264, Time: 6 * time.Second, IsKeyFrame: true, Data: []byte{0x02}})
+	d.offer(av.Packet{Type: av.H264, Time: 6*time.Second + 33*time.Millisecond, Data: []byte{0x03}})
+
+	expected := []struct {
+		packetType int
+		time       time.Duration
+		data       byte
+	}{
+		{av.H264DecoderConfig, 0, 0x01},
+		{av.H264, 0, 0x02},
+		{av.H264, 33 * time.Millisecond, 0x03},
+	}
+
+	for _, want := range expected {
+		select {
+		case pkt := <-received:
+			if pkt.Type != want.packetType || pkt.Time != want.time || pkt.Data[0] != want.data {
+				t.Errorf("expected %s at %s starting %x, got %s at %s starting %x", av.PacketTypeString[want.packetType], want.time, want.data, av.PacketTypeString[pkt.Type], pkt.Time, pkt.Data[0])
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a forwarded packet")
+		}
+	}
+
+	if got := events(); len(got) != 1 || got[0] != models.RestreamConnected {
+		t.Errorf("expected a connected webhook, got %v", got)
+	}
+}
+
+func TestFailingDestination(t *testing.T) {
+	events := recordWebhooks(t)
+
+	// Nothing listens on the port once the listener is closed.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	_ = lis.Close()
+
+	d := newDestination(models.RestreamDestination{
+		ID:      "offline",
+		Name:    "Offline",
+		URL:     "rtmp://" + addr + "/live/abc123",
+		Enabled: true,
+	}, func() []av.Packet { return nil })
+	start(t, d)
+
+	status := waitForState(t, d, StateReconnecting)
+	if status.LastError == "" || status.Reconnects != 1 {
+		t.Errorf("expected the failure to be reported, got %+v", status)
+	}
+
+	// The inbound stream is never held up by the failing destination.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 4*packetBufferSize; i++ {
+			d.offer(av.Packet{Type: av.H264, Time: time.Duration(i) * time.Millisecond})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("offering packets to a failing destination blocked")
+	}
+
+	if dropped := d.getStatus().DroppedPackets; dropped != 0 {
+		t.Errorf("expected packets missed while disconnected not to count as dropped, got %d", dropped)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for len(events()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := events(); len(got) != 1 || got[0] != models.RestreamDisconnected {
+		t.Errorf("expected a disconnected webhook, got %v", got)
+	}
+}
+
+func TestOfferResync(t *testing.T) {
+	d := newDestination(models.RestreamDestination{ID: "slow"}, func() []av.Packet { return nil })
+	d.forwarding.Store(true)
+
+	for i := 0; i < packetBufferSize; i++ {
+		d.offer(av.Packet{Type: av.H264})
+	}
+
+	// A full buffer drops the packet, then everything up to the next
+	// keyframe apart from decoder configuration.
+	d.offer(av.Packet{Type: av.H264})
+	for len(d.packets) > 0 {
+		<-d.packets
+	}
+	d.offer(av.Packet{Type: av.AAC})
+	d.offer(av.Packet{Type: av.H264DecoderConfig})
+	d.offer(av.Packet{Type: av.H264, IsKeyFrame: true})
+	d.offer(av.Packet{Type: av.AAC})
+
+	if dropped := d.getStatus().DroppedPackets; dropped != 2 {
+		t.Errorf("expected 2 dropped packets, got %d", dropped)
+	}
+
+	expected := []int{av.H264DecoderConfig, av.H264, av.AAC}
+	if len(d.packets) != len(expected) {
+		t.Fatalf("expected %d queued packets, got %d", len(expected), len(d.packets))
+	}
+	for _, packetType := range expected {
+		if pkt := <-d.packets; pkt.Type != packetType {
+			t.Errorf("expected %s, got %s", av.PacketTypeString[packetType], av.PacketTypeString[pkt.Type])
+		}
+	}
+}
+
+func TestPublishURL(t *testing.T) {
+	tests := []struct {
+		url       string
+		streamKey string
+		want      string
+	}{
+		{"rtmp://a.rtmp.youtube.com/live2", "abcd-1234", "rtmp://a.rtmp.youtube.com/live2/abcd-1234"},
+		{"rtmps://live.example.com:443/app/", "key", "rtmps://live.example.com:443/app/key"},
+		{"rtmp://live.example.com/app/key", "", "rtmp://live.example.com/app/key"},
+	}
+
+	for _, test := range tests {
+		got := publishURL(models.RestreamDestination{URL: test.url, StreamKey: test.streamKey})
+		if got != test.want {
+			t.Errorf("expected %s, got %s", test.want, got)
+		}
+	}
+}
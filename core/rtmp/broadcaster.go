@@ -8,14 +8,21 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func setCurrentBroadcasterInfo(t flvio.Tag, remoteAddr string) {
+func setCurrentBroadcasterInfo(t flvio.Tag, remoteAddr string, protocol string) {
+	_setBroadcaster(broadcasterFromMetadata(t, remoteAddr, protocol))
+}
+
+// broadcasterFromMetadata returns the broadcaster the onMetaData tag
+// describes.
+func broadcasterFromMetadata(t flvio.Tag, remoteAddr string, protocol string) models.Broadcaster {
 	data, err := getInboundDetailsFromMetadata(t.DebugFields())
 	if err != nil {
 		log.Traceln("Unable to parse inbound broadcaster details:", err)
 	}
 
-	broadcaster := models.Broadcaster{
+	return models.Broadcaster{
 		RemoteAddr: remoteAddr,
+		Protocol:   protocol,
 		Time:       time.Now(),
 		StreamDetails: models.InboundStreamDetails{
 			Width:          data.Width,
@@ -29,6 +36,4 @@ func setCurrentBroadcasterInfo(t flvio.Tag, remoteAddr string) {
 			VideoOnly:      data.AudioCodec == nil,
 		},
 	}
-
-	_setBroadcaster(broadcaster)
 }
@@ -0,0 +1,113 @@
+package rtmp
+
+import (
+	"crypto/tls"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// How often the certificate files are checked for changes, such as when
+// they were renewed.
+const certificateCheckInterval = 30 * time.Second
+
+// certificateReloader serves the RTMPS certificate, loading it again on
+// SIGHUP or when its files change. A certificate that fails to load
+// leaves the previous one in use.
+type certificateReloader struct {
+	certificatePath string
+	keyPath         string
+
+	certificate *tls.Certificate
+	modTime     time.Time
+	lock        sync.RWMutex
+}
+
+func newCertificateReloader(certificatePath string, keyPath string) (*certificateReloader, error) {
+	r := &certificateReloader{certificatePath: certificatePath, keyPath: keyPath}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, for tls.Config.
+func (r *certificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.certificate, nil
+}
+
+func (r *certificateReloader) reload() error {
+	modTime := r.filesModTime()
+
+	certificate, err := tls.LoadX509KeyPair(r.certificatePath, r.keyPath)
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	r.certificate = &certificate
+	r.modTime = modTime
+	r.lock.Unlock()
+
+	return nil
+}
+
+// reloadIfChanged will load the certificate again if its files changed
+// since it was last loaded.
+func (r *certificateReloader) reloadIfChanged() {
+	r.lock.RLock()
+	changed := !r.filesModTime().Equal(r.modTime)
+	r.lock.RUnlock()
+
+	if !changed {
+		return
+	}
+
+	if err := r.reload(); err != nil {
+		log.Errorln("Unable to reload the changed RTMPS certificate, keeping the previous one.", err)
+		return
+	}
+	log.Infoln("Reloaded the changed RTMPS certificate.")
+}
+
+// filesModTime returns when the certificate or key file last changed.
+func (r *certificateReloader) filesModTime() time.Time {
+	var latest time.Time
+	for _, path := range []string{r.certificatePath, r.keyPath} {
+		if info, err := os.Stat(path); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest
+}
+
+// watch will reload the certificate on SIGHUP or when its files change.
+func (r *certificateReloader) watch() {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	ticker := time.NewTicker(certificateCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-hangup:
+			if err := r.reload(); err != nil {
+				log.Errorln("Unable to reload the RTMPS certificate, keeping the previous one.", err)
+				continue
+			}
+			log.Infoln("Reloaded the RTMPS certificate.")
+		case <-ticker.C:
+			r.reloadIfChanged()
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package rtmp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCertificate writes a self-signed certificate for the name.
+func writeCertificate(t *testing.T, certificatePath string, keyPath string, name string, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(certificatePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{certificatePath, keyPath} {
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func servedName(t *testing.T, r *certificateReloader) string {
+	t.Helper()
+
+	certificate, err := r.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return leaf.Subject.CommonName
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir := t.TempDir()
+	certificatePath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	issued := time.Now().Add(-time.Hour)
+
+	writeCertificate(t, certificatePath, keyPath, "first.example", issued)
+	r, err := newCertificateReloader(certificatePath, keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.reloadIfChanged()
+	if name := servedName(t, r); name != "first.example" {
+		t.Fatalf("expected the first certificate, got %s", name)
+	}
+
+	// A renewal replaces the files.
+	writeCertificate(t, certificatePath, keyPath, "renewed.example", issued.Add(time.Minute))
+	r.reloadIfChanged()
+	if name := servedName(t, r); name != "renewed.example" {
+		t.Errorf("expected the renewed certificate, got %s", name)
+	}
+
+	// A broken certificate keeps the previous one in use.
+	if err := os.WriteFile(certificatePath, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(certificatePath, issued.Add(2*time.Minute), issued.Add(2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	r.reloadIfChanged()
+	if name := servedName(t, r); name != "renewed.example" {
+		t.Errorf("expected the renewed certificate to stay in use, got %s", name)
+	}
+}
@@ -0,0 +1,203 @@
+package rtmp
+
+import (
+	"time"
+
+	"github.com/nareix/joy5/av"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+const (
+	// How far back the inbound bitrate is measured.
+	bitrateWindow = 10 * time.Second
+
+	// A timestamp moving this far from the previous one of the same track
+	// is a jump, rather than a gap between frames.
+	timestampJumpThreshold = 2 * time.Second
+
+	// Packets arriving this much later than their timestamps say, compared
+	// to the most punctual one, are late.
+	latePacketThreshold = 2 * time.Second
+)
+
+type arrival struct {
+	time time.Time
+	size int
+}
+
+// streamAnalyzer measures the health of the inbound stream from the
+// packets the publisher sends.
+type streamAnalyzer struct {
+	startedAt time.Time
+	arrivals  []arrival
+
+	// Timing of the first packet, against which it is judged if the
+	// following ones are late. Reset when the timestamps jump.
+	baselineArrival   time.Time
+	baselineTimestamp time.Duration
+	minLag            time.Duration
+	hasBaseline       bool
+
+	lastVideo time.Duration
+	lastAudio time.Duration
+	hasVideo  bool
+	hasAudio  bool
+
+	lastKeyframe     time.Duration
+	keyframeInterval time.Duration
+	hasKeyframe      bool
+
+	// The shortest time between two video frames, taken as the frame rate.
+	frameDuration time.Duration
+
+	timestampJumps int
+	droppedFrames  int
+	latePackets    int
+}
+
+func newStreamAnalyzer(now time.Time) *streamAnalyzer {
+	return &streamAnalyzer{startedAt: now}
+}
+
+// packet records a packet that arrived from the publisher.
+func (a *streamAnalyzer) packet(pkt av.Packet, now time.Time) {
+	a.arrivals = append(a.arrivals, arrival{time: now, size: len(pkt.Data)})
+	a.pruneArrivals(now)
+
+	// Only audio and video frames are timed, the rest carry codec setup.
+	var jumped bool
+	switch pkt.Type {
+	case av.H264:
+		jumped = a.video(pkt)
+	case av.AAC:
+		jumped = a.audio(pkt)
+	default:
+		return
+	}
+
+	if jumped {
+		a.hasBaseline = false
+		a.hasKeyframe = false
+	}
+	a.checkLate(pkt.Time, now)
+}
+
+func (a *streamAnalyzer) video(pkt av.Packet) bool {
+	jumped := false
+	if a.hasVideo {
+		delta := pkt.Time - a.lastVideo
+		switch {
+		case isTimestampJump(delta):
+			a.timestampJumps++
+			jumped = true
+		case delta > 0:
+			if a.frameDuration == 0 || delta < a.frameDuration {
+				a.frameDuration = delta
+			}
+			// Gaps of whole frames are frames the encoder dropped.
+			if missing := int((delta+a.frameDuration/2)/a.frameDuration) - 1; missing > 0 {
+				a.droppedFrames += missing
+			}
+		}
+	}
+	a.lastVideo = pkt.Time
+	a.hasVideo = true
+
+	if pkt.IsKeyFrame {
+		if a.hasKeyframe && !jumped && pkt.Time > a.lastKeyframe {
+			a.keyframeInterval = pkt.Time - a.lastKeyframe
+		}
+		a.lastKeyframe = pkt.Time
+		a.hasKeyframe = true
+	}
+
+	return jumped
+}
+
+func (a *streamAnalyzer) audio(pkt av.Packet) bool {
+	jumped := a.hasAudio && isTimestampJump(pkt.Time-a.lastAudio)
+	if jumped {
+		a.timestampJumps++
+	}
+	a.lastAudio = pkt.Time
+	a.hasAudio = true
+
+	return jumped
+}
+
+func (a *streamAnalyzer) checkLate(timestamp time.Duration, now time.Time) {
+	if !a.hasBaseline {
+		a.baselineArrival = now
+		a.baselineTimestamp = timestamp
+		a.minLag = 0
+		a.hasBaseline = true
+		return
+	}
+
+	lag := now.Sub(a.baselineArrival) - (timestamp - a.baselineTimestamp)
+	if lag < a.minLag {
+		a.minLag = lag
+	}
+	if lag-a.minLag > latePacketThreshold {
+		a.latePackets++
+	}
+}
+
+func (a *streamAnalyzer) pruneArrivals(now time.Time) {
+	cutoff := now.Add(-bitrateWindow)
+	i := 0
+	for i < len(a.arrivals) && a.arrivals[i].time.Before(cutoff) {
+		i++
+	}
+	a.arrivals = a.arrivals[i:]
+}
+
+// health returns what was measured of the stream so far.
+func (a *streamAnalyzer) health(now time.Time) models.InboundStreamHealth {
+	a.pruneArrivals(now)
+
+	// Until the stream has run for the whole window only the time it ran
+	// counts.
+	window := bitrateWindow
+	if running := now.Sub(a.startedAt); running < window {
+		window = max(running, time.Second)
+	}
+
+	bytes := 0
+	for _, arrival := range a.arrivals {
+		bytes += arrival.size
+	}
+
+	health := models.InboundStreamHealth{
+		StartedAt:               a.startedAt,
+		BitrateKbps:             int(float64(bytes*8) / 1000 / window.Seconds()),
+		KeyframeIntervalSeconds: a.keyframeInterval.Seconds(),
+		TimestampJumps:          a.timestampJumps,
+		DroppedFrames:           a.droppedFrames,
+		LatePackets:             a.latePackets,
+	}
+	if a.hasVideo && a.hasAudio {
+		health.AVDriftMilliseconds = int((a.lastVideo - a.lastAudio).Milliseconds())
+	}
+
+	return health
+}
+
+func isTimestampJump(delta time.Duration) bool {
+	return delta > timestampJumpThreshold || delta < -timestampJumpThreshold
+}
+
+// InboundHealth returns the health of the inbound RTMP stream, or nil if
+// there is none.
+func InboundHealth() *models.InboundStreamHealth {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if _analyzer == nil {
+		return nil
+	}
+
+	health := _analyzer.health(time.Now())
+	return &health
+}
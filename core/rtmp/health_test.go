@@ -0,0 +1,76 @@
+package rtmp
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nareix/joy5/av"
+)
+
+// sendFrames sends frames of 30fps video with a keyframe every
+// keyframeEvery frames, and audio alongside, arriving on time.
+func sendFrames(a *streamAnalyzer, start time.Time, frames int, keyframeEvery int) {
+	frame := time.Second / 30
+	for i := 0; i < frames; i++ {
+		ts := time.Duration(i) * frame
+		now := start.Add(ts)
+		a.packet(av.Packet{Type: av.H264, Time: ts, IsKeyFrame: i%keyframeEvery == 0, Data: make([]byte, 1000)}, now)
+		a.packet(av.Packet{Type: av.AAC, Time: ts, Data: make([]byte, 100)}, now)
+	}
+}
+
+func Test_streamAnalyzerHealthyStream(t *testing.T) {
+	start := time.Now()
+	a := newStreamAnalyzer(start)
+	sendFrames(a, start, 300, 60)
+
+	health := a.health(start.Add(10 * time.Second))
+	if math.Abs(health.KeyframeIntervalSeconds-2) > 0.001 {
+		t.Errorf("expected a keyframe interval of 2s, got %v", health.KeyframeIntervalSeconds)
+	}
+	// 1100 bytes 30 times a second.
+	if health.BitrateKbps != 264 {
+		t.Errorf("expected a bitrate of 264 kbps, got %d", health.BitrateKbps)
+	}
+	if health.DroppedFrames != 0 || health.TimestampJumps != 0 || health.LatePackets != 0 || health.AVDriftMilliseconds != 0 {
+		t.Errorf("expected no problems with the stream, got %+v", health)
+	}
+}
+
+func Test_streamAnalyzerProblems(t *testing.T) {
+	start := time.Now()
+	a := newStreamAnalyzer(start)
+	frame := time.Second / 30
+
+	// Two frames are missing between the first and the second.
+	a.packet(av.Packet{Type: av.H264, Time: 0, IsKeyFrame: true}, start)
+	a.packet(av.Packet{Type: av.H264, Time: frame}, start.Add(frame))
+	a.packet(av.Packet{Type: av.H264, Time: 4 * frame}, start.Add(4*frame))
+
+	// The timestamps jump ahead by a minute.
+	a.packet(av.Packet{Type: av.H264, Time: time.Minute, IsKeyFrame: true}, start.Add(5*frame))
+
+	// The next packet arrives 3 seconds later than it should.
+	a.packet(av.Packet{Type: av.H264, Time: time.Minute + frame}, start.Add(6*frame+3*time.Second))
+
+	// Audio is 500ms behind the video.
+	a.packet(av.Packet{Type: av.AAC, Time: time.Minute + frame - 500*time.Millisecond}, start.Add(6*frame+3*time.Second))
+
+	health := a.health(start.Add(5 * time.Second))
+	if health.DroppedFrames != 2 {
+		t.Errorf("expected 2 dropped frames, got %d", health.DroppedFrames)
+	}
+	if health.TimestampJumps != 1 {
+		t.Errorf("expected 1 timestamp jump, got %d", health.TimestampJumps)
+	}
+	if health.LatePackets != 2 {
+		t.Errorf("expected 2 late packets, got %d", health.LatePackets)
+	}
+	if health.AVDriftMilliseconds != 500 {
+		t.Errorf("expected the video to be 500ms ahead of the audio, got %d", health.AVDriftMilliseconds)
+	}
+	if health.KeyframeIntervalSeconds != 0 {
+		t.Errorf("expected no keyframe interval across a timestamp jump, got %v", health.KeyframeIntervalSeconds)
+	}
+}
@@ -1,27 +1,41 @@
 package rtmp
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"sync"
 	"time"
 
+	"github.com/nareix/joy5/av"
 	"github.com/nareix/joy5/format/flv"
 	"github.com/nareix/joy5/format/flv/flvio"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/core/restream"
 	"github.com/TekkadanPlays/oni/models"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
-	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
 	"github.com/nareix/joy5/format/rtmp"
 )
 
-var _hasInboundRTMPConnection = false
-
 var (
-	_pipe           *io.PipeWriter
-	_rtmpConnection net.Conn
+	_pipe      *io.PipeWriter
+	_muxer     *flv.Muxer
+	_publisher *publisher
+	_lock      sync.Mutex
+
+	// Held while a packet is written, which blocks until the transcoder
+	// reads it, so the state guarded by _lock can be used meanwhile.
+	_writeLock sync.Mutex
+
+	// The latest timestamp written to the transcoder.
+	_lastTimestamp time.Duration
+
+	// Measures the health of the stream the current publisher sends.
+	_analyzer *streamAnalyzer
 )
 
 var (
@@ -29,7 +43,8 @@ var (
 	_setBroadcaster       func(models.Broadcaster)
 )
 
-// Start starts the rtmp service, listening on specified RTMP port.
+// Start starts the rtmp service, listening on specified RTMP port, and on
+// the RTMPS port if it is enabled.
 func Start(setStreamAsConnected func(*io.PipeReader), setBroadcaster func(models.Broadcaster)) {
 	_setStreamAsConnected = setStreamAsConnected
 	_setBroadcaster = setBroadcaster
@@ -56,6 +71,37 @@ func Start(setStreamAsConnected func(*io.PipeReader), setBroadcaster func(models
 	}
 	log.Tracef("RTMP server is listening for incoming stream on port: %d", port)
 
+	if rtmpsConfig := configRepository.GetRTMPSConfig(); rtmpsConfig.Enabled {
+		go startTLS(s, rtmpsConfig)
+	}
+
+	serve(s, lis)
+}
+
+// startTLS will listen for RTMPS broadcasters, which are handled the same
+// as RTMP ones once the TLS connection is established.
+func startTLS(s *rtmp.Server, rtmpsConfig models.RTMPSConfiguration) {
+	certificates, err := newCertificateReloader(rtmpsConfig.CertificatePath, rtmpsConfig.KeyPath)
+	if err != nil {
+		log.Errorln("Unable to load the RTMPS certificate. RTMPS is disabled.", err)
+		return
+	}
+	go certificates.watch()
+
+	lis, err := tls.Listen("tcp", fmt.Sprintf(":%d", rtmpsConfig.Port), &tls.Config{
+		GetCertificate: certificates.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	})
+	if err != nil {
+		log.Errorln("Unable to start the RTMPS listener.", err)
+		return
+	}
+	log.Infof("RTMPS is accepting inbound streams on port %d.", rtmpsConfig.Port)
+
+	serve(s, lis)
+}
+
+func serve(s *rtmp.Server, lis net.Listener) {
 	for {
 		nc, err := lis.Accept()
 		if err != nil {
@@ -68,56 +114,65 @@ func Start(setStreamAsConnected func(*io.PipeReader), setBroadcaster func(models
 
 // HandleConn is fired when an inbound RTMP connection takes place.
 func HandleConn(c *rtmp.Conn, nc net.Conn) {
+	// The broadcaster is only recorded once they are allowed to stream.
+	var metadata *flvio.Tag
+	published := false
 	c.LogTagEvent = func(isRead bool, t flvio.Tag) {
 		if t.Type == flvio.TAG_AMF0 {
 			log.Tracef("%+v\n", t.DebugFields())
-			setCurrentBroadcasterInfo(t, nc.RemoteAddr().String())
+			metadata = &t
+			if published {
+				setCurrentBroadcasterInfo(t, nc.RemoteAddr().String(), transport(nc))
+			}
 		}
 	}
 
-	if _hasInboundRTMPConnection {
-		log.Errorln("stream already running; can not overtake an existing stream from", nc.RemoteAddr().String())
-		_ = nc.Close()
-		return
-	}
-
-	configRepository := configrepository.Get()
-
-	accessGranted := false
-	validStreamingKeys := configRepository.GetStreamKeys()
-
-	// If a stream key override was specified then use that instead.
-	if config.TemporaryStreamKey != "" {
-		validStreamingKeys = []generated.StreamKey{{Key: &config.TemporaryStreamKey}}
-	}
+	p := &publisher{conn: nc, lastPacket: time.Now()}
 
-	for _, key := range validStreamingKeys {
-		if key.Key != nil && secretMatch(*key.Key, c.URL.Path) {
-			accessGranted = true
-			break
+	// With the publish callback the first packet is read before deciding,
+	// as it carries the encoder metadata sent to the callback.
+	var first *av.Packet
+	if ingest.PublishCallbackEnabled() {
+		pkt, err := authorize(c, nc, p, func() *flvio.Tag { return metadata })
+		if err != nil {
+			log.Errorln("rejecting incoming stream from", nc.RemoteAddr().String(), err)
+			_ = nc.Close()
+			return
+		}
+		first = &pkt
+	} else {
+		streamKey, accessGranted := matchingStreamKey(c.URL.Path)
+		if !accessGranted {
+			log.Errorln("invalid streaming key; rejecting incoming stream from", nc.RemoteAddr().String())
+			_ = nc.Close()
+			return
 		}
+		p.streamKey = streamKey
 	}
 
-	if !accessGranted {
-		log.Errorln("invalid streaming key; rejecting incoming stream from", nc.RemoteAddr().String())
+	if !publish(p) {
+		log.Errorln("stream already running; can not overtake an existing stream from", nc.RemoteAddr().String())
 		_ = nc.Close()
 		return
 	}
 
-	rtmpOut, rtmpIn := io.Pipe()
-	_pipe = rtmpIn
-	log.Infoln("Inbound stream connected from", nc.RemoteAddr().String())
-	_setStreamAsConnected(rtmpOut)
-
-	_hasInboundRTMPConnection = true
-	_rtmpConnection = nc
+	published = true
+	if metadata != nil {
+		setCurrentBroadcasterInfo(*metadata, nc.RemoteAddr().String(), transport(nc))
+	}
 
-	w := flv.NewMuxer(rtmpIn)
+	if first != nil {
+		if err := writePacket(p, *first); err != nil {
+			log.Errorln("unable to write rtmp packet", err)
+			handleDisconnect(p)
+			return
+		}
+	}
 
-	for _hasInboundRTMPConnection {
+	for {
 		// If we don't get a readable packet in 30 seconds give up and disconnect.
 		// Increased from 10s for resilience on congested uplinks and cheap VPS.
-		if err := _rtmpConnection.SetReadDeadline(time.Now().Add(30 * time.Second)); err != nil {
+		if err := nc.SetReadDeadline(time.Now().Add(30 * time.Second)); err != nil {
 			log.Debugln(err)
 		}
 
@@ -125,42 +180,207 @@ func HandleConn(c *rtmp.Conn, nc net.Conn) {
 
 		// Broadcaster disconnected
 		if err == io.EOF {
-			handleDisconnect(nc)
+			handleDisconnect(p)
 			return
 		}
 
 		// Read timeout.  Disconnect.
 		if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
 			log.Debugln("Timeout reading the inbound stream from the broadcaster.  Assuming that they disconnected and ending the stream.")
-			handleDisconnect(nc)
+			handleDisconnect(p)
 			return
 		}
 
-		if err := w.WritePacket(pkt); err != nil {
-			log.Errorln("unable to write rtmp packet", err)
-			handleDisconnect(nc)
+		// The connection was closed, for example by a publisher taking over.
+		if err != nil {
+			handleDisconnect(p)
+			return
+		}
+
+		if err := writePacket(p, pkt); err != nil {
+			if err != errReplaced {
+				log.Errorln("unable to write rtmp packet", err)
+			}
+			handleDisconnect(p)
 			return
 		}
 	}
 }
 
-func handleDisconnect(conn net.Conn) {
-	if !_hasInboundRTMPConnection {
+// authorize asks the publish callback if the publisher may stream, and
+// returns the first packet of the stream that was read to get the encoder
+// metadata.
+func authorize(c *rtmp.Conn, nc net.Conn, p *publisher, metadata func() *flvio.Tag) (av.Packet, error) {
+	streamKey, ok := streamKeyFromPath(c.URL.Path)
+	if !ok {
+		return av.Packet{}, errors.New("no stream key")
+	}
+
+	if err := nc.SetReadDeadline(time.Now().Add(30 * time.Second)); err != nil {
+		log.Debugln(err)
+	}
+	pkt, err := c.ReadPacket()
+	if err != nil {
+		return av.Packet{}, err
+	}
+
+	request := ingest.PublishRequest{
+		StreamKey:  streamKey,
+		RemoteAddr: nc.RemoteAddr().String(),
+		Protocol:   transport(nc),
+	}
+	if t := metadata(); t != nil {
+		details := broadcasterFromMetadata(*t, request.RemoteAddr, request.Protocol).StreamDetails
+		request.Metadata = &details
+	}
+
+	decision := ingest.AuthorizePublish(request)
+	if !decision.Allowed {
+		return av.Packet{}, fmt.Errorf("the publish callback denied the stream: %s", decision.Reason)
+	}
+
+	p.streamKey = streamKey
+	p.decision = &decision
+	return pkt, nil
+}
+
+// transport returns if the broadcaster streams over RTMP or RTMPS.
+func transport(nc net.Conn) string {
+	if _, ok := nc.(*tls.Conn); ok {
+		return ingest.RTMPS
+	}
+
+	return ingest.RTMP
+}
+
+// matchingStreamKey returns the stream key the RTMP URL path uses, if it
+// uses a valid one.
+func matchingStreamKey(path string) (string, bool) {
+	for _, key := range ingest.StreamKeys() {
+		if secretMatch(key, path) {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+// publish makes the publisher the source of the stream, starting the
+// stream or taking over from the current publisher when the takeover
+// policy allows it. Returns false if the publisher was rejected.
+func publish(p *publisher) bool {
+	_lock.Lock()
+
+	if current := _publisher; current != nil {
+		if !canTakeOver(configrepository.Get().GetRTMPTakeoverConfig(), current, p, time.Now()) {
+			_lock.Unlock()
+			return false
+		}
+
+		// The running transcoder keeps the output of the current broadcast.
+		if !ingest.MatchesCurrentOutput(p.streamKey, p.decision) {
+			_lock.Unlock()
+			log.Warnln("Inbound stream from", p.conn.RemoteAddr().String(), "would change the output variants or latency level of the running stream")
+			return false
+		}
+
+		// Keep feeding the running transcoder through the same pipe.
+		log.Infoln("Inbound stream from", p.conn.RemoteAddr().String(), "is taking over from", current.conn.RemoteAddr().String())
+		p.rebase = true
+		_publisher = p
+		_analyzer = newStreamAnalyzer(time.Now())
+		_ = current.conn.Close()
+		_lock.Unlock()
+
+		// The stream carries on with the new publisher's key and decision,
+		// set up without the stream lock as the key's usage is saved.
+		ingest.SetStreamKey(p.streamKey, p.conn.RemoteAddr().String())
+		if p.decision != nil {
+			ingest.ApplyPublishDecision(*p.decision)
+		}
+		return true
+	}
+
+	// Another protocol may be streaming already.
+	if !ingest.Claim(ingest.RTMP) {
+		_lock.Unlock()
+		return false
+	}
+
+	rtmpOut, rtmpIn := io.Pipe()
+	_pipe = rtmpIn
+	_muxer = flv.NewMuxer(rtmpIn)
+	_lastTimestamp = 0
+	_publisher = p
+	_analyzer = newStreamAnalyzer(time.Now())
+	_lock.Unlock()
+
+	// Set up before the transcoder starts, which uses the key's overrides.
+	ingest.SetStreamKey(p.streamKey, p.conn.RemoteAddr().String())
+	if p.decision != nil {
+		ingest.ApplyPublishDecision(*p.decision)
+	}
+
+	log.Infoln("Inbound stream connected from", p.conn.RemoteAddr().String())
+	_setStreamAsConnected(rtmpOut)
+	restream.Start()
+
+	return true
+}
+
+// writePacket sends a packet from the publisher to the transcoder and the
+// restream destinations.
+func writePacket(p *publisher, pkt av.Packet) error {
+	_writeLock.Lock()
+	defer _writeLock.Unlock()
+
+	_lock.Lock()
+	if _publisher != p {
+		_lock.Unlock()
+		return errReplaced
+	}
+
+	p.lastPacket = time.Now()
+	_analyzer.packet(pkt, p.lastPacket)
+	pkt.Time = p.timestamp(pkt.Time, _lastTimestamp)
+	if pkt.Time > _lastTimestamp {
+		_lastTimestamp = pkt.Time
+	}
+	muxer := _muxer
+	_lock.Unlock()
+
+	restream.WritePacket(pkt)
+	return muxer.WritePacket(pkt)
+}
+
+func handleDisconnect(p *publisher) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	// A publisher that was taken over from leaves the stream running.
+	if _publisher != p {
 		return
 	}
 
 	log.Infoln("Inbound stream disconnected.")
-	_ = conn.Close()
+	_ = p.conn.Close()
 	_ = _pipe.Close()
-	_hasInboundRTMPConnection = false
+	_publisher = nil
+	_analyzer = nil
+	ingest.Release(ingest.RTMP)
+	restream.Stop()
 }
 
 // Disconnect will force disconnect the current inbound RTMP connection.
 func Disconnect() {
-	if _rtmpConnection == nil {
+	_lock.Lock()
+	p := _publisher
+	_lock.Unlock()
+
+	if p == nil {
 		return
 	}
 
 	log.Traceln("Inbound stream disconnect requested.")
-	handleDisconnect(_rtmpConnection)
+	handleDisconnect(p)
 }
@@ -0,0 +1,93 @@
+package rtmp
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-rtmp-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func newTestPublisher(t *testing.T, streamKey string, decision *ingest.PublishDecision) *publisher {
+	t.Helper()
+
+	conn, other := net.Pipe()
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = other.Close()
+	})
+
+	// Publishers that stopped sending long ago can be taken over from.
+	return &publisher{conn: conn, streamKey: streamKey, decision: decision, lastPacket: time.Now().Add(-time.Hour)}
+}
+
+func Test_publishTakeover(t *testing.T) {
+	configRepository := configrepository.Get()
+	_ = configRepository.SetRTMPTakeoverConfig(models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverIdle, IdleSeconds: 1})
+
+	// One key streams with another latency level than the configured one.
+	otherLevel := 4
+	if configRepository.GetStreamLatencyLevel().Level == otherLevel {
+		otherLevel = 3
+	}
+	firstKey, secondKey, otherLevelKey := "first-key", "second-key", "other-level-key"
+	if err := configRepository.SetStreamKeys([]generated.StreamKey{
+		{Key: &firstKey},
+		{Key: &secondKey},
+		{Key: &otherLevelKey, LatencyLevel: &otherLevel},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	_setStreamAsConnected = func(r *io.PipeReader) { go func() { _, _ = io.Copy(io.Discard, r) }() }
+	first := newTestPublisher(t, firstKey, nil)
+	if !publish(first) {
+		t.Fatal("expected the first publisher to start the stream")
+	}
+	t.Cleanup(func() { Disconnect() })
+
+	second := newTestPublisher(t, secondKey, &ingest.PublishDecision{Allowed: true, StreamTitle: "Taken over"})
+	if !publish(second) {
+		t.Fatal("expected the second publisher to take over")
+	}
+	if streamKey, _ := ingest.StreamKey(); streamKey != secondKey {
+		t.Errorf("expected the stream to use the key taking over, got %q", streamKey)
+	}
+	if title := configRepository.GetStreamTitle(); title != "Taken over" {
+		t.Errorf("expected the stream title chosen for the publisher taking over, got %q", title)
+	}
+
+	// The running transcoder can not change its output.
+	if publish(newTestPublisher(t, otherLevelKey, nil)) {
+		t.Error("expected a key with another latency level to not take over")
+	}
+	variants := []models.StreamOutputVariant{{Name: "other", VideoBitrate: 123}}
+	if publish(newTestPublisher(t, firstKey, &ingest.PublishDecision{Allowed: true, OutputVariants: variants})) {
+		t.Error("expected a decision with other output variants to not take over")
+	}
+	if streamKey, _ := ingest.StreamKey(); streamKey != secondKey {
+		t.Errorf("expected the stream to keep its key, got %q", streamKey)
+	}
+}
@@ -0,0 +1,60 @@
+package rtmp
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/models"
+)
+
+// How far after the last packet of the previous publisher the packets of
+// one taking over start.
+const takeoverTimestampGap = 100 * time.Millisecond
+
+var errReplaced = errors.New("another publisher took over the stream")
+
+// publisher is an inbound RTMP connection feeding the stream.
+type publisher struct {
+	conn       net.Conn
+	lastPacket time.Time
+	streamKey  string
+
+	// What the publish callback decided for the publisher, if it is used.
+	decision *ingest.PublishDecision
+
+	// Publishers taking over start their timestamps from zero again, so
+	// they are moved to carry on from where the previous one stopped.
+	timeOffset time.Duration
+	rebase     bool
+	rebased    bool
+}
+
+// timestamp returns the time of a packet from the publisher on the
+// timeline of the running stream.
+func (p *publisher) timestamp(t time.Duration, lastTimestamp time.Duration) time.Duration {
+	if !p.rebase {
+		return t
+	}
+
+	if !p.rebased {
+		p.timeOffset = lastTimestamp + takeoverTimestampGap - t
+		p.rebased = true
+	}
+
+	return t + p.timeOffset
+}
+
+// canTakeOver returns if the takeover policy lets the next publisher
+// replace the current one.
+func canTakeOver(config models.RTMPTakeoverConfiguration, current, next *publisher, now time.Time) bool {
+	switch config.Policy {
+	case models.RTMPTakeoverSameKey:
+		return current.streamKey == next.streamKey
+	case models.RTMPTakeoverIdle:
+		return now.Sub(current.lastPacket) >= config.IdleTimeout()
+	default:
+		return false
+	}
+}
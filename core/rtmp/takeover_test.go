@@ -0,0 +1,53 @@
+package rtmp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+func Test_canTakeOver(t *testing.T) {
+	now := time.Now()
+	current := &publisher{streamKey: "abc", lastPacket: now.Add(-3 * time.Second)}
+
+	tests := []struct {
+		name   string
+		config models.RTMPTakeoverConfiguration
+		key    string
+		want   bool
+	}{
+		{"reject", models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverReject}, "abc", false},
+		{"same key", models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverSameKey}, "abc", true},
+		{"different key", models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverSameKey}, "def", false},
+		{"idle", models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverIdle, IdleSeconds: 2}, "def", true},
+		{"not idle long enough", models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverIdle, IdleSeconds: 5}, "abc", false},
+		{"unknown policy", models.RTMPTakeoverConfiguration{Policy: "other"}, "abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &publisher{streamKey: tt.key}
+			if got := canTakeOver(tt.config, current, next, now); got != tt.want {
+				t.Errorf("canTakeOver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_publisherTimestamp(t *testing.T) {
+	original := &publisher{}
+	if got := original.timestamp(5*time.Second, 0); got != 5*time.Second {
+		t.Errorf("expected the first publisher's timestamps to be unchanged, got %v", got)
+	}
+
+	// The publisher taking over starts from zero again.
+	next := &publisher{rebase: true}
+	last := 60 * time.Second
+	if got := next.timestamp(0, last); got != last+takeoverTimestampGap {
+		t.Errorf("expected the first packet to follow the previous publisher, got %v", got)
+	}
+	if got := next.timestamp(time.Second, last+takeoverTimestampGap); got != last+takeoverTimestampGap+time.Second {
+		t.Errorf("expected later packets to keep the same offset, got %v", got)
+	}
+}
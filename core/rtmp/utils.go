@@ -82,15 +82,25 @@ func getVideoCodec(codec interface{}) string {
 }
 
 func secretMatch(configStreamKey string, path string) bool {
+	streamingKey, ok := streamKeyFromPath(path)
+	if !ok {
+		return false
+	}
+
+	matches := subtle.ConstantTimeCompare([]byte(streamingKey), []byte(configStreamKey)) == 1
+	return matches
+}
+
+// streamKeyFromPath returns the stream key a broadcaster streams to, which
+// is everything after /live/ in the RTMP URL path.
+func streamKeyFromPath(path string) (string, bool) {
 	prefix := "/live/"
 
 	if !strings.HasPrefix(path, prefix) {
 		log.Debug("RTMP path does not start with " + prefix)
-		return false // We need the path to begin with $prefix
+		return "", false // We need the path to begin with $prefix
 	}
 
 	streamingKey := path[len(prefix):] // Remove $prefix
-
-	matches := subtle.ConstantTimeCompare([]byte(streamingKey), []byte(configStreamKey)) == 1
-	return matches
+	return streamingKey, streamingKey != ""
 }
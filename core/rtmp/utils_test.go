@@ -33,3 +33,23 @@ func Test_secretMatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_streamKeyFromPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+		ok   bool
+	}{
+		{"/live/abc123", "abc123", true},
+		{"/live/one/two", "one/two", true},
+		{"/live/", "", false},
+		{"/other/abc123", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := streamKeyFromPath(tt.path)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("streamKeyFromPath(%q) = %q, %v, want %q, %v", tt.path, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package srt
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/nareix/joy5/codec/h264"
+)
+
+const (
+	tsPacketSize = 188
+	tsSyncByte   = 0x47
+
+	// How much H.264 video to look through for the SPS before giving up.
+	maxSPSSearchSize = 256 * 1024
+)
+
+// MPEG-TS stream types of the codecs broadcasters send.
+var (
+	videoStreamTypes = map[byte]string{
+		0x02: "MPEG-2",
+		0x1b: "H.264",
+		0x24: "H.265",
+	}
+	audioStreamTypes = map[byte]string{
+		0x03: "MP3",
+		0x04: "MP3",
+		0x0f: "AAC",
+		0x11: "AAC",
+		0x81: "AC-3",
+	}
+)
+
+// probe reads the codecs, resolution and bitrates of the MPEG-TS a
+// broadcaster sends, as SRT carries no metadata about the stream.
+type probe struct {
+	remainder []byte
+	pmtPIDs   map[uint16]bool
+	sps       []byte
+
+	videoCodec string
+	audioCodec string
+	width      int
+	height     int
+	framerate  float32
+
+	videoBytes uint64
+	audioBytes uint64
+	lock       sync.Mutex
+
+	videoPID uint16
+	audioPID uint16
+	foundSPS bool
+}
+
+func newProbe() *probe {
+	return &probe{pmtPIDs: map[uint16]bool{}}
+}
+
+// Write will inspect the next chunk of the transport stream.
+func (p *probe) Write(b []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	data := append(p.remainder, b...)
+	for len(data) >= tsPacketSize {
+		if data[0] != tsSyncByte {
+			next := bytes.IndexByte(data, tsSyncByte)
+			if next < 0 {
+				data = nil
+				break
+			}
+			data = data[next:]
+			continue
+		}
+
+		p.readPacket(data[:tsPacketSize])
+		data = data[tsPacketSize:]
+	}
+
+	p.remainder = append(p.remainder[:0], data...)
+}
+
+// details returns what is known about the stream, with the bitrates
+// averaged over the interval since the previous call.
+func (p *probe) details(interval time.Duration) models.InboundStreamDetails {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	details := models.InboundStreamDetails{
+		VideoCodec:     p.videoCodec,
+		AudioCodec:     p.audioCodec,
+		Width:          p.width,
+		Height:         p.height,
+		VideoFramerate: p.framerate,
+		VideoOnly:      p.audioPID == 0,
+	}
+	if details.VideoCodec == "" {
+		details.VideoCodec = "Unknown"
+	}
+	if details.AudioCodec == "" {
+		details.AudioCodec = "No audio"
+	}
+
+	if seconds := interval.Seconds(); seconds > 0 {
+		details.VideoBitrate = int(float64(p.videoBytes) * 8 / 1000 / seconds)
+		details.AudioBitrate = int(float64(p.audioBytes) * 8 / 1000 / seconds)
+	}
+	p.videoBytes = 0
+	p.audioBytes = 0
+
+	return details
+}
+
+func (p *probe) readPacket(packet []byte) {
+	payloadStart := packet[1]&0x40 != 0
+	pid := uint16(packet[1]&0x1f)<<8 | uint16(packet[2])
+	adaptationField := (packet[3] >> 4) & 0x3
+
+	if adaptationField&0x1 == 0 {
+		return
+	}
+
+	offset := 4
+	if adaptationField&0x2 != 0 {
+		offset += 1 + int(packet[4])
+	}
+	if offset >= tsPacketSize {
+		return
+	}
+	payload := packet[offset:]
+
+	switch {
+	case pid == 0 && payloadStart:
+		p.readPAT(payload)
+	case p.pmtPIDs[pid] && payloadStart:
+		p.readPMT(payload)
+	case pid == p.videoPID && pid != 0:
+		p.videoBytes += uint64(len(payload))
+		if p.videoCodec == "H.264" && !p.foundSPS {
+			p.searchSPS(payload, payloadStart)
+		}
+	case pid == p.audioPID && pid != 0:
+		p.audioBytes += uint64(len(payload))
+	}
+}
+
+// section returns the PSI section the payload starts, without its CRC.
+func section(payload []byte, tableID byte) []byte {
+	pointer := int(payload[0])
+	if 1+pointer+3 > len(payload) {
+		return nil
+	}
+
+	s := payload[1+pointer:]
+	if s[0] != tableID {
+		return nil
+	}
+
+	length := int(s[1]&0x0f)<<8 | int(s[2])
+	end := 3 + length - 4
+	if length < 9 || end > len(s) {
+		return nil
+	}
+
+	return s[:end]
+}
+
+func (p *probe) readPAT(payload []byte) {
+	s := section(payload, 0x00)
+	if s == nil {
+		return
+	}
+
+	for i := 8; i+4 <= len(s); i += 4 {
+		programNumber := uint16(s[i])<<8 | uint16(s[i+1])
+		if programNumber == 0 {
+			continue
+		}
+		p.pmtPIDs[uint16(s[i+2]&0x1f)<<8|uint16(s[i+3])] = true
+	}
+}
+
+func (p *probe) readPMT(payload []byte) {
+	s := section(payload, 0x02)
+	if s == nil || len(s) < 12 {
+		return
+	}
+
+	programInfoLength := int(s[10]&0x0f)<<8 | int(s[11])
+	for i := 12 + programInfoLength; i+5 <= len(s); {
+		streamType := s[i]
+		pid := uint16(s[i+1]&0x1f)<<8 | uint16(s[i+2])
+		esInfoLength := int(s[i+3]&0x0f)<<8 | int(s[i+4])
+
+		if codec, ok := videoStreamTypes[streamType]; ok && p.videoPID == 0 {
+			p.videoPID = pid
+			p.videoCodec = codec
+		} else if codec, ok := audioStreamTypes[streamType]; ok && p.audioPID == 0 {
+			p.audioPID = pid
+			p.audioCodec = codec
+		}
+
+		i += 5 + esInfoLength
+	}
+}
+
+// searchSPS collects the H.264 elementary stream until it holds a complete
+// sequence parameter set, which has the resolution.
+func (p *probe) searchSPS(payload []byte, payloadStart bool) {
+	if payloadStart {
+		// Skip the PES header.
+		if len(payload) < 9 || payload[0] != 0 || payload[1] != 0 || payload[2] != 1 {
+			return
+		}
+		headerEnd := 9 + int(payload[8])
+		if headerEnd > len(payload) {
+			return
+		}
+		payload = payload[headerEnd:]
+	}
+
+	p.sps = append(p.sps, payload...)
+	if len(p.sps) > maxSPSSearchSize {
+		p.sps = nil
+		p.foundSPS = true
+		return
+	}
+
+	for start := 0; ; {
+		i := bytes.Index(p.sps[start:], []byte{0, 0, 1})
+		if i < 0 || start+i+3 >= len(p.sps) {
+			return
+		}
+		nalStart := start + i + 3
+		start = nalStart
+
+		if h264.NALUType(p.sps[nalStart:]) != h264.NALU_SPS {
+			continue
+		}
+
+		// The SPS is complete once the next NAL unit started.
+		end := bytes.Index(p.sps[nalStart:], []byte{0, 0, 1})
+		if end < 0 {
+			return
+		}
+
+		info, err := h264.ParseSPS(bytes.TrimRight(p.sps[nalStart:nalStart+end], "\x00"))
+		p.sps = nil
+		p.foundSPS = true
+		if err == nil {
+			p.width = int(info.Width)
+			p.height = int(info.Height)
+			p.framerate = float32(info.FPS)
+		}
+		return
+	}
+}
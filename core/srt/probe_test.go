@@ -0,0 +1,100 @@
+package srt
+
+import (
+	"testing"
+	"time"
+)
+
+// A 1280x720 30fps H.264 sequence parameter set.
+var testSPS = []byte{0x67, 0x64, 0x00, 0x1f, 0xac, 0xd9, 0x40, 0x50, 0x05, 0xbb, 0x01, 0x10, 0x00, 0x00, 0x03, 0x00, 0x10, 0x00, 0x00, 0x03, 0x03, 0xc0, 0xf1, 0x83, 0x19, 0x60}
+
+const (
+	testPMTPID   = 0x1000
+	testVideoPID = 0x100
+	testAudioPID = 0x101
+)
+
+// tsPacket wraps the payload in a transport stream packet, padding it
+// with an adaptation field.
+func tsPacket(pid uint16, payloadStart bool, payload []byte) []byte {
+	packet := make([]byte, 0, tsPacketSize)
+	flags := byte(pid>>8) & 0x1f
+	if payloadStart {
+		flags |= 0x40
+	}
+	packet = append(packet, tsSyncByte, flags, byte(pid))
+
+	padding := tsPacketSize - 4 - len(payload)
+	if padding == 0 {
+		packet = append(packet, 0x10)
+	} else {
+		packet = append(packet, 0x30, byte(padding-1))
+		for i := 1; i < padding; i++ {
+			packet = append(packet, 0xff)
+		}
+	}
+
+	return append(packet, payload...)
+}
+
+// psiSection builds a PSI section with a placeholder CRC.
+func psiSection(tableID byte, body []byte) []byte {
+	length := 5 + len(body) + 4
+	s := []byte{0x00, tableID, 0xb0 | byte(length>>8), byte(length), 0x00, 0x01, 0xc1, 0x00, 0x00}
+	s = append(s, body...)
+	return append(s, 0, 0, 0, 0)
+}
+
+func testStream() []byte {
+	var stream []byte
+
+	pat := psiSection(0x00, []byte{0x00, 0x01, 0xe0 | byte(testPMTPID>>8), byte(testPMTPID & 0xff)})
+	stream = append(stream, tsPacket(0, true, pat)...)
+
+	pmt := psiSection(0x02, []byte{
+		0xe0 | byte(testVideoPID>>8), byte(testVideoPID & 0xff), // PCR PID
+		0xf0, 0x00, // program info length
+		0x1b, 0xe0 | byte(testVideoPID>>8), byte(testVideoPID & 0xff), 0xf0, 0x00,
+		0x0f, 0xe0 | byte(testAudioPID>>8), byte(testAudioPID & 0xff), 0xf0, 0x00,
+	})
+	stream = append(stream, tsPacket(testPMTPID, true, pmt)...)
+
+	pes := []byte{0x00, 0x00, 0x01, 0xe0, 0x00, 0x00, 0x80, 0x00, 0x00}
+	pes = append(pes, 0x00, 0x00, 0x00, 0x01)
+	pes = append(pes, testSPS...)
+	pes = append(pes, 0x00, 0x00, 0x00, 0x01, 0x68, 0xeb, 0xe3, 0xcb, 0x22, 0xc0)
+	stream = append(stream, tsPacket(testVideoPID, true, pes)...)
+
+	stream = append(stream, tsPacket(testAudioPID, true, make([]byte, 100))...)
+
+	return stream
+}
+
+func TestProbe(t *testing.T) {
+	p := newProbe()
+
+	// Start mid packet and split the writes across packets, the way they
+	// arrive over the network.
+	stream := append([]byte{0x12, 0x34, 0x56}, testStream()...)
+	p.Write(stream[:200])
+	p.Write(stream[200:500])
+	p.Write(stream[500:])
+
+	details := p.details(time.Millisecond)
+	if details.VideoCodec != "H.264" || details.AudioCodec != "AAC" {
+		t.Errorf("expected H.264 and AAC, got %s and %s", details.VideoCodec, details.AudioCodec)
+	}
+	if details.Width != 1280 || details.Height != 720 || details.VideoFramerate != 30 {
+		t.Errorf("expected 1280x720 at 30fps, got %dx%d at %v", details.Width, details.Height, details.VideoFramerate)
+	}
+	if details.VideoOnly {
+		t.Error("expected the stream to have audio")
+	}
+	if details.VideoBitrate == 0 || details.AudioBitrate == 0 {
+		t.Errorf("expected the bitrates to be measured, got %d and %d", details.VideoBitrate, details.AudioBitrate)
+	}
+
+	if details = p.details(time.Millisecond); details.VideoBitrate != 0 {
+		t.Errorf("expected the bitrate to only cover the last interval, got %d", details.VideoBitrate)
+	}
+}
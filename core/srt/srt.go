@@ -0,0 +1,272 @@
+// Package srt accepts inbound streams over SRT, for broadcasters on lossy
+// links such as mobile connections.
+package srt
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	srt "github.com/datarhei/gosrt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+const (
+	// How much the receiver buffers to recover lost packets.
+	receiverLatency = 1 * time.Second
+
+	// How long a broadcaster may send nothing before it is disconnected.
+	peerIdleTimeout = 10 * time.Second
+
+	// How often the broadcaster details and link stats are updated.
+	statsInterval = 5 * time.Second
+)
+
+var (
+	_conn srt.Conn
+	_pipe *io.PipeWriter
+	_lock sync.Mutex
+)
+
+var (
+	_setStreamAsConnected func(*io.PipeReader)
+	_setBroadcaster       func(models.Broadcaster)
+)
+
+// Start starts the SRT listener on the configured SRT port.
+func Start(setStreamAsConnected func(*io.PipeReader), setBroadcaster func(models.Broadcaster)) {
+	_setStreamAsConnected = setStreamAsConnected
+	_setBroadcaster = setBroadcaster
+
+	port := configrepository.Get().GetSRTPortNumber()
+
+	srtConfig := srt.DefaultConfig()
+	srtConfig.ReceiverLatency = receiverLatency
+	srtConfig.PeerIdleTimeout = peerIdleTimeout
+
+	ln, err := srt.Listen("srt", fmt.Sprintf(":%d", port), srtConfig)
+	if err != nil {
+		log.Errorln("Unable to start the SRT listener.", err)
+		return
+	}
+	log.Infof("SRT is accepting inbound streams on port %d.", port)
+
+	for {
+		req, err := ln.Accept2()
+		if err == srt.ErrListenerClosed {
+			return
+		}
+		if err != nil {
+			log.Debugln(err)
+			continue
+		}
+
+		go handleRequest(req)
+	}
+}
+
+func handleRequest(req srt.ConnRequest) {
+	remoteAddr := req.RemoteAddr().String()
+
+	streamKey, err := streamKeyFromStreamID(req.StreamId())
+	if err == errNotPublishing {
+		log.Errorln("SRT connection from", remoteAddr, "does not publish a stream; rejecting it")
+		req.Reject(srt.REJX_BAD_MODE)
+		return
+	}
+	if err != nil {
+		log.Errorln("invalid streaming key; rejecting incoming SRT stream from", remoteAddr)
+		req.Reject(srt.REJX_UNAUTHORIZED)
+		return
+	}
+	decision, authorized := authorize(streamKey, remoteAddr)
+	if !authorized {
+		log.Errorln("invalid streaming key; rejecting incoming SRT stream from", remoteAddr)
+		req.Reject(srt.REJX_UNAUTHORIZED)
+		return
+	}
+
+	if req.IsEncrypted() {
+		log.Errorln("encrypted SRT streams are not supported; rejecting incoming stream from", remoteAddr)
+		req.Reject(srt.REJ_UNSECURE)
+		return
+	}
+
+	conn, streamOut := accept(req)
+	if conn == nil {
+		return
+	}
+	ingest.SetStreamKey(streamKey, remoteAddr)
+	if decision != nil {
+		ingest.ApplyPublishDecision(*decision)
+	}
+
+	log.Infoln("Inbound SRT stream connected from", remoteAddr)
+
+	// The broadcaster is known right away, the details of the stream follow
+	// once they are measured.
+	p := newProbe()
+	connectedAt := time.Now()
+	setBroadcaster(conn, p.details(0), remoteAddr, connectedAt)
+
+	_setStreamAsConnected(streamOut)
+
+	done := make(chan struct{})
+	defer close(done)
+	go reportBroadcaster(conn, p, remoteAddr, connectedAt, done)
+
+	buffer := make([]byte, 64*1024)
+	for {
+		n, err := conn.Read(buffer)
+		if err != nil {
+			log.Debugln("SRT stream ended", err)
+			handleDisconnect(conn)
+			return
+		}
+
+		p.Write(buffer[:n])
+		if err := writeData(conn, buffer[:n]); err != nil {
+			log.Errorln("unable to write srt data", err)
+			handleDisconnect(conn)
+			return
+		}
+	}
+}
+
+// authorize returns if the broadcaster may stream with the key, asking the
+// publish callback instead of checking the configured keys when it is
+// enabled.
+func authorize(streamKey string, remoteAddr string) (*ingest.PublishDecision, bool) {
+	if !ingest.PublishCallbackEnabled() {
+		return nil, isValidStreamKey(streamKey)
+	}
+
+	decision := ingest.AuthorizePublish(ingest.PublishRequest{
+		StreamKey:  streamKey,
+		RemoteAddr: remoteAddr,
+		Protocol:   ingest.SRT,
+	})
+	if !decision.Allowed {
+		log.Errorln("the publish callback denied the SRT stream from", remoteAddr, decision.Reason)
+		return nil, false
+	}
+
+	return &decision, true
+}
+
+// accept will accept the broadcaster if nobody else is streaming and
+// returns its connection with the pipe the stream is read from.
+func accept(req srt.ConnRequest) (srt.Conn, *io.PipeReader) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if _conn != nil || !ingest.Claim(ingest.SRT) {
+		log.Errorln("stream already running; can not overtake an existing stream from", req.RemoteAddr())
+		req.Reject(srt.REJX_CONFLICT)
+		return nil, nil
+	}
+
+	conn, err := req.Accept()
+	if err != nil {
+		log.Errorln("unable to accept SRT connection", err)
+		ingest.Release(ingest.SRT)
+		return nil, nil
+	}
+
+	streamOut, streamIn := io.Pipe()
+	_conn = conn
+	_pipe = streamIn
+
+	return conn, streamOut
+}
+
+func writeData(conn srt.Conn, data []byte) error {
+	_lock.Lock()
+	pipe := _pipe
+	current := _conn == conn
+	_lock.Unlock()
+
+	if !current || pipe == nil {
+		return io.ErrClosedPipe
+	}
+
+	_, err := pipe.Write(data)
+	return err
+}
+
+// reportBroadcaster will update the broadcaster details with what the
+// stream and the link look like until done is closed.
+func reportBroadcaster(conn srt.Conn, p *probe, remoteAddr string, connectedAt time.Time, done chan struct{}) {
+	ticker := time.NewTicker(statsInterval)
+	defer ticker.Stop()
+
+	lastUpdate := connectedAt
+
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			setBroadcaster(conn, p.details(now.Sub(lastUpdate)), remoteAddr, connectedAt)
+			lastUpdate = now
+		}
+	}
+}
+
+// setBroadcaster will record the broadcaster with the details of the stream
+// and the stats of the link.
+func setBroadcaster(conn srt.Conn, details models.InboundStreamDetails, remoteAddr string, connectedAt time.Time) {
+	var stats srt.Statistics
+	conn.Stats(&stats)
+
+	_setBroadcaster(models.Broadcaster{
+		RemoteAddr:    remoteAddr,
+		Time:          connectedAt,
+		Protocol:      ingest.SRT,
+		StreamDetails: details,
+		Link: &models.InboundLinkStats{
+			RTT:                  stats.Instantaneous.MsRTT,
+			PacketLoss:           stats.Instantaneous.PktRecvLossRate,
+			PacketsLost:          stats.Accumulated.PktRecvLoss,
+			PacketsRetransmitted: stats.Accumulated.PktRecvRetrans,
+			PacketsDropped:       stats.Accumulated.PktRecvDrop,
+			ReceiveBitrate:       int(stats.Instantaneous.MbpsRecvRate * 1000),
+		},
+	})
+}
+
+func handleDisconnect(conn srt.Conn) {
+	_lock.Lock()
+	defer _lock.Unlock()
+
+	if _conn != conn {
+		conn.Close()
+		return
+	}
+
+	log.Infoln("SRT stream disconnected.")
+	_conn.Close()
+	_conn = nil
+	_pipe.Close()
+	_pipe = nil
+	ingest.Release(ingest.SRT)
+}
+
+// Disconnect will force disconnect the current inbound SRT connection.
+func Disconnect() {
+	_lock.Lock()
+	conn := _conn
+	_lock.Unlock()
+
+	if conn == nil {
+		return
+	}
+
+	log.Traceln("Inbound SRT stream disconnect requested.")
+	handleDisconnect(conn)
+}
@@ -0,0 +1,59 @@
+package srt
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"github.com/TekkadanPlays/oni/core/ingest"
+)
+
+const accessControlPrefix = "#!::"
+
+var (
+	errNotPublishing = errors.New("the streamid does not request to publish")
+	errMissingKey    = errors.New("the streamid has no stream key")
+)
+
+// streamKeyFromStreamID returns the stream key an SRT streamid carries.
+// It is either in the SRT access control syntax, #!::r=live/<key>,m=publish,
+// or the resource on its own, live/<key> or just <key>.
+func streamKeyFromStreamID(streamID string) (string, error) {
+	resource := streamID
+
+	if strings.HasPrefix(streamID, accessControlPrefix) {
+		resource = ""
+		for _, pair := range strings.Split(strings.TrimPrefix(streamID, accessControlPrefix), ",") {
+			key, value, _ := strings.Cut(pair, "=")
+			switch key {
+			case "r":
+				resource = value
+			case "m":
+				if value != "publish" {
+					return "", errNotPublishing
+				}
+			}
+		}
+	} else {
+		resource = strings.TrimPrefix(resource, "publish:")
+	}
+
+	resource = strings.TrimPrefix(resource, "/")
+	resource = strings.TrimPrefix(resource, "live/")
+	if resource == "" {
+		return "", errMissingKey
+	}
+
+	return resource, nil
+}
+
+// isValidStreamKey returns if the key is one the broadcaster may stream with.
+func isValidStreamKey(streamKey string) bool {
+	for _, key := range ingest.StreamKeys() {
+		if subtle.ConstantTimeCompare([]byte(streamKey), []byte(key)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
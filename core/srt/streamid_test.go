@@ -0,0 +1,31 @@
+package srt
+
+import "testing"
+
+func TestStreamKeyFromStreamID(t *testing.T) {
+	tests := []struct {
+		streamID string
+		want     string
+		err      error
+	}{
+		{"#!::r=live/abc123,m=publish", "abc123", nil},
+		{"#!::m=publish,r=abc123", "abc123", nil},
+		{"#!::r=live/abc123", "abc123", nil},
+		{"#!::r=live/abc123,m=request", "", errNotPublishing},
+		{"#!::m=publish", "", errMissingKey},
+		{"publish:live/abc123", "abc123", nil},
+		{"/live/abc123", "abc123", nil},
+		{"abc123", "abc123", nil},
+		{"", "", errMissingKey},
+	}
+
+	for _, test := range tests {
+		got, err := streamKeyFromStreamID(test.streamID)
+		if err != test.err {
+			t.Errorf("%q: expected error %v, got %v", test.streamID, test.err, err)
+		}
+		if got != test.want {
+			t.Errorf("%q: expected stream key %q, got %q", test.streamID, test.want, got)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/TekkadanPlays/oni/core/ingest"
 	"github.com/TekkadanPlays/oni/models"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/services/geoip"
@@ -48,8 +49,7 @@ func IsStreamConnected() bool {
 	// Kind of a hack.  It takes a handful of seconds between a RTMP connection and when HLS data is available.
 	// So account for that with an artificial buffer of four segments.
 	timeSinceLastConnected := time.Since(_stats.LastConnectTime.Time).Seconds()
-	configRepository := configrepository.Get()
-	waitTime := math.Max(float64(configRepository.GetStreamLatencyLevel().SecondsPerSegment)*3.0, 7)
+	waitTime := math.Max(float64(ingest.LatencyLevel().SecondsPerSegment)*3.0, 7)
 	if timeSinceLastConnected < waitTime {
 		return false
 	}
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"sort"
 
+	"github.com/TekkadanPlays/oni/core/ingest"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	log "github.com/sirupsen/logrus"
 )
@@ -63,8 +64,7 @@ func (s *LocalStorage) Save(filePath string, retryCount int) (string, error) {
 // Cleanup will remove old files from the storage provider.
 func (s *LocalStorage) Cleanup() error {
 	// Determine how many files we should keep on disk
-	configRepository := configrepository.Get()
-	maxNumber := configRepository.GetStreamLatencyLevel().SegmentCount
+	maxNumber := ingest.LatencyLevel().SegmentCount
 	buffer := 10
 	return localCleanup(maxNumber + buffer)
 }
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/TekkadanPlays/oni/core/ingest"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/utils"
 	"github.com/pkg/errors"
@@ -106,9 +107,8 @@ func (s *S3Storage) SegmentWritten(localFilePath string) {
 	averagePerformance := utils.GetAveragePerformance(performanceMonitorKey)
 
 	// Warn the user about long-running save operations
-	configRepository := configrepository.Get()
 	if averagePerformance != 0 {
-		if averagePerformance > float64(configRepository.GetStreamLatencyLevel().SecondsPerSegment)*0.9 {
+		if averagePerformance > float64(ingest.LatencyLevel().SecondsPerSegment)*0.9 {
 			log.Warnln("Possible slow uploads: average upload S3 save duration", averagePerformance, "s. troubleshoot this issue by visiting https://owncast.online/docs/troubleshooting/")
 		}
 	}
@@ -221,8 +221,7 @@ func (s *S3Storage) Cleanup() error {
 // RemoteCleanup will remove old files from the remote storage provider.
 func (s *S3Storage) RemoteCleanup() error {
 	// Determine how many files we should keep on S3 storage
-	configRepository := configrepository.Get()
-	maxNumber := configRepository.GetStreamLatencyLevel().SegmentCount
+	maxNumber := ingest.LatencyLevel().SegmentCount
 	buffer := 20
 
 	keys, err := s.getDeletableVideoSegmentsWithOffset(maxNumber + buffer)
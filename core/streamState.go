@@ -11,10 +11,14 @@ import (
 	"github.com/TekkadanPlays/oni/config"
 	"github.com/TekkadanPlays/oni/core/chat"
 	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/core/ingest"
 	"github.com/TekkadanPlays/oni/core/rtmp"
+	"github.com/TekkadanPlays/oni/core/srt"
 	"github.com/TekkadanPlays/oni/core/transcoder"
 	"github.com/TekkadanPlays/oni/core/webhooks"
+	"github.com/TekkadanPlays/oni/core/whip"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr/live"
 	"github.com/TekkadanPlays/oni/notifications"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/utils"
@@ -34,6 +38,10 @@ var _lastNotified *time.Time
 
 // setStreamAsConnected sets the stream as connected.
 func setStreamAsConnected(rtmpOut *io.PipeReader) {
+	if resumeBroadcast(rtmpOut) {
+		return
+	}
+
 	now := utils.NullTime{Time: time.Now(), Valid: true}
 	_stats.StreamConnected = true
 	_stats.LastDisconnectTime = nil
@@ -43,9 +51,10 @@ func setStreamAsConnected(rtmpOut *io.PipeReader) {
 	configRepository := configrepository.Get()
 
 	_currentBroadcast = &models.CurrentBroadcast{
-		LatencyLevel:   configRepository.GetStreamLatencyLevel(),
-		OutputSettings: configRepository.GetStreamOutputVariants(),
+		LatencyLevel:   ingest.LatencyLevel(),
+		OutputSettings: ingest.OutputVariants(),
 	}
+	recordBroadcastStarted()
 
 	StopOfflineCleanupTimer()
 	startOnlineCleanupTimer()
@@ -60,18 +69,11 @@ func setStreamAsConnected(rtmpOut *io.PipeReader) {
 		log.Fatalln("failed to setup the storage", err)
 	}
 
-	go func() {
-		_transcoder = transcoder.NewTranscoder()
-		_transcoder.TranscoderCompleted = func(error) {
-			SetStreamAsDisconnected()
-			_transcoder = nil
-			_currentBroadcast = nil
-		}
-		_transcoder.SetStdin(rtmpOut)
-		_transcoder.Start(true)
-	}()
+	startTranscoder(rtmpOut, false)
 
 	go webhooks.SendStreamStatusEvent(models.StreamStarted)
+	live.StreamStarted()
+	chat.StartNostrBridge()
 	selectedThumbnailVideoQualityIndex, isVideoPassthrough := configRepository.FindHighestVideoQualityIndex(_currentBroadcast.OutputSettings)
 	transcoder.StartThumbnailGenerator(segmentPath, selectedThumbnailVideoQualityIndex, isVideoPassthrough)
 
@@ -82,6 +84,31 @@ func setStreamAsConnected(rtmpOut *io.PipeReader) {
 	_onlineTimerCancelFunc = startLiveStreamNotificationsTimer()
 }
 
+// startTranscoder will start transcoding the inbound stream. A resumed
+// broadcast continues the playlists the previous transcoder wrote.
+func startTranscoder(rtmpOut *io.PipeReader, resumed bool) {
+	latencyLevel := _currentBroadcast.LatencyLevel
+	outputVariants := _currentBroadcast.OutputSettings
+
+	go func() {
+		_transcoder = transcoder.NewTranscoder()
+		_transcoder.SetLatencyLevel(latencyLevel)
+		_transcoder.SetOutputVariants(outputVariants)
+		_transcoder.TranscoderCompleted = func(error) {
+			_transcoder = nil
+			if startReconnectGracePeriod() {
+				return
+			}
+			SetStreamAsDisconnected()
+			_currentBroadcast = nil
+		}
+		_transcoder.SetAppendToStream(resumed)
+		_transcoder.SetDiscontinuityStart(resumed)
+		_transcoder.SetStdin(rtmpOut)
+		_transcoder.Start(true)
+	}()
+}
+
 // SetStreamAsDisconnected sets the stream as disconnected.
 func SetStreamAsDisconnected() {
 	_ = chat.SendSystemAction("The stream is ending.", true)
@@ -95,6 +122,7 @@ func SetStreamAsDisconnected() {
 	_stats.LastDisconnectTime = &now
 	_stats.LastConnectTime = nil
 	_broadcaster = nil
+	recordBroadcastEnded()
 
 	offlineFilename := "offline-v2.ts"
 
@@ -106,6 +134,8 @@ func SetStreamAsDisconnected() {
 
 	transcoder.StopThumbnailGenerator()
 	rtmp.Disconnect()
+	srt.Disconnect()
+	whip.Disconnect()
 
 	if _yp != nil {
 		_yp.Stop()
@@ -130,6 +160,8 @@ func SetStreamAsDisconnected() {
 	saveStats()
 
 	go webhooks.SendStreamStatusEvent(models.StreamStopped)
+	live.StreamEnded()
+	chat.StopNostrBridge()
 }
 
 // StartOfflineCleanupTimer will fire a cleanup after n minutes being disconnected.
@@ -50,6 +50,7 @@ type Transcoder struct {
 	currentLatencyLevel         models.LatencyLevel
 	appendToStream              bool
 	isEvent                     bool
+	discontinuityStart          bool
 }
 
 // HLSVariant is a combination of settings that results in a single HLS stream.
@@ -195,6 +196,18 @@ func (t *Transcoder) SetIsEvent(isEvent bool) {
 	t.isEvent = isEvent
 }
 
+// SetDiscontinuityStart will mark the first segment as a discontinuity, for
+// when the output continues a stream a previous instance was writing.
+func (t *Transcoder) SetDiscontinuityStart(discontinuityStart bool) {
+	t.discontinuityStart = discontinuityStart
+}
+
+// SetAppendToStream will continue the existing playlists rather than
+// starting new ones, keeping their media and discontinuity sequences.
+func (t *Transcoder) SetAppendToStream(appendToStream bool) {
+	t.appendToStream = appendToStream
+}
+
 func (t *Transcoder) GetString() string {
 	ffmpegFlags := t.getFlags()
 	return ffmpegFlags.String()
@@ -213,6 +226,10 @@ func (t *Transcoder) getFlags() *execInfo {
 		hlsOptionFlags = append(hlsOptionFlags, "append_list")
 	}
 
+	if t.discontinuityStart {
+		hlsOptionFlags = append(hlsOptionFlags, "discont_start")
+	}
+
 	if t.segmentIdentifier == "" {
 		t.segmentIdentifier = shortid.MustGenerate()
 	}
@@ -352,6 +369,17 @@ func NewTranscoder() *Transcoder {
 	return transcoder
 }
 
+// SetOutputVariants will transcode to the given variants instead of the
+// configured ones.
+func (t *Transcoder) SetOutputVariants(variants []models.StreamOutputVariant) {
+	t.currentStreamOutputSettings = variants
+	t.variants = nil
+
+	for index, quality := range variants {
+		t.AddVariant(getVariantFromConfigQuality(quality, index))
+	}
+}
+
 // Uses `map` https://www.ffmpeg.org/ffmpeg-all.html#Stream-specifiers-1 https://www.ffmpeg.org/ffmpeg-all.html#Advanced-options
 func (v *HLSVariant) getVariantString(t *Transcoder) []string {
 	variantEncoderCommands := v.getVideoQualityString(t)
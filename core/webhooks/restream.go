@@ -0,0 +1,37 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+// WebhookRestreamEventData represents a change in forwarding the stream to a
+// restream destination sent as a webhook payload.
+type WebhookRestreamEventData struct {
+	BaseWebhookData
+	Timestamp   time.Time             `json:"timestamp"`
+	Destination models.RestreamStatus `json:"destination"`
+}
+
+// SendRestreamEvent will send a restream destination status change to
+// webhook destinations.
+func SendRestreamEvent(eventType models.EventType, destination models.RestreamStatus) {
+	sendRestreamEvent(eventType, destination, time.Now())
+}
+
+func sendRestreamEvent(eventType models.EventType, destination models.RestreamStatus, timestamp time.Time) {
+	webhookEvent := WebhookEvent{
+		Type: eventType,
+		EventData: &WebhookRestreamEventData{
+			BaseWebhookData: BaseWebhookData{
+				Status:    getStatus(),
+				ServerURL: getServerURL(),
+			},
+			Timestamp:   timestamp,
+			Destination: destination,
+		},
+	}
+
+	SendEventToWebhooks(webhookEvent)
+}
@@ -0,0 +1,44 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+func TestSendRestreamEvent(t *testing.T) {
+	checkPayload(t, models.RestreamDisconnected, func() {
+		sendRestreamEvent(models.RestreamDisconnected, models.RestreamStatus{
+			ID:         "youtube",
+			Name:       "YouTube",
+			URL:        "rtmp://a.rtmp.youtube.com/live2",
+			State:      "reconnecting",
+			LastError:  "connection refused",
+			Reconnects: 1,
+		}, time.Unix(72, 0).UTC())
+	}, `{
+		"destination": {
+			"bitrate": 0,
+			"droppedPackets": 0,
+			"id": "youtube",
+			"lastError": "connection refused",
+			"name": "YouTube",
+			"reconnects": 1,
+			"state": "reconnecting",
+			"url": "rtmp://a.rtmp.youtube.com/live2"
+		},
+		"serverURL": "http://localhost:8080",
+		"status": {
+			"lastConnectTime": null,
+			"lastDisconnectTime": null,
+			"online": true,
+			"overallMaxViewerCount": 420,
+			"sessionMaxViewerCount": 69,
+			"streamTitle": "my stream",
+			"versionNumber": "1.2.3",
+			"viewerCount": 5
+		},
+		"timestamp": "1970-01-01T00:01:12Z"
+	}`)
+}
@@ -0,0 +1,47 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+// WebhookZapEventData represents a Nostr zap sent as a webhook payload.
+type WebhookZapEventData struct {
+	BaseWebhookData
+	Timestamp       time.Time `json:"timestamp"`
+	ID              string    `json:"id"`
+	StreamID        string    `json:"streamId"`
+	Sender          string    `json:"sender"`
+	SenderName      string    `json:"senderName"`
+	Recipient       string    `json:"recipient"`
+	Comment         string    `json:"comment"`
+	AmountMsat      int64     `json:"amountMsat"`
+	StreamTotalMsat int64     `json:"streamTotalMsat"`
+	StreamZapCount  int       `json:"streamZapCount"`
+}
+
+// SendZapEvent will send a zap event to webhook destinations.
+func SendZapEvent(zap models.Zap, senderName string, total models.ZapTotal) {
+	webhookEvent := WebhookEvent{
+		Type: models.ZapReceived,
+		EventData: &WebhookZapEventData{
+			BaseWebhookData: BaseWebhookData{
+				Status:    getStatus(),
+				ServerURL: getServerURL(),
+			},
+			Timestamp:       zap.Timestamp,
+			ID:              zap.ID,
+			StreamID:        zap.StreamID,
+			Sender:          zap.Sender,
+			SenderName:      senderName,
+			Recipient:       zap.Recipient,
+			Comment:         zap.Comment,
+			AmountMsat:      zap.AmountMsat,
+			StreamTotalMsat: total.AmountMsat,
+			StreamZapCount:  total.Count,
+		},
+	}
+
+	SendEventToWebhooks(webhookEvent)
+}
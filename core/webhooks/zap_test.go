@@ -0,0 +1,48 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+)
+
+func TestSendZapEvent(t *testing.T) {
+	checkPayload(t, models.ZapReceived, func() {
+		SendZapEvent(models.Zap{
+			Timestamp:  time.Unix(72, 0).UTC(),
+			ID:         "receipt id",
+			StreamID:   "oni-1",
+			Sender:     "sender pubkey",
+			Recipient:  "recipient pubkey",
+			Comment:    "great stream",
+			AmountMsat: 21000,
+		}, "satoshi", models.ZapTotal{
+			StreamID:   "oni-1",
+			Count:      2,
+			AmountMsat: 42000,
+		})
+	}, `{
+		"amountMsat": 21000,
+		"comment": "great stream",
+		"id": "receipt id",
+		"recipient": "recipient pubkey",
+		"sender": "sender pubkey",
+		"senderName": "satoshi",
+		"serverURL": "http://localhost:8080",
+		"status": {
+			"lastConnectTime": null,
+			"lastDisconnectTime": null,
+			"online": true,
+			"overallMaxViewerCount": 420,
+			"sessionMaxViewerCount": 69,
+			"streamTitle": "my stream",
+			"versionNumber": "1.2.3",
+			"viewerCount": 5
+		},
+		"streamId": "oni-1",
+		"streamTotalMsat": 42000,
+		"streamZapCount": 2,
+		"timestamp": "1970-01-01T00:01:12Z"
+	}`)
+}
@@ -0,0 +1,230 @@
+package whip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Matroska element IDs.
+const (
+	idEBML               = 0x1A45DFA3
+	idEBMLVersion        = 0x4286
+	idEBMLReadVersion    = 0x42F7
+	idEBMLMaxIDLength    = 0x42F2
+	idEBMLMaxSizeLength  = 0x42F3
+	idDocType            = 0x4282
+	idDocTypeVersion     = 0x4287
+	idDocTypeReadVersion = 0x4285
+	idSegment            = 0x18538067
+	idInfo               = 0x1549A966
+	idTimestampScale     = 0x2AD7B1
+	idMuxingApp          = 0x4D80
+	idWritingApp         = 0x5741
+	idTracks             = 0x1654AE6B
+	idTrackEntry         = 0xAE
+	idTrackNumber        = 0xD7
+	idTrackUID           = 0x73C5
+	idTrackType          = 0x83
+	idFlagLacing         = 0x9C
+	idCodecID            = 0x86
+	idCodecPrivate       = 0x63A2
+	idVideo              = 0xE0
+	idPixelWidth         = 0xB0
+	idPixelHeight        = 0xBA
+	idAudio              = 0xE1
+	idSamplingFrequency  = 0xB5
+	idChannels           = 0x9F
+	idCluster            = 0x1F43B675
+	idTimestamp          = 0xE7
+	idSimpleBlock        = 0xA3
+)
+
+const (
+	trackTypeVideo = 1
+	trackTypeAudio = 2
+
+	// Block timestamps are 16 bit offsets from their cluster, so start a
+	// new cluster well before they overflow.
+	maxClusterDuration = 30000
+
+	// The size of an element that is written before its length is known.
+	unknownSize = 0x01FFFFFFFFFFFFFF
+)
+
+// matroskaTrack describes a track of the live Matroska stream.
+type matroskaTrack struct {
+	number       uint64
+	trackType    uint64
+	codecID      string
+	codecPrivate []byte
+	width        int
+	height       int
+	sampleRate   float64
+	channels     int
+}
+
+// matroskaWriter writes a live Matroska stream, the container ffmpeg reads
+// the WebRTC codecs from. Timestamps are in milliseconds.
+type matroskaWriter struct {
+	w               io.Writer
+	clusterStarted  bool
+	clusterTimecode int64
+}
+
+func newMatroskaWriter(w io.Writer, tracks []matroskaTrack) (*matroskaWriter, error) {
+	var header bytes.Buffer
+
+	writeMaster(&header, idEBML, func(b *bytes.Buffer) {
+		writeUint(b, idEBMLVersion, 1)
+		writeUint(b, idEBMLReadVersion, 1)
+		writeUint(b, idEBMLMaxIDLength, 4)
+		writeUint(b, idEBMLMaxSizeLength, 8)
+		writeString(b, idDocType, "matroska")
+		writeUint(b, idDocTypeVersion, 4)
+		writeUint(b, idDocTypeReadVersion, 2)
+	})
+
+	// The stream has no end, so neither does the segment.
+	writeID(&header, idSegment)
+	writeSize(&header, unknownSize)
+
+	writeMaster(&header, idInfo, func(b *bytes.Buffer) {
+		writeUint(b, idTimestampScale, 1000000)
+		writeString(b, idMuxingApp, "oni")
+		writeString(b, idWritingApp, "oni")
+	})
+
+	writeMaster(&header, idTracks, func(b *bytes.Buffer) {
+		for _, track := range tracks {
+			writeMaster(b, idTrackEntry, func(b *bytes.Buffer) {
+				writeUint(b, idTrackNumber, track.number)
+				writeUint(b, idTrackUID, track.number)
+				writeUint(b, idTrackType, track.trackType)
+				writeUint(b, idFlagLacing, 0)
+				writeString(b, idCodecID, track.codecID)
+				if len(track.codecPrivate) > 0 {
+					writeBinary(b, idCodecPrivate, track.codecPrivate)
+				}
+
+				switch track.trackType {
+				case trackTypeVideo:
+					writeMaster(b, idVideo, func(b *bytes.Buffer) {
+						writeUint(b, idPixelWidth, uint64(track.width))
+						writeUint(b, idPixelHeight, uint64(track.height))
+					})
+				case trackTypeAudio:
+					writeMaster(b, idAudio, func(b *bytes.Buffer) {
+						writeFloat(b, idSamplingFrequency, track.sampleRate)
+						writeUint(b, idChannels, uint64(track.channels))
+					})
+				}
+			})
+		}
+	})
+
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return &matroskaWriter{w: w}, nil
+}
+
+// writeBlock will write a frame of the track. A new cluster is started
+// with each video keyframe, so players can join the stream there.
+func (m *matroskaWriter) writeBlock(track uint64, timecode int64, keyframe bool, data []byte) error {
+	var b bytes.Buffer
+
+	offset := timecode - m.clusterTimecode
+	if !m.clusterStarted || (keyframe && offset != 0) || offset > maxClusterDuration || offset < -maxClusterDuration {
+		writeID(&b, idCluster)
+		writeSize(&b, unknownSize)
+		writeUint(&b, idTimestamp, uint64(max(timecode, 0)))
+
+		m.clusterStarted = true
+		m.clusterTimecode = max(timecode, 0)
+		offset = timecode - m.clusterTimecode
+	}
+
+	var flags byte
+	if keyframe {
+		flags = 0x80
+	}
+
+	writeID(&b, idSimpleBlock)
+	writeSize(&b, uint64(len(data)+4))
+	b.WriteByte(0x80 | byte(track))
+	_ = binary.Write(&b, binary.BigEndian, int16(offset))
+	b.WriteByte(flags)
+	b.Write(data)
+
+	_, err := m.w.Write(b.Bytes())
+	return err
+}
+
+func writeID(b *bytes.Buffer, id uint32) {
+	switch {
+	case id > 0xFFFFFF:
+		b.Write([]byte{byte(id >> 24), byte(id >> 16), byte(id >> 8), byte(id)})
+	case id > 0xFFFF:
+		b.Write([]byte{byte(id >> 16), byte(id >> 8), byte(id)})
+	case id > 0xFF:
+		b.Write([]byte{byte(id >> 8), byte(id)})
+	default:
+		b.WriteByte(byte(id))
+	}
+}
+
+// writeSize writes the size as a variable length integer.
+func writeSize(b *bytes.Buffer, size uint64) {
+	if size == unknownSize {
+		b.Write([]byte{0x01, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF})
+		return
+	}
+
+	length := 1
+	for length < 8 && size >= 1<<(7*length)-1 {
+		length++
+	}
+
+	size |= 1 << (7 * length)
+	for i := length - 1; i >= 0; i-- {
+		b.WriteByte(byte(size >> (8 * i)))
+	}
+}
+
+func writeMaster(b *bytes.Buffer, id uint32, children func(*bytes.Buffer)) {
+	var body bytes.Buffer
+	children(&body)
+	writeBinary(b, id, body.Bytes())
+}
+
+func writeUint(b *bytes.Buffer, id uint32, value uint64) {
+	length := 1
+	for length < 8 && value >= 1<<(8*length) {
+		length++
+	}
+
+	writeID(b, id)
+	writeSize(b, uint64(length))
+	for i := length - 1; i >= 0; i-- {
+		b.WriteByte(byte(value >> (8 * i)))
+	}
+}
+
+func writeFloat(b *bytes.Buffer, id uint32, value float64) {
+	writeID(b, id)
+	writeSize(b, 8)
+	_ = binary.Write(b, binary.BigEndian, math.Float64bits(value))
+}
+
+func writeString(b *bytes.Buffer, id uint32, value string) {
+	writeBinary(b, id, []byte(value))
+}
+
+func writeBinary(b *bytes.Buffer, id uint32, value []byte) {
+	writeID(b, id)
+	writeSize(b, uint64(len(value)))
+	b.Write(value)
+}
@@ -0,0 +1,62 @@
+package whip
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteSize(t *testing.T) {
+	tests := []struct {
+		size uint64
+		want []byte
+	}{
+		{0, []byte{0x80}},
+		{126, []byte{0xFE}},
+		{127, []byte{0x40, 0x7F}},
+		{16382, []byte{0x7F, 0xFE}},
+		{16383, []byte{0x20, 0x3F, 0xFF}},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		writeSize(&b, test.size)
+		if !bytes.Equal(b.Bytes(), test.want) {
+			t.Errorf("%d: expected %x, got %x", test.size, test.want, b.Bytes())
+		}
+	}
+}
+
+func TestMatroskaClusters(t *testing.T) {
+	var b bytes.Buffer
+	m, err := newMatroskaWriter(&b, []matroskaTrack{{number: 1, trackType: trackTypeVideo, codecID: "V_MPEG4/ISO/AVC", width: 1280, height: 720}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := []byte{0x1F, 0x43, 0xB6, 0x75}
+	blocks := []struct {
+		timecode int64
+		keyframe bool
+		clusters int
+	}{
+		{0, true, 1},
+		{33, false, 1},
+		{2000, true, 2},
+		{2033, false, 2},
+		{33000, false, 3},
+	}
+
+	for _, block := range blocks {
+		if err := m.writeBlock(1, block.timecode, block.keyframe, []byte{0x00}); err != nil {
+			t.Fatal(err)
+		}
+		if clusters := bytes.Count(b.Bytes(), cluster); clusters != block.clusters {
+			t.Errorf("at %dms expected %d clusters, got %d", block.timecode, block.clusters, clusters)
+		}
+	}
+
+	// The last block is right at the start of its cluster.
+	if !bytes.HasSuffix(b.Bytes(), []byte{0xA3, 0x85, 0x81, 0x00, 0x00, 0x00, 0x00}) {
+		t.Errorf("expected a block at the cluster timestamp, got %x", b.Bytes()[b.Len()-7:])
+	}
+}
@@ -0,0 +1,428 @@
+package whip
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nareix/joy5/codec/h264"
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp/codecs"
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media"
+	"github.com/pion/webrtc/v4/pkg/media/samplebuilder"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/utils"
+)
+
+const (
+	videoTrackNumber = 1
+	audioTrackNumber = 2
+
+	videoClockRate = 90000
+	audioClockRate = 48000
+
+	// How many packets the sample builders wait for reordered packets.
+	maxVideoLate = 256
+	maxAudioLate = 32
+
+	// How often a keyframe is requested until the stream can start.
+	keyframeRequestInterval = 2 * time.Second
+
+	// How often the broadcaster details and link stats are updated.
+	statsInterval = 5 * time.Second
+)
+
+// session is a WHIP broadcaster's peer connection, remuxing the media it
+// receives into the stream the transcoder reads.
+type session struct {
+	id         string
+	streamKey  string
+	remoteAddr string
+	hasAudio   bool
+	server     *Server
+	pc         *webrtc.PeerConnection
+
+	streamOut *io.PipeReader
+	streamIn  *io.PipeWriter
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// Guarded by the lock.
+	codec       *h264.Codec
+	muxer       *matroskaWriter
+	startedAt   time.Time
+	videoClock  mediaClock
+	audioClock  mediaClock
+	videoBytes  uint64
+	audioBytes  uint64
+	framerate   float32
+	connectedAt time.Time
+	lock        sync.Mutex
+}
+
+func newSession(server *Server, streamKey string, remoteAddr string, hasAudio bool) (*session, error) {
+	id, err := utils.GenerateRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := server.api.NewPeerConnection(webrtc.Configuration{})
+	if err != nil {
+		return nil, err
+	}
+
+	streamOut, streamIn := io.Pipe()
+	s := &session{
+		id:          id,
+		streamKey:   streamKey,
+		remoteAddr:  remoteAddr,
+		hasAudio:    hasAudio,
+		server:      server,
+		pc:          pc,
+		streamOut:   streamOut,
+		streamIn:    streamIn,
+		done:        make(chan struct{}),
+		codec:       h264.NewCodec(),
+		videoClock:  mediaClock{clockRate: videoClockRate},
+		audioClock:  mediaClock{clockRate: audioClockRate},
+		connectedAt: time.Now(),
+	}
+
+	pc.OnTrack(s.handleTrack)
+	pc.OnConnectionStateChange(func(state webrtc.PeerConnectionState) {
+		log.Traceln("WHIP connection state changed to", state)
+		if state == webrtc.PeerConnectionStateFailed || state == webrtc.PeerConnectionStateClosed {
+			server.endSession(s)
+		}
+	})
+
+	return s, nil
+}
+
+// answer returns the answer to the broadcaster's offer, with all of the
+// candidates as WHIP clients do not need to trickle them.
+func (s *session) answer(r *http.Request, offer string) (string, error) {
+	if err := s.pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeOffer, SDP: offer}); err != nil {
+		return "", err
+	}
+
+	answer, err := s.pc.CreateAnswer(nil)
+	if err != nil {
+		return "", err
+	}
+
+	gatherComplete := webrtc.GatheringCompletePromise(s.pc)
+	if err := s.pc.SetLocalDescription(answer); err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), gatherTimeout)
+	defer cancel()
+	select {
+	case <-gatherComplete:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	return s.pc.LocalDescription().SDP, nil
+}
+
+func (s *session) handleTrack(track *webrtc.TrackRemote, _ *webrtc.RTPReceiver) {
+	switch {
+	case track.Kind() == webrtc.RTPCodecTypeVideo && track.Codec().MimeType == webrtc.MimeTypeH264:
+		go s.requestKeyframes(track)
+		s.readTrack(track, samplebuilder.New(maxVideoLate, &codecs.H264Packet{}, videoClockRate), s.writeVideo)
+	case track.Kind() == webrtc.RTPCodecTypeAudio && track.Codec().MimeType == webrtc.MimeTypeOpus:
+		s.readTrack(track, samplebuilder.New(maxAudioLate, &codecs.OpusPacket{}, audioClockRate), s.writeAudio)
+	default:
+		log.Warnln("Ignoring unsupported WHIP track", track.Codec().MimeType)
+	}
+}
+
+func (s *session) readTrack(track *webrtc.TrackRemote, builder *samplebuilder.SampleBuilder, write func(*media.Sample) error) {
+	for {
+		packet, _, err := track.ReadRTP()
+		if err != nil {
+			return
+		}
+
+		builder.Push(packet)
+		for sample := builder.Pop(); sample != nil; sample = builder.Pop() {
+			if err := write(sample); err != nil {
+				log.Debugln("unable to write whip data", err)
+				s.server.endSession(s)
+				return
+			}
+		}
+	}
+}
+
+// requestKeyframes asks the broadcaster for a keyframe until the stream
+// started, as it can only start with one.
+func (s *session) requestKeyframes(track *webrtc.TrackRemote) {
+	ticker := time.NewTicker(keyframeRequestInterval)
+	defer ticker.Stop()
+
+	for {
+		s.lock.Lock()
+		started := s.muxer != nil
+		s.lock.Unlock()
+		if started {
+			return
+		}
+
+		if err := s.pc.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: uint32(track.SSRC())}}); err != nil {
+			return
+		}
+
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *session) writeVideo(sample *media.Sample) error {
+	nalus, _ := h264.SplitNALUs(sample.Data)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	keyframe := false
+	for _, nalu := range nalus {
+		switch h264.NALUType(nalu) {
+		case h264.NALU_SPS, h264.NALU_PPS:
+			s.codec.AddSPSPPS(nalu)
+		case h264.NALU_IDR:
+			keyframe = true
+		}
+	}
+
+	if s.muxer == nil {
+		if !keyframe || len(s.codec.SPS) == 0 || len(s.codec.PPS) == 0 {
+			return nil
+		}
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	s.videoBytes += uint64(len(sample.Data))
+	timecode := s.videoClock.timecode(sample.PacketTimestamp, s.startedAt)
+
+	return s.muxer.writeBlock(videoTrackNumber, timecode, keyframe, h264.JoinNALUsAVCC(nalus))
+}
+
+func (s *session) writeAudio(sample *media.Sample) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Audio before the first keyframe has no video to go with.
+	if s.muxer == nil || !s.hasAudio {
+		return nil
+	}
+
+	s.audioBytes += uint64(len(sample.Data))
+	timecode := s.audioClock.timecode(sample.PacketTimestamp, s.startedAt)
+
+	return s.muxer.writeBlock(audioTrackNumber, timecode, true, sample.Data)
+}
+
+// start will connect the stream once the first keyframe arrived. Must be
+// called with the lock held.
+func (s *session) start() error {
+	for _, sps := range s.codec.SPS {
+		if info, err := h264.ParseSPS(sps); err == nil {
+			s.framerate = float32(info.FPS)
+		}
+	}
+
+	tracks := []matroskaTrack{{
+		number:       videoTrackNumber,
+		trackType:    trackTypeVideo,
+		codecID:      "V_MPEG4/ISO/AVC",
+		codecPrivate: avcDecoderConfig(s.codec),
+		width:        s.codec.W,
+		height:       s.codec.H,
+	}}
+	if s.hasAudio {
+		tracks = append(tracks, matroskaTrack{
+			number:       audioTrackNumber,
+			trackType:    trackTypeAudio,
+			codecID:      "A_OPUS",
+			codecPrivate: opusHead(2, audioClockRate),
+			sampleRate:   audioClockRate,
+			channels:     2,
+		})
+	}
+
+	log.Infoln("Inbound WHIP stream connected from", s.remoteAddr)
+
+	// The transcoder reads the stream, so it has to be running before the
+	// header can be written.
+	s.server.setStreamAsConnected(s.streamOut)
+
+	muxer, err := newMatroskaWriter(s.streamIn, tracks)
+	if err != nil {
+		return err
+	}
+	s.muxer = muxer
+	s.startedAt = time.Now()
+
+	go s.reportBroadcaster()
+
+	return nil
+}
+
+// reportBroadcaster will update the broadcaster details with what the
+// stream and the link look like until the session is closed.
+func (s *session) reportBroadcaster() {
+	ticker := time.NewTicker(statsInterval)
+	defer ticker.Stop()
+
+	s.server.setBroadcaster(s.broadcaster(nil, 0))
+
+	lastUpdate := time.Now()
+	var lastReceived, lastLost int64
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case now := <-ticker.C:
+			link := &models.InboundLinkStats{}
+			var received, lost int64
+			for _, stats := range s.pc.GetStats() {
+				switch stats := stats.(type) {
+				case webrtc.ICECandidatePairStats:
+					if stats.Nominated {
+						link.RTT = stats.CurrentRoundTripTime * 1000
+					}
+				case webrtc.InboundRTPStreamStats:
+					received += int64(stats.PacketsReceived)
+					lost += int64(stats.PacketsLost)
+				}
+			}
+
+			link.PacketsLost = uint64(max(lost, 0))
+			if expected := (received - lastReceived) + (lost - lastLost); expected > 0 {
+				link.PacketLoss = float64(max(lost-lastLost, 0)) / float64(expected) * 100
+			}
+			lastReceived, lastLost = received, lost
+
+			s.server.setBroadcaster(s.broadcaster(link, now.Sub(lastUpdate)))
+			lastUpdate = now
+		}
+	}
+}
+
+// broadcaster returns the broadcaster details, with the bitrates averaged
+// over the interval since the previous call.
+func (s *session) broadcaster(link *models.InboundLinkStats, interval time.Duration) models.Broadcaster {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	details := models.InboundStreamDetails{
+		VideoCodec:     "H.264",
+		AudioCodec:     "No audio",
+		Width:          s.codec.W,
+		Height:         s.codec.H,
+		VideoFramerate: s.framerate,
+		VideoOnly:      !s.hasAudio,
+	}
+	if s.hasAudio {
+		details.AudioCodec = "Opus"
+	}
+
+	if seconds := interval.Seconds(); seconds > 0 {
+		details.VideoBitrate = int(float64(s.videoBytes) * 8 / 1000 / seconds)
+		details.AudioBitrate = int(float64(s.audioBytes) * 8 / 1000 / seconds)
+		if link != nil {
+			link.ReceiveBitrate = details.VideoBitrate + details.AudioBitrate
+		}
+	}
+	s.videoBytes = 0
+	s.audioBytes = 0
+
+	return models.Broadcaster{
+		RemoteAddr:    s.remoteAddr,
+		Time:          s.connectedAt,
+		Protocol:      ingest.WHIP,
+		StreamDetails: details,
+		Link:          link,
+	}
+}
+
+// close will end the session. Closing the stream makes the transcoder
+// stop, the same as when any other broadcaster disconnects.
+func (s *session) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		_ = s.streamIn.Close()
+		if err := s.pc.Close(); err != nil {
+			log.Debugln(err)
+		}
+	})
+}
+
+// mediaClock turns the RTP timestamps of a track into stream milliseconds.
+type mediaClock struct {
+	clockRate int64
+	started   bool
+	last      uint32
+	ticks     int64
+	offset    int64
+}
+
+// timecode returns the milliseconds of the RTP timestamp since the stream
+// started. Tracks are lined up by when their first sample arrived.
+func (c *mediaClock) timecode(timestamp uint32, startedAt time.Time) int64 {
+	if !c.started {
+		c.started = true
+		c.last = timestamp
+		c.offset = time.Since(startedAt).Milliseconds()
+	}
+
+	c.ticks += int64(int32(timestamp - c.last))
+	c.last = timestamp
+
+	return c.offset + c.ticks*1000/c.clockRate
+}
+
+// avcDecoderConfig returns the AVCDecoderConfigurationRecord of the codec,
+// the H.264 codec private data of Matroska.
+func avcDecoderConfig(codec *h264.Codec) []byte {
+	size := 7
+	for _, nalu := range codec.SPS {
+		size += 2 + len(nalu)
+	}
+	for _, nalu := range codec.PPS {
+		size += 2 + len(nalu)
+	}
+
+	b := make([]byte, size)
+	n := 0
+	codec.ToConfig(b, &n)
+
+	return b[:n]
+}
+
+// opusHead returns the Opus identification header, the Opus codec private
+// data of Matroska.
+func opusHead(channels int, sampleRate uint32) []byte {
+	b := make([]byte, 19)
+	copy(b, "OpusHead")
+	b[8] = 1
+	b[9] = byte(channels)
+	binary.LittleEndian.PutUint32(b[12:], sampleRate)
+
+	return b
+}
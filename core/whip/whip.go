@@ -0,0 +1,367 @@
+// Package whip accepts inbound streams over WebRTC, using the WebRTC-HTTP
+// ingestion protocol (WHIP) that OBS and browsers publish with.
+package whip
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pion/interceptor"
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/utils"
+)
+
+// Path is where broadcasters publish to. Each session is a resource below
+// it that is deleted to end the stream.
+const Path = "/whip"
+
+const (
+	sdpContentType = "application/sdp"
+
+	// The most an offer may be, they are a few kilobytes at most.
+	maxOfferSize = 64 * 1024
+
+	// How long to gather the candidates sent in the answer.
+	gatherTimeout = 5 * time.Second
+)
+
+var _server *Server
+
+// Start will accept WHIP broadcasters, with the WebRTC media on the
+// configured UDP port.
+func Start(setStreamAsConnected func(*io.PipeReader), setBroadcaster func(models.Broadcaster)) {
+	port := configrepository.Get().GetWebRTCPortNumber()
+
+	settingEngine := webrtc.SettingEngine{}
+	udpListener, err := net.ListenUDP("udp", &net.UDPAddr{Port: port})
+	if err != nil {
+		log.Errorln("Unable to listen for WebRTC on UDP port", port, "WHIP broadcasters will use random ports.", err)
+	} else {
+		settingEngine.SetICEUDPMux(webrtc.NewICEUDPMux(nil, udpListener))
+		log.Tracef("WHIP is accepting WebRTC media on UDP port: %d", port)
+	}
+
+	api, err := newAPI(settingEngine)
+	if err != nil {
+		log.Errorln("Unable to start the WHIP server.", err)
+		return
+	}
+
+	_server = newServer(api, setStreamAsConnected, setBroadcaster)
+}
+
+// HandleRequest will publish, or end, a WHIP broadcast.
+func HandleRequest(w http.ResponseWriter, r *http.Request) {
+	if _server == nil {
+		http.Error(w, "WHIP is not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	_server.ServeHTTP(w, r)
+}
+
+// Disconnect will force disconnect the current WHIP broadcaster.
+func Disconnect() {
+	if _server == nil {
+		return
+	}
+
+	_server.Disconnect()
+}
+
+// newAPI returns a WebRTC API that only negotiates the codecs the stream
+// is remuxed with.
+func newAPI(settingEngine webrtc.SettingEngine) (*webrtc.API, error) {
+	mediaEngine := &webrtc.MediaEngine{}
+
+	videoFeedback := []webrtc.RTCPFeedback{{Type: "goog-remb"}, {Type: "nack"}, {Type: "nack", Parameter: "pli"}}
+	for i, fmtp := range []string{
+		"level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=42e01f",
+		"level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=42001f",
+		"level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=4d001f",
+		"level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=640032",
+	} {
+		if err := mediaEngine.RegisterCodec(webrtc.RTPCodecParameters{
+			RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeH264, ClockRate: 90000, SDPFmtpLine: fmtp, RTCPFeedback: videoFeedback},
+			PayloadType:        webrtc.PayloadType(102 + 2*i),
+		}, webrtc.RTPCodecTypeVideo); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mediaEngine.RegisterCodec(webrtc.RTPCodecParameters{
+		RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus, ClockRate: 48000, Channels: 2, SDPFmtpLine: "minptime=10;useinbandfec=1"},
+		PayloadType:        111,
+	}, webrtc.RTPCodecTypeAudio); err != nil {
+		return nil, err
+	}
+
+	interceptors := &interceptor.Registry{}
+	if err := webrtc.RegisterDefaultInterceptors(mediaEngine, interceptors); err != nil {
+		return nil, err
+	}
+
+	settingEngine.SetICETimeouts(5*time.Second, 10*time.Second, 2*time.Second)
+
+	return webrtc.NewAPI(
+		webrtc.WithMediaEngine(mediaEngine),
+		webrtc.WithInterceptorRegistry(interceptors),
+		webrtc.WithSettingEngine(settingEngine),
+	), nil
+}
+
+// Server publishes the streams of WHIP broadcasters.
+type Server struct {
+	api                  *webrtc.API
+	setStreamAsConnected func(*io.PipeReader)
+	setBroadcaster       func(models.Broadcaster)
+	session              *session
+	lock                 sync.Mutex
+}
+
+func newServer(api *webrtc.API, setStreamAsConnected func(*io.PipeReader), setBroadcaster func(models.Broadcaster)) *Server {
+	return &Server{
+		api:                  api,
+		setStreamAsConnected: setStreamAsConnected,
+		setBroadcaster:       setBroadcaster,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Expose-Headers", "Location")
+
+	sessionID := strings.Trim(strings.TrimPrefix(r.URL.Path, Path), "/")
+
+	switch {
+	case r.Method == http.MethodOptions:
+		w.Header().Set("Access-Control-Allow-Methods", "POST, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && sessionID == "":
+		s.publish(w, r)
+	case r.Method == http.MethodDelete && sessionID != "":
+		s.unpublish(w, r, sessionID)
+	default:
+		// Trickle ICE and ICE restarts are not supported.
+		w.Header().Set("Allow", "POST, DELETE, OPTIONS")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) publish(w http.ResponseWriter, r *http.Request) {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != sdpContentType {
+		http.Error(w, "the offer must be "+sdpContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	remoteAddr := utils.GetIPAddressFromRequest(r)
+	streamKey, _ := bearerToken(r)
+	decision, authorized := authorize(streamKey, remoteAddr)
+	if !authorized {
+		log.Errorln("invalid streaming key; rejecting incoming WHIP stream from", remoteAddr)
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "invalid stream key", http.StatusUnauthorized)
+		return
+	}
+
+	offer, err := io.ReadAll(io.LimitReader(r.Body, maxOfferSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	hasAudio, err := negotiableMedia(offer)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotAcceptable)
+		return
+	}
+
+	s.lock.Lock()
+	if s.session != nil || !ingest.Claim(ingest.WHIP) {
+		s.lock.Unlock()
+		log.Errorln("stream already running; can not overtake an existing stream from", remoteAddr)
+		http.Error(w, "a stream is already running", http.StatusConflict)
+		return
+	}
+	sess, err := newSession(s, streamKey, remoteAddr, hasAudio)
+	if err != nil {
+		ingest.Release(ingest.WHIP)
+		s.lock.Unlock()
+		log.Errorln("unable to create WHIP session", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.session = sess
+	s.lock.Unlock()
+	ingest.SetStreamKey(streamKey, remoteAddr)
+	if decision != nil {
+		ingest.ApplyPublishDecision(*decision)
+	}
+
+	answer, err := sess.answer(r, string(offer))
+	if err != nil {
+		s.endSession(sess)
+		log.Errorln("unable to answer WHIP offer from", remoteAddr, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	log.Infoln("Inbound WHIP stream connecting from", remoteAddr)
+
+	w.Header().Set("Content-Type", sdpContentType)
+	w.Header().Set("Location", Path+"/"+sess.id)
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(answer))
+}
+
+func (s *Server) unpublish(w http.ResponseWriter, r *http.Request, sessionID string) {
+	s.lock.Lock()
+	sess := s.session
+	s.lock.Unlock()
+
+	// The publish callback may have allowed a key that is not configured,
+	// so the key the session was published with can end it too.
+	token, _ := bearerToken(r)
+	if !isValidStreamKey(token) && (sess == nil || !secretEqual(token, sess.streamKey)) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "invalid stream key", http.StatusUnauthorized)
+		return
+	}
+
+	if sess == nil || !secretEqual(sess.id, sessionID) {
+		http.Error(w, "no such session", http.StatusNotFound)
+		return
+	}
+
+	log.Infoln("WHIP broadcaster ended the stream.")
+	s.endSession(sess)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Disconnect will end the current session.
+func (s *Server) Disconnect() {
+	s.lock.Lock()
+	sess := s.session
+	s.lock.Unlock()
+
+	if sess == nil {
+		return
+	}
+
+	log.Traceln("Inbound WHIP stream disconnect requested.")
+	s.endSession(sess)
+}
+
+// endSession will close the session, which ends the stream the same way
+// as a broadcaster disconnecting.
+func (s *Server) endSession(sess *session) {
+	s.lock.Lock()
+	current := s.session == sess
+	if current {
+		s.session = nil
+	}
+	s.lock.Unlock()
+
+	sess.close()
+	if current {
+		log.Infoln("WHIP stream disconnected.")
+		ingest.Release(ingest.WHIP)
+	}
+}
+
+// bearerToken returns the Bearer token of the request, which is the stream
+// key the broadcaster streams with.
+func bearerToken(r *http.Request) (string, bool) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return token, found && token != ""
+}
+
+// authorize returns if the broadcaster may stream with the key, asking the
+// publish callback instead of checking the configured keys when it is
+// enabled.
+func authorize(streamKey string, remoteAddr string) (*ingest.PublishDecision, bool) {
+	if streamKey == "" {
+		return nil, false
+	}
+
+	if !ingest.PublishCallbackEnabled() {
+		return nil, isValidStreamKey(streamKey)
+	}
+
+	decision := ingest.AuthorizePublish(ingest.PublishRequest{
+		StreamKey:  streamKey,
+		RemoteAddr: remoteAddr,
+		Protocol:   ingest.WHIP,
+	})
+	if !decision.Allowed {
+		log.Errorln("the publish callback denied the WHIP stream from", remoteAddr, decision.Reason)
+		return nil, false
+	}
+
+	return &decision, true
+}
+
+// isValidStreamKey returns if the key is one the broadcaster may stream with.
+func isValidStreamKey(streamKey string) bool {
+	if streamKey == "" {
+		return false
+	}
+
+	for _, key := range ingest.StreamKeys() {
+		if secretEqual(streamKey, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func secretEqual(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// negotiableMedia checks the offer sends H.264 video, and returns if it
+// also sends Opus audio.
+func negotiableMedia(offer []byte) (hasAudio bool, err error) {
+	description := sdp.SessionDescription{}
+	if err := description.Unmarshal(offer); err != nil {
+		return false, fmt.Errorf("invalid offer: %w", err)
+	}
+
+	hasVideo := false
+	for _, media := range description.MediaDescriptions {
+		for _, attribute := range media.Attributes {
+			if attribute.Key != "rtpmap" {
+				continue
+			}
+
+			codec := strings.ToLower(attribute.Value)
+			switch {
+			case media.MediaName.Media == "video" && strings.Contains(codec, "h264/"):
+				hasVideo = true
+			case media.MediaName.Media == "audio" && strings.Contains(codec, "opus/"):
+				hasAudio = true
+			}
+		}
+	}
+
+	if !hasVideo {
+		return false, fmt.Errorf("the offer must send H.264 video")
+	}
+
+	return hasAudio, nil
+}
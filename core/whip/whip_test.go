@@ -0,0 +1,340 @@
+package whip
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+const testStreamKey = "whip-test-key"
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-whip-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// A 1280x720 30fps H.264 keyframe, with its parameter sets.
+var testKeyframe = bytes.Join([][]byte{
+	nil,
+	{0x67, 0x64, 0x00, 0x1f, 0xac, 0xd9, 0x40, 0x50, 0x05, 0xbb, 0x01, 0x10, 0x00, 0x00, 0x03, 0x00, 0x10, 0x00, 0x00, 0x03, 0x03, 0xc0, 0xf1, 0x83, 0x19, 0x60},
+	{0x68, 0xeb, 0xe3, 0xcb, 0x22, 0xc0},
+	{0x65, 0x88, 0x84, 0x00, 0x33, 0xff, 0xfe, 0xf6, 0xf0, 0xfe, 0x05, 0x36, 0x56, 0x04, 0x50, 0x96},
+}, []byte{0x00, 0x00, 0x00, 0x01})
+
+// stream collects what the server hands to the transcoder.
+type stream struct {
+	data        bytes.Buffer
+	ended       chan struct{}
+	broadcaster models.Broadcaster
+	lock        sync.Mutex
+}
+
+func (s *stream) connected(r *io.PipeReader) {
+	go func() {
+		buffer := make([]byte, 4096)
+		for {
+			n, err := r.Read(buffer)
+			s.lock.Lock()
+			s.data.Write(buffer[:n])
+			s.lock.Unlock()
+			if err != nil {
+				close(s.ended)
+				return
+			}
+		}
+	}()
+}
+
+func (s *stream) setBroadcaster(b models.Broadcaster) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.broadcaster = b
+}
+
+func (s *stream) contains(b []byte) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return bytes.Contains(s.data.Bytes(), b)
+}
+
+func testSettingEngine() webrtc.SettingEngine {
+	settingEngine := webrtc.SettingEngine{}
+	settingEngine.SetIncludeLoopbackCandidate(true)
+	settingEngine.SetNetworkTypes([]webrtc.NetworkType{webrtc.NetworkTypeUDP4})
+	return settingEngine
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, *stream) {
+	t.Helper()
+
+	config.TemporaryStreamKey = testStreamKey
+	t.Cleanup(func() { config.TemporaryStreamKey = "" })
+
+	api, err := newAPI(testSettingEngine())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &stream{ended: make(chan struct{})}
+	server := newServer(api, s.connected, s.setBroadcaster)
+	t.Cleanup(server.Disconnect)
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	return httpServer, s
+}
+
+// newPublisher returns a peer connection sending H.264 and Opus, and its
+// offer with all of the candidates.
+func newPublisher(t *testing.T) (*webrtc.PeerConnection, *webrtc.TrackLocalStaticSample, *webrtc.TrackLocalStaticSample, string) {
+	t.Helper()
+
+	mediaEngine := &webrtc.MediaEngine{}
+	if err := mediaEngine.RegisterDefaultCodecs(); err != nil {
+		t.Fatal(err)
+	}
+	api := webrtc.NewAPI(webrtc.WithMediaEngine(mediaEngine), webrtc.WithSettingEngine(testSettingEngine()))
+
+	pc, err := api.NewPeerConnection(webrtc.Configuration{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = pc.Close() })
+
+	video, err := webrtc.NewTrackLocalStaticSample(webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeH264, ClockRate: videoClockRate, SDPFmtpLine: "level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=42e01f"}, "video", "oni")
+	if err != nil {
+		t.Fatal(err)
+	}
+	audio, err := webrtc.NewTrackLocalStaticSample(webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus, ClockRate: audioClockRate, Channels: 2}, "audio", "oni")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, track := range []webrtc.TrackLocal{video, audio} {
+		if _, err := pc.AddTransceiverFromTrack(track, webrtc.RTPTransceiverInit{Direction: webrtc.RTPTransceiverDirectionSendonly}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	offer, err := pc.CreateOffer(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gatherComplete := webrtc.GatheringCompletePromise(pc)
+	if err := pc.SetLocalDescription(offer); err != nil {
+		t.Fatal(err)
+	}
+	<-gatherComplete
+
+	return pc, video, audio, pc.LocalDescription().SDP
+}
+
+func whipRequest(t *testing.T, method string, url string, streamKey string, body string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", sdpContentType)
+	if streamKey != "" {
+		req.Header.Set("Authorization", "Bearer "+streamKey)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for", what)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	httpServer, s := newTestServer(t)
+	pc, video, audio, offer := newPublisher(t)
+
+	resp := whipRequest(t, http.MethodPost, httpServer.URL+Path, testStreamKey, offer)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the offer to be answered, got %d", resp.StatusCode)
+	}
+	location := resp.Header.Get("Location")
+	if !strings.HasPrefix(location, Path+"/") {
+		t.Fatalf("expected the session resource in the location, got %q", location)
+	}
+	answer, _ := io.ReadAll(resp.Body)
+	if err := pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: string(answer)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp := whipRequest(t, http.MethodPost, httpServer.URL+Path, testStreamKey, offer); resp.StatusCode != http.StatusConflict {
+		t.Errorf("expected a second broadcaster to be rejected, got %d", resp.StatusCode)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		videoTicker := time.NewTicker(33 * time.Millisecond)
+		audioTicker := time.NewTicker(20 * time.Millisecond)
+		defer videoTicker.Stop()
+		defer audioTicker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-videoTicker.C:
+				_ = video.WriteSample(media.Sample{Data: testKeyframe, Duration: 33 * time.Millisecond})
+			case <-audioTicker.C:
+				_ = audio.WriteSample(media.Sample{Data: []byte{0xfc, 0xff, 0xfe}, Duration: 20 * time.Millisecond})
+			}
+		}
+	}()
+
+	waitFor(t, "the stream", func() bool {
+		return s.contains([]byte("V_MPEG4/ISO/AVC")) && s.contains([]byte("A_OPUS")) && s.contains([]byte{0x1F, 0x43, 0xB6, 0x75})
+	})
+
+	s.lock.Lock()
+	broadcaster := s.broadcaster
+	s.lock.Unlock()
+	if broadcaster.Protocol != "whip" || broadcaster.StreamDetails.Width != 1280 || broadcaster.StreamDetails.Height != 720 {
+		t.Errorf("expected a 1280x720 whip broadcaster, got %+v", broadcaster)
+	}
+
+	if resp := whipRequest(t, http.MethodDelete, httpServer.URL+Path+"/unknown", testStreamKey, ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected an unknown session to not be found, got %d", resp.StatusCode)
+	}
+	if resp := whipRequest(t, http.MethodDelete, httpServer.URL+location, testStreamKey, ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the session to be deleted, got %d", resp.StatusCode)
+	}
+
+	select {
+	case <-s.ended:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the stream to end once the session was deleted")
+	}
+}
+
+func TestPublishRejected(t *testing.T) {
+	httpServer, _ := newTestServer(t)
+	_, _, _, offer := newPublisher(t)
+
+	vp8Offer := "v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\ns=-\r\nt=0 0\r\nm=video 9 UDP/TLS/RTP/SAVPF 96\r\nc=IN IP4 0.0.0.0\r\na=rtpmap:96 VP8/90000\r\n"
+
+	tests := []struct {
+		name        string
+		contentType string
+		streamKey   string
+		offer       string
+		want        int
+	}{
+		{"missing stream key", sdpContentType, "", offer, http.StatusUnauthorized},
+		{"wrong stream key", sdpContentType, "wrong", offer, http.StatusUnauthorized},
+		{"not an offer", "application/json", testStreamKey, offer, http.StatusUnsupportedMediaType},
+		{"no H.264", sdpContentType, testStreamKey, vp8Offer, http.StatusNotAcceptable},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest(http.MethodPost, httpServer.URL+Path, strings.NewReader(test.offer))
+		req.Header.Set("Content-Type", test.contentType)
+		if test.streamKey != "" {
+			req.Header.Set("Authorization", "Bearer "+test.streamKey)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != test.want {
+			t.Errorf("%s: expected %d, got %d", test.name, test.want, resp.StatusCode)
+		}
+	}
+}
+
+func TestPublishCallback(t *testing.T) {
+	httpServer, _ := newTestServer(t)
+	pc, _, _, offer := newPublisher(t)
+
+	// The callback allows a key that is not configured, and denies the
+	// configured one.
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			StreamKey string `json:"streamKey"`
+			Protocol  string `json:"protocol"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if request.StreamKey != "callback-key" || request.Protocol != "whip" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"streamTitle": "Chosen by the callback"})
+	}))
+	defer callback.Close()
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetPublishCallbackConfig(models.PublishCallbackConfiguration{Enabled: true, URL: callback.URL}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = configRepository.SetPublishCallbackConfig(models.PublishCallbackConfiguration{}) }()
+
+	if resp := whipRequest(t, http.MethodPost, httpServer.URL+Path, testStreamKey, offer); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected the key the callback denies to be rejected, got %d", resp.StatusCode)
+	}
+
+	resp := whipRequest(t, http.MethodPost, httpServer.URL+Path, "callback-key", offer)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the key the callback allows to stream, got %d", resp.StatusCode)
+	}
+	answer, _ := io.ReadAll(resp.Body)
+	if err := pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: string(answer)}); err != nil {
+		t.Fatal(err)
+	}
+	if title := configRepository.GetStreamTitle(); title != "Chosen by the callback" {
+		t.Errorf("expected the stream title chosen by the callback, got %q", title)
+	}
+
+	if resp := whipRequest(t, http.MethodDelete, httpServer.URL+resp.Header.Get("Location"), "callback-key", ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the session to be deleted with its key, got %d", resp.StatusCode)
+	}
+}
@@ -29,6 +29,11 @@ var (
 	webServerPortOverride = flag.String("webserverport", "", "Force the web server to listen on a specific port")
 	webServerIPOverride   = flag.String("webserverip", "", "Force web server to listen on this IP address")
 	rtmpPortOverride      = flag.Int("rtmpport", 0, "Set listen port for the RTMP server")
+	srtPortOverride       = flag.Int("srtport", 0, "Set listen port for the SRT server")
+	webRTCPortOverride    = flag.Int("webrtcport", 0, "Set the UDP port for WHIP WebRTC media")
+	setupToken            = flag.String("setuptoken", "", "Set the one-time token required to claim the admin Nostr pubkey")
+	nostrKeyFile          = flag.String("nostrkeyfile", "", "Path to the secret used to encrypt the server Nostr key")
+	nostrKeyPassphrase    = flag.String("nostrkeypassphrase", "", "Passphrase used to encrypt the server Nostr key. Can also be set with ONI_NOSTR_KEY_PASSPHRASE")
 )
 
 // nolint:cyclop
@@ -130,15 +135,14 @@ func handleCommandLineFlags() {
 
 	// Set the web server port
 	if *webServerPortOverride != "" {
-		portNumber, err := strconv.Atoi(*webServerPortOverride)
-		if err != nil {
-			log.Warnln(err)
-			return
-		}
-
-		log.Println("Saving new web server port number to", portNumber)
-		if err := configRepository.SetHTTPPortNumber(float64(portNumber)); err != nil {
-			log.Errorln(err)
+		// An invalid port is ignored, the flags after it still apply.
+		if portNumber, err := strconv.Atoi(*webServerPortOverride); err != nil {
+			log.Warnln("Ignoring the invalid web server port", *webServerPortOverride, err)
+		} else {
+			log.Println("Saving new web server port number to", portNumber)
+			if err := configRepository.SetHTTPPortNumber(float64(portNumber)); err != nil {
+				log.Errorln(err)
+			}
 		}
 	}
 	config.WebServerPort = configRepository.GetHTTPPortNumber()
@@ -159,6 +163,55 @@ func handleCommandLineFlags() {
 			log.Errorln(err)
 		}
 	}
+
+	// Set the srt server port
+	if *srtPortOverride > 0 {
+		log.Println("Saving new SRT server port number to", *srtPortOverride)
+		if err := configRepository.SetSRTPortNumber(float64(*srtPortOverride)); err != nil {
+			log.Errorln(err)
+		}
+	}
+
+	// Set the webrtc media port
+	if *webRTCPortOverride > 0 {
+		log.Println("Saving new WebRTC port number to", *webRTCPortOverride)
+		if err := configRepository.SetWebRTCPortNumber(float64(*webRTCPortOverride)); err != nil {
+			log.Errorln(err)
+		}
+	}
+
+	if *nostrKeyFile != "" {
+		config.NostrKeyFile = *nostrKeyFile
+	}
+	config.NostrKeyPassphrase = *nostrKeyPassphrase
+	if config.NostrKeyPassphrase == "" {
+		config.NostrKeyPassphrase = os.Getenv("ONI_NOSTR_KEY_PASSPHRASE")
+	}
+
+	setupAdminBootstrapToken()
+}
+
+// setupAdminBootstrapToken creates the one-time token required to claim the
+// admin Nostr pubkey when one has not been configured yet.
+func setupAdminBootstrapToken() {
+	if configrepository.Get().GetAdminNostrPubkey() != "" {
+		return
+	}
+
+	if *setupToken != "" {
+		config.AdminSetupToken = *setupToken
+		log.Warnln("No admin Nostr pubkey is configured. Use the setup token provided with -setuptoken to claim it.")
+		return
+	}
+
+	token, err := utils.GenerateAccessToken()
+	if err != nil {
+		log.Errorln("Unable to generate an admin setup token", err)
+		return
+	}
+
+	config.AdminSetupToken = token
+	log.Warnln("No admin Nostr pubkey is configured. Use this one-time setup token to claim it:", token)
 }
 
 func configureLogging(enableDebugFeatures bool, enableVerboseLogging bool) {
@@ -2,7 +2,9 @@ package metrics
 
 import (
 	"fmt"
+	"math"
 	"sort"
+	"time"
 
 	"github.com/TekkadanPlays/oni/core"
 	"github.com/TekkadanPlays/oni/models"
@@ -14,6 +16,10 @@ const (
 	healthyPercentageMinValue = 75
 	maxCPUUsage               = 90
 	minClientCountForDetails  = 3
+
+	recommendedKeyframeIntervalSeconds = 2
+	maxKeyframeIntervalSeconds         = 4
+	maxAVDriftMilliseconds             = 1000
 )
 
 // GetStreamHealthOverview will return the stream health overview.
@@ -23,20 +29,22 @@ func GetStreamHealthOverview() *models.StreamHealthOverview {
 
 func generateStreamHealthOverview() {
 	// Determine what percentage of total players are represented in our overview.
+	// Problems with the inbound stream are worth knowing about before
+	// anybody is watching.
 	totalPlayerCount := len(core.GetActiveViewers())
 	if totalPlayerCount == 0 {
-		metrics.streamHealthOverview = nil
+		metrics.streamHealthOverview = inboundStreamHealthOverview()
 		return
 	}
 
 	pct := getClientErrorHeathyPercentage()
 	if pct < 1 {
-		metrics.streamHealthOverview = nil
+		metrics.streamHealthOverview = inboundStreamHealthOverview()
 		return
 	}
 
 	overview := &models.StreamHealthOverview{
-		Healthy:           pct > healthyPercentageMinValue,
+		Healthy:           pct > healthyPercentageMinValue && inboundStreamHealthOverviewMessage() == "",
 		HealthyPercentage: pct,
 		Message:           getStreamHealthOverviewMessage(),
 	}
@@ -49,8 +57,19 @@ func generateStreamHealthOverview() {
 	metrics.streamHealthOverview = overview
 }
 
+func inboundStreamHealthOverview() *models.StreamHealthOverview {
+	message := inboundStreamHealthOverviewMessage()
+	if message == "" {
+		return nil
+	}
+
+	return &models.StreamHealthOverview{Message: message}
+}
+
 func getStreamHealthOverviewMessage() string {
-	if message := wastefulBitrateOverviewMessage(); message != "" {
+	if message := inboundStreamHealthOverviewMessage(); message != "" {
+		return message
+	} else if message := wastefulBitrateOverviewMessage(); message != "" {
 		return message
 	} else if message := cpuUsageHealthOverviewMessage(); message != "" {
 		return message
@@ -63,6 +82,40 @@ func getStreamHealthOverviewMessage() string {
 	return ""
 }
 
+// inboundStreamHealthOverviewMessage will advise the broadcaster about
+// problems with the stream their encoder is sending.
+func inboundStreamHealthOverviewMessage() string {
+	health := metrics.inboundStreamHealth
+	if health == nil {
+		return ""
+	}
+
+	if health.KeyframeIntervalSeconds > maxKeyframeIntervalSeconds {
+		interval := math.Round(health.KeyframeIntervalSeconds*10) / 10
+		return fmt.Sprintf("Your keyframe interval is %gs, set it to %ds in your encoder. Long keyframe intervals make viewers wait to start watching and to change quality.", interval, recommendedKeyframeIntervalSeconds)
+	}
+
+	if drift := health.AVDriftMilliseconds; drift > maxAVDriftMilliseconds || drift < -maxAVDriftMilliseconds {
+		return fmt.Sprintf("Your audio and video are %.1fs out of sync as they arrive. Check the audio sync offset and sample rate in your encoder.", math.Abs(float64(drift))/1000)
+	}
+
+	since := time.Now().Add(-playbackMetricsPollingInterval)
+
+	if latePackets := recentInboundCount(metrics.latePackets, since); latePackets > 0 {
+		return fmt.Sprintf("%d packets from your encoder arrived late recently. Your upload speed may not keep up with your bitrate of %d kbps, you may want to lower it.", latePackets, health.BitrateKbps)
+	}
+
+	if droppedFrames := recentInboundCount(metrics.droppedFrames, since); droppedFrames > 0 {
+		return fmt.Sprintf("Your encoder dropped %d frames recently. It may be overloaded, you may want to use a faster preset or a lower resolution.", droppedFrames)
+	}
+
+	if timestampJumps := recentInboundCount(metrics.timestampJumps, since); timestampJumps > 0 {
+		return fmt.Sprintf("The timestamps of your stream jumped %d times recently, which can make playback stall for your viewers. Check that your encoder is not restarting its output.", timestampJumps)
+	}
+
+	return ""
+}
+
 func networkSpeedHealthOverviewMessage() string {
 	type singleVariant struct {
 		isVideoPassthrough bool
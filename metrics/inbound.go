@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TekkadanPlays/oni/core/rtmp"
+	"github.com/TekkadanPlays/oni/models"
+)
+
+func handleInboundStreamPolling() {
+	metrics.m.Lock()
+	defer metrics.m.Unlock()
+
+	collectInboundStreamHealth(rtmp.InboundHealth(), time.Now())
+}
+
+// collectInboundStreamHealth will save what was measured of the inbound
+// stream. The counts are kept as how much they went up since the last
+// collection, so the time series show when the problems happened.
+func collectInboundStreamHealth(health *models.InboundStreamHealth, now time.Time) {
+	previous := metrics.inboundStreamHealth
+	metrics.inboundStreamHealth = health
+
+	if health == nil {
+		setInboundStreamCollectors(models.InboundStreamHealth{}, 0, 0, 0)
+		return
+	}
+
+	timestampJumps := health.TimestampJumps
+	droppedFrames := health.DroppedFrames
+	latePackets := health.LatePackets
+	if previous != nil && previous.StartedAt.Equal(health.StartedAt) {
+		timestampJumps -= previous.TimestampJumps
+		droppedFrames -= previous.DroppedFrames
+		latePackets -= previous.LatePackets
+	}
+
+	metrics.inboundBitrate = appendInboundValue(metrics.inboundBitrate, now, float64(health.BitrateKbps))
+	metrics.keyframeInterval = appendInboundValue(metrics.keyframeInterval, now, health.KeyframeIntervalSeconds)
+	metrics.avDrift = appendInboundValue(metrics.avDrift, now, float64(health.AVDriftMilliseconds))
+	metrics.timestampJumps = appendInboundValue(metrics.timestampJumps, now, float64(timestampJumps))
+	metrics.droppedFrames = appendInboundValue(metrics.droppedFrames, now, float64(droppedFrames))
+	metrics.latePackets = appendInboundValue(metrics.latePackets, now, float64(latePackets))
+
+	setInboundStreamCollectors(*health, timestampJumps, droppedFrames, latePackets)
+}
+
+func appendInboundValue(values []TimestampedValue, now time.Time, value float64) []TimestampedValue {
+	values = append(values, TimestampedValue{Time: now, Value: value})
+	if len(values) > maxCollectionValues {
+		values = values[1:]
+	}
+
+	return values
+}
+
+// Save to the Prometheus collectors. The counts are added to counters that
+// only go up, so they can be used with rate() and increase().
+func setInboundStreamCollectors(health models.InboundStreamHealth, timestampJumps, droppedFrames, latePackets int) {
+	inboundBitrate.Set(float64(health.BitrateKbps))
+	inboundKeyframeInterval.Set(health.KeyframeIntervalSeconds)
+	inboundAVDrift.Set(float64(health.AVDriftMilliseconds))
+	addInboundCount(inboundTimestampJumps, timestampJumps)
+	addInboundCount(inboundDroppedFrames, droppedFrames)
+	addInboundCount(inboundLatePackets, latePackets)
+}
+
+func addInboundCount(counter prometheus.Counter, count int) {
+	if count > 0 {
+		counter.Add(float64(count))
+	}
+}
+
+// recentInboundCount returns how much a count went up within the window.
+func recentInboundCount(values []TimestampedValue, since time.Time) int {
+	count := 0.0
+	for _, value := range values {
+		if !value.Time.Before(since) {
+			count += value.Value
+		}
+	}
+
+	return int(count)
+}
+
+// GetInboundStreamHealth will return the latest health of the inbound
+// stream, or nil if there is none.
+func GetInboundStreamHealth() *models.InboundStreamHealth {
+	return metrics.inboundStreamHealth
+}
+
+// GetInboundBitrateOverTime will return the inbound bitrate in kbps over time.
+func GetInboundBitrateOverTime() []TimestampedValue {
+	return metrics.inboundBitrate
+}
+
+// GetKeyframeIntervalOverTime will return the inbound keyframe interval in
+// seconds over time.
+func GetKeyframeIntervalOverTime() []TimestampedValue {
+	return metrics.keyframeInterval
+}
+
+// GetAVDriftOverTime will return how far the inbound video was ahead of the
+// audio in milliseconds over time.
+func GetAVDriftOverTime() []TimestampedValue {
+	return metrics.avDrift
+}
+
+// GetTimestampJumpsOverTime will return the inbound timestamp jumps over time.
+func GetTimestampJumpsOverTime() []TimestampedValue {
+	return metrics.timestampJumps
+}
+
+// GetDroppedFramesOverTime will return the frames the encoder dropped over time.
+func GetDroppedFramesOverTime() []TimestampedValue {
+	return metrics.droppedFrames
+}
+
+// GetLatePacketsOverTime will return the inbound packets that arrived late
+// over time.
+func GetLatePacketsOverTime() []TimestampedValue {
+	return metrics.latePackets
+}
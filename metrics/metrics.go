@@ -13,6 +13,7 @@ import (
 const (
 	hardwareMetricsPollingInterval = 2 * time.Minute
 	playbackMetricsPollingInterval = 2 * time.Minute
+	inboundMetricsPollingInterval  = 10 * time.Second
 )
 
 const (
@@ -46,6 +47,14 @@ type CollectedMetrics struct {
 
 	qualityVariantChanges []TimestampedValue `json:"-"`
 
+	inboundStreamHealth *models.InboundStreamHealth
+	inboundBitrate      []TimestampedValue `json:"-"`
+	keyframeInterval    []TimestampedValue `json:"-"`
+	avDrift             []TimestampedValue `json:"-"`
+	timestampJumps      []TimestampedValue `json:"-"`
+	droppedFrames       []TimestampedValue `json:"-"`
+	latePackets         []TimestampedValue `json:"-"`
+
 	m sync.Mutex `json:"-"`
 }
 
@@ -83,6 +92,12 @@ func Start(getStatus func() models.Status) {
 			handlePlaybackPolling()
 		}
 	}()
+
+	go func() {
+		for range time.Tick(inboundMetricsPollingInterval) {
+			handleInboundStreamPolling()
+		}
+	}()
 }
 
 func handlePolling() {
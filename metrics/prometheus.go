@@ -13,6 +13,12 @@ var (
 	chatUserCount           prometheus.Gauge
 	currentChatMessageCount prometheus.Gauge
 	playbackErrorCount      prometheus.Gauge
+	inboundBitrate          prometheus.Gauge
+	inboundKeyframeInterval prometheus.Gauge
+	inboundAVDrift          prometheus.Gauge
+	inboundTimestampJumps   prometheus.Counter
+	inboundDroppedFrames    prometheus.Counter
+	inboundLatePackets      prometheus.Counter
 )
 
 func setupPrometheusCollectors() {
@@ -52,4 +58,40 @@ func setupPrometheusCollectors() {
 		Help:        "CPU usage as seen internally to Owncast.",
 		ConstLabels: labels,
 	})
+
+	inboundBitrate = promauto.NewGauge(prometheus.GaugeOpts{
+		Name:        "owncast_instance_inbound_bitrate_kbps",
+		Help:        "The bitrate the broadcaster is sending at, as received.",
+		ConstLabels: labels,
+	})
+
+	inboundKeyframeInterval = promauto.NewGauge(prometheus.GaugeOpts{
+		Name:        "owncast_instance_inbound_keyframe_interval_seconds",
+		Help:        "The time between the last two keyframes the broadcaster sent.",
+		ConstLabels: labels,
+	})
+
+	inboundAVDrift = promauto.NewGauge(prometheus.GaugeOpts{
+		Name:        "owncast_instance_inbound_av_drift_milliseconds",
+		Help:        "How far the inbound video is ahead of the audio.",
+		ConstLabels: labels,
+	})
+
+	inboundTimestampJumps = promauto.NewCounter(prometheus.CounterOpts{
+		Name:        "owncast_instance_inbound_timestamp_jumps_total",
+		Help:        "Timestamp jumps in the inbound stream",
+		ConstLabels: labels,
+	})
+
+	inboundDroppedFrames = promauto.NewCounter(prometheus.CounterOpts{
+		Name:        "owncast_instance_inbound_dropped_frames_total",
+		Help:        "Frames the broadcaster's encoder dropped",
+		ConstLabels: labels,
+	})
+
+	inboundLatePackets = promauto.NewCounter(prometheus.CounterOpts{
+		Name:        "owncast_instance_inbound_late_packets_total",
+		Help:        "Inbound packets that arrived late",
+		ConstLabels: labels,
+	})
 }
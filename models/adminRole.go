@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// AdminRole is the access a Nostr pubkey has to the admin API.
+type AdminRole string
+
+// Admin roles, each allowed everything the roles below it are.
+const (
+	AdminRoleOwner       AdminRole = "owner"
+	AdminRoleAdmin       AdminRole = "admin"
+	AdminRoleModerator   AdminRole = "moderator"
+	AdminRoleIntegration AdminRole = "integration"
+)
+
+var adminRoleLevels = map[AdminRole]int{
+	AdminRoleIntegration: 1,
+	AdminRoleModerator:   2,
+	AdminRoleAdmin:       3,
+	AdminRoleOwner:       4,
+}
+
+// IsValid will return if this is a known role.
+func (r AdminRole) IsValid() bool {
+	_, ok := adminRoleLevels[r]
+	return ok
+}
+
+// Allows will return if the role has at least the access of the required role.
+func (r AdminRole) Allows(required AdminRole) bool {
+	return r.IsValid() && required.IsValid() && adminRoleLevels[r] >= adminRoleLevels[required]
+}
+
+// AdminPubkey is a Nostr pubkey allowed to use the admin API with NIP-98
+// HTTP auth.
+type AdminPubkey struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Pubkey    string    `json:"pubkey"`
+	Role      AdminRole `json:"role"`
+	Label     string    `json:"label,omitempty"`
+	AddedBy   string    `json:"addedBy,omitempty"`
+	// Primary is set for the pubkey configured as the server's admin, which
+	// is always an owner and can only be changed on its own.
+	Primary bool `json:"primary,omitempty"`
+}
+
+// AdminAuditEntry records a change made through the admin API.
+type AdminAuditEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Pubkey is empty for changes made with the admin password.
+	Pubkey string    `json:"pubkey,omitempty"`
+	Role   AdminRole `json:"role"`
+	Method string    `json:"method"`
+	Path   string    `json:"path"`
+	ID     int64     `json:"id"`
+	Status int       `json:"status"`
+}
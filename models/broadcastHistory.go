@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// BroadcastHistoryEntry records which stream key started a broadcast.
+type BroadcastHistoryEntry struct {
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	ID        string     `json:"id"`
+	// StreamKeyLabel is the label of the key when the broadcast started,
+	// and StreamKeyHint its last characters so keys can be told apart
+	// without storing them.
+	StreamKeyLabel string `json:"streamKeyLabel"`
+	StreamKeyHint  string `json:"streamKeyHint,omitempty"`
+	Protocol       string `json:"protocol"`
+	RemoteAddr     string `json:"remoteAddr"`
+}
@@ -5,10 +5,23 @@ import "time"
 // Broadcaster represents the details around the inbound broadcasting connection.
 type Broadcaster struct {
 	Time          time.Time            `json:"time"`
+	Link          *InboundLinkStats    `json:"link,omitempty"`
 	RemoteAddr    string               `json:"remoteAddr"`
+	Protocol      string               `json:"protocol"`
 	StreamDetails InboundStreamDetails `json:"streamDetails"`
 }
 
+// InboundLinkStats are the network conditions of an inbound connection,
+// for protocols that report them.
+type InboundLinkStats struct {
+	RTT                  float64 `json:"rtt"`
+	PacketLoss           float64 `json:"packetLoss"`
+	PacketsLost          uint64  `json:"packetsLost"`
+	PacketsRetransmitted uint64  `json:"packetsRetransmitted"`
+	PacketsDropped       uint64  `json:"packetsDropped"`
+	ReceiveBitrate       int     `json:"receiveBitrate"`
+}
+
 // InboundStreamDetails represents an inbound broadcast stream.
 type InboundStreamDetails struct {
 	VideoCodec     string  `json:"videoCodec"`
@@ -28,4 +28,10 @@ const (
 	SystemMessageSent EventType = "SYSTEM"
 	// ChatActionSent is a generic chat action that can be used for anything that doesn't need specific handling or formatting.
 	ChatActionSent EventType = "CHAT_ACTION"
+	// ZapReceived is the event sent when someone zaps the stream on Nostr.
+	ZapReceived EventType = "ZAP"
+	// RestreamConnected is the event sent when the stream starts being forwarded to a restream destination.
+	RestreamConnected EventType = "RESTREAM_CONNECTED"
+	// RestreamDisconnected is the event sent when forwarding the stream to a restream destination stops or fails.
+	RestreamDisconnected EventType = "RESTREAM_DISCONNECTED"
 )
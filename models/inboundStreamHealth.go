@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// InboundStreamHealth describes the stream the broadcaster is sending, as
+// measured from the packets that arrive rather than what the encoder
+// reports.
+type InboundStreamHealth struct {
+	StartedAt time.Time `json:"startedAt"`
+	// Measured over the last few seconds.
+	BitrateKbps int `json:"bitrateKbps"`
+	// The time between the last two keyframes. Zero until two arrived.
+	KeyframeIntervalSeconds float64 `json:"keyframeIntervalSeconds"`
+	// How far the video is ahead of the audio, negative when behind.
+	AVDriftMilliseconds int `json:"avDriftMilliseconds"`
+	// Counted since the stream started.
+	TimestampJumps int `json:"timestampJumps"`
+	DroppedFrames  int `json:"droppedFrames"`
+	LatePackets    int `json:"latePackets"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Kinds of entries on a Nostr mute list that can disable a chat user.
+const (
+	NostrMutePubkey = "pubkey"
+	NostrMuteWord   = "word"
+)
+
+// NostrMuteList is the public part of the admin's NIP-51 kind 10000 mute
+// list as it was last applied to chat.
+type NostrMuteList struct {
+	Author    string   `json:"author"`
+	Pubkeys   []string `json:"pubkeys"`
+	Words     []string `json:"words"`
+	CreatedAt int64    `json:"createdAt"`
+}
+
+// NostrMute records that a chat user was disabled because of an entry on
+// the admin's Nostr mute list, as opposed to by a moderator.
+type NostrMute struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	UserID      string    `json:"-"`
+	Type        string    `json:"type"`
+	Value       string    `json:"value"`
+	IPAddresses []string  `json:"-"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// NostrProfile is the kind 0 profile metadata synced for a chat user with
+// a linked Nostr pubkey.
+type NostrProfile struct {
+	FetchedAt     time.Time `json:"fetchedAt"`
+	UserID        string    `json:"-"`
+	Pubkey        string    `json:"pubkey"`
+	Name          string    `json:"name,omitempty"`
+	Picture       string    `json:"picture,omitempty"`
+	NIP05         string    `json:"nip05,omitempty"`
+	CreatedAt     int64     `json:"createdAt"`
+	NIP05Verified bool      `json:"nip05Verified"`
+}
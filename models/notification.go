@@ -8,6 +8,13 @@ type DiscordConfiguration struct {
 	Enabled       bool   `json:"enabled"`
 }
 
+// NostrNotificationConfiguration represents the configuration for go-live
+// direct messages sent to Nostr subscribers.
+type NostrNotificationConfiguration struct {
+	GoLiveMessage string `json:"goLiveMessage,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
 // BrowserNotificationConfiguration represents the configuration for
 // browser notifications.
 type BrowserNotificationConfiguration struct {
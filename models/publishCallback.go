@@ -0,0 +1,14 @@
+package models
+
+// PublishCallbackConfiguration is an optional HTTP endpoint that decides if
+// a broadcaster may stream, like nginx-rtmp's on_publish. When it is
+// enabled it is asked instead of checking the configured stream keys.
+type PublishCallbackConfiguration struct {
+	URL string `json:"url"`
+	// How long a decision is reused for the same stream key and address.
+	CacheSeconds int  `json:"cacheSeconds"`
+	Enabled      bool `json:"enabled"`
+	// Whether broadcasters with a configured stream key may stream when the
+	// callback can not be reached.
+	FailOpen bool `json:"failOpen"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Recording is a past broadcast and the video it can be replayed from,
+// announced on Nostr as a NIP-71 video event once the video is available.
+type Recording struct {
+	StartedAt   time.Time  `json:"startedAt"`
+	EndedAt     time.Time  `json:"endedAt"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	// ID is the identifier of the broadcast's NIP-53 live activity.
+	ID string `json:"id"`
+	// LiveActivity is the address of the broadcast's NIP-53 live activity.
+	LiveActivity string `json:"liveActivity,omitempty"`
+	Title        string `json:"title"`
+	Summary      string `json:"summary,omitempty"`
+	PlaylistURL  string `json:"playlistUrl,omitempty"`
+	VideoURL     string `json:"videoUrl,omitempty"`
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	// EventID is the NIP-71 video event announcing the recording, and
+	// LegacyEventID the kind 34235 event for older clients.
+	EventID       string `json:"eventId,omitempty"`
+	LegacyEventID string `json:"legacyEventId,omitempty"`
+	EventKind     int    `json:"eventKind,omitempty"`
+	Width         int    `json:"width,omitempty"`
+	Height        int    `json:"height,omitempty"`
+}
+
+// HasVideo will return if the recording has a video to play.
+func (r *Recording) HasVideo() bool {
+	return r.PlaylistURL != "" || r.VideoURL != ""
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// RestreamDestination is an external RTMP or RTMPS service the inbound
+// stream is forwarded to, such as another streaming platform.
+type RestreamDestination struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey"`
+	Enabled   bool   `json:"enabled"`
+}
+
+// RestreamStatus is the state of forwarding the stream to a destination.
+type RestreamStatus struct {
+	ConnectedAt *time.Time `json:"connectedAt,omitempty"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	URL         string     `json:"url"`
+	State       string     `json:"state"`
+	LastError   string     `json:"lastError,omitempty"`
+	// Bitrate is the rate the stream is forwarded at, in kbps.
+	Bitrate        int `json:"bitrate"`
+	DroppedPackets int `json:"droppedPackets"`
+	Reconnects     int `json:"reconnects"`
+}
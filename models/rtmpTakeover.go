@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// RTMPTakeoverPolicy decides if a new inbound RTMP connection may replace
+// the one currently streaming.
+type RTMPTakeoverPolicy string
+
+// RTMP takeover policies.
+const (
+	// RTMPTakeoverReject keeps the current publisher and rejects new ones.
+	RTMPTakeoverReject RTMPTakeoverPolicy = "reject"
+	// RTMPTakeoverSameKey replaces the current publisher with a new one
+	// using the same stream key.
+	RTMPTakeoverSameKey RTMPTakeoverPolicy = "samekey"
+	// RTMPTakeoverIdle replaces the current publisher once it has not sent
+	// any data for IdleSeconds.
+	RTMPTakeoverIdle RTMPTakeoverPolicy = "idle"
+)
+
+// DefaultRTMPTakeoverIdleSeconds is how long the current publisher has to
+// be silent before the idle policy lets another one take over.
+const DefaultRTMPTakeoverIdleSeconds = 5
+
+// RTMPTakeoverConfiguration is how a second inbound RTMP connection is
+// handled while a stream is running.
+type RTMPTakeoverConfiguration struct {
+	Policy      RTMPTakeoverPolicy `json:"policy"`
+	IdleSeconds int                `json:"idleSeconds"`
+}
+
+// IsValid will return if the policy is known and the idle time usable.
+func (c RTMPTakeoverConfiguration) IsValid() bool {
+	switch c.Policy {
+	case RTMPTakeoverReject, RTMPTakeoverSameKey:
+		return true
+	case RTMPTakeoverIdle:
+		return c.IdleSeconds > 0
+	default:
+		return false
+	}
+}
+
+// IdleTimeout returns how long the current publisher has to be silent
+// before the idle policy lets another one take over.
+func (c RTMPTakeoverConfiguration) IdleTimeout() time.Duration {
+	if c.IdleSeconds <= 0 {
+		return DefaultRTMPTakeoverIdleSeconds * time.Second
+	}
+	return time.Duration(c.IdleSeconds) * time.Second
+}
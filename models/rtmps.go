@@ -0,0 +1,10 @@
+package models
+
+// RTMPSConfiguration is the optional TLS listener broadcasters can stream
+// to, so their stream key is not sent in plain text.
+type RTMPSConfiguration struct {
+	CertificatePath string `json:"certificatePath"`
+	KeyPath         string `json:"keyPath"`
+	Port            int    `json:"port"`
+	Enabled         bool   `json:"enabled"`
+}
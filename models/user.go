@@ -13,15 +13,19 @@ const (
 type User struct {
 	CreatedAt       time.Time  `json:"createdAt"`
 	DisabledAt      *time.Time `json:"disabledAt,omitempty"`
+	NostrMute       *NostrMute `json:"nostrMute,omitempty"`
 	NameChangedAt   *time.Time `json:"nameChangedAt,omitempty"`
 	AuthenticatedAt *time.Time `json:"-"`
 	ID              string     `json:"id"`
 	DisplayName     string     `json:"displayName"`
+	Avatar          string     `json:"avatar,omitempty"`
+	NIP05           string     `json:"nip05,omitempty"`
 	PreviousNames   []string   `json:"previousNames"`
 	Scopes          []string   `json:"scopes,omitempty"`
 	DisplayColor    int        `json:"displayColor"`
 	IsBot           bool       `json:"isBot"`
 	Authenticated   bool       `json:"authenticated"`
+	NIP05Verified   bool       `json:"nip05Verified,omitempty"`
 }
 
 // IsEnabled will return if this single user is enabled.
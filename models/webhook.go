@@ -25,6 +25,9 @@ var validEvents = []EventType{
 	StreamStarted,
 	StreamStopped,
 	StreamTitleUpdated,
+	ZapReceived,
+	RestreamConnected,
+	RestreamDisconnected,
 }
 
 // HasValidEvents will verify that all the events provided are valid.
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Zap is a NIP-57 Lightning zap sent to a stream.
+type Zap struct {
+	Timestamp  time.Time `json:"timestamp"`
+	ID         string    `json:"id"`
+	StreamID   string    `json:"streamId"`
+	Sender     string    `json:"sender"`
+	Recipient  string    `json:"recipient"`
+	Comment    string    `json:"comment"`
+	AmountMsat int64     `json:"amountMsat"`
+}
+
+// ZapTotal is the sum of the zaps sent to a single stream.
+type ZapTotal struct {
+	FirstZapAt time.Time `json:"firstZapAt"`
+	LastZapAt  time.Time `json:"lastZapAt"`
+	StreamID   string    `json:"streamId"`
+	Count      int       `json:"count"`
+	AmountMsat int64     `json:"amountMsat"`
+}
@@ -0,0 +1,206 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// BOLT-11 tagged field types the zap validation cares about.
+const (
+	invoiceFieldPaymentHash     = 1
+	invoiceFieldDescription     = 13
+	invoiceFieldDescriptionHash = 23
+	invoiceFieldExpiry          = 6
+)
+
+// The number of 5 bit groups holding the invoice timestamp and signature.
+const (
+	invoiceTimestampLength = 7
+	invoiceSignatureLength = 104
+)
+
+// Invoice is the part of a BOLT-11 Lightning payment request needed to
+// validate zaps. The invoice signature is not checked.
+type Invoice struct {
+	CreatedAt       time.Time
+	Network         string
+	PaymentHash     string
+	Description     string
+	DescriptionHash string
+	Expiry          time.Duration
+	AmountMsat      int64
+}
+
+// DecodeInvoice parses a BOLT-11 payment request.
+func DecodeInvoice(bolt11 string) (*Invoice, error) {
+	bolt11 = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(bolt11)), "lightning:")
+
+	hrp, data, err := bech32Decode(bolt11)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid invoice")
+	}
+	if !strings.HasPrefix(hrp, "ln") {
+		return nil, errors.New("invoice does not start with ln")
+	}
+	if len(data) < invoiceTimestampLength+invoiceSignatureLength {
+		return nil, errors.New("invoice is too short")
+	}
+
+	invoice := &Invoice{}
+
+	// The network is the letters following "ln", the rest is the amount.
+	rest := hrp[2:]
+	split := strings.IndexAny(rest, "0123456789")
+	if split < 0 {
+		invoice.Network = rest
+	} else {
+		invoice.Network = rest[:split]
+		if invoice.AmountMsat, err = parseInvoiceAmount(rest[split:]); err != nil {
+			return nil, err
+		}
+	}
+
+	invoice.CreatedAt = time.Unix(int64(readInvoiceInt(data[:invoiceTimestampLength])), 0)
+
+	fields := data[invoiceTimestampLength : len(data)-invoiceSignatureLength]
+	for len(fields) >= 3 {
+		fieldType := fields[0]
+		length := int(readInvoiceInt(fields[1:3]))
+		fields = fields[3:]
+		if length > len(fields) {
+			return nil, errors.New("invoice field is truncated")
+		}
+		value := fields[:length]
+		fields = fields[length:]
+
+		switch fieldType {
+		case invoiceFieldPaymentHash:
+			if b, err := convertBits(value, 5, 8, false); err == nil && len(b) == 32 {
+				invoice.PaymentHash = hex.EncodeToString(b)
+			}
+		case invoiceFieldDescriptionHash:
+			if b, err := convertBits(value, 5, 8, false); err == nil && len(b) == 32 {
+				invoice.DescriptionHash = hex.EncodeToString(b)
+			}
+		case invoiceFieldDescription:
+			if b, err := convertBits(value, 5, 8, false); err == nil {
+				invoice.Description = string(b)
+			}
+		case invoiceFieldExpiry:
+			invoice.Expiry = time.Duration(readInvoiceInt(value)) * time.Second
+		}
+	}
+
+	if invoice.Expiry == 0 {
+		invoice.Expiry = time.Hour
+	}
+
+	return invoice, nil
+}
+
+// Encode returns the invoice as a BOLT-11 payment request with an empty
+// signature. Wallets will refuse to pay it, so it is only useful for tests
+// and fake wallets.
+func (i Invoice) Encode() string {
+	network := i.Network
+	if network == "" {
+		network = "bc"
+	}
+
+	hrp := "ln" + network
+	if i.AmountMsat > 0 {
+		// Nano bitcoin are 100 msat, pico bitcoin a tenth of a msat.
+		if i.AmountMsat%100 == 0 {
+			hrp += strconv.FormatInt(i.AmountMsat/100, 10) + "n"
+		} else {
+			hrp += strconv.FormatInt(i.AmountMsat*10, 10) + "p"
+		}
+	}
+
+	data := writeInvoiceInt(uint64(i.CreatedAt.Unix()), invoiceTimestampLength)
+	writeField := func(fieldType byte, value []byte) {
+		data = append(data, fieldType)
+		data = append(data, writeInvoiceInt(uint64(len(value)), 2)...)
+		data = append(data, value...)
+	}
+	writeBytes := func(fieldType byte, b []byte) {
+		value, _ := convertBits(b, 8, 5, true)
+		writeField(fieldType, value)
+	}
+
+	if b, err := hex.DecodeString(i.PaymentHash); err == nil && len(b) == 32 {
+		writeBytes(invoiceFieldPaymentHash, b)
+	}
+	if i.Description != "" {
+		writeBytes(invoiceFieldDescription, []byte(i.Description))
+	}
+	if b, err := hex.DecodeString(i.DescriptionHash); err == nil && len(b) == 32 {
+		writeBytes(invoiceFieldDescriptionHash, b)
+	}
+	if i.Expiry > 0 {
+		seconds := uint64(i.Expiry / time.Second)
+		length := 1
+		for seconds>>(5*length) > 0 {
+			length++
+		}
+		writeField(invoiceFieldExpiry, writeInvoiceInt(seconds, length))
+	}
+
+	data = append(data, make([]byte, invoiceSignatureLength)...)
+
+	return bech32Encode(hrp, data)
+}
+
+// parseInvoiceAmount converts the amount in the invoice prefix to msat.
+func parseInvoiceAmount(amount string) (int64, error) {
+	multiplier := byte(0)
+	if last := amount[len(amount)-1]; last < '0' || last > '9' {
+		multiplier = last
+		amount = amount[:len(amount)-1]
+	}
+
+	value, err := strconv.ParseInt(amount, 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid invoice amount")
+	}
+
+	// One bitcoin is 10^11 msat.
+	switch multiplier {
+	case 0:
+		return value * 100_000_000_000, nil
+	case 'm':
+		return value * 100_000_000, nil
+	case 'u':
+		return value * 100_000, nil
+	case 'n':
+		return value * 100, nil
+	case 'p':
+		if value%10 != 0 {
+			return 0, errors.New("invoice amount is not a whole msat")
+		}
+		return value / 10, nil
+	}
+
+	return 0, errors.Errorf("invalid invoice amount multiplier %q", multiplier)
+}
+
+func readInvoiceInt(groups []byte) uint64 {
+	value := uint64(0)
+	for _, group := range groups {
+		value = value<<5 | uint64(group)
+	}
+	return value
+}
+
+func writeInvoiceInt(value uint64, length int) []byte {
+	groups := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		groups[i] = byte(value & 31)
+		value >>= 5
+	}
+	return groups
+}
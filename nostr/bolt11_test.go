@@ -0,0 +1,78 @@
+package nostr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecodeInvoice(t *testing.T) {
+	// From the BOLT-11 specification examples.
+	invoice, err := DecodeInvoice("lnbc2500u1pvjluezsp5zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3zygspp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdq5xysxxatsyp3k7enxv4jsxqzpu9qrsgquk0rl77nj30yxdy8j9vdx85fkpmdla2087ne0xh8nhedh8w27kyke0lp53ut353s06fv3qfegext0eh0ymjpf39tuven09sam30g4vgpfna3rh")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if invoice.AmountMsat != 250_000_000 {
+		t.Errorf("amount = %d msat, want 250000000", invoice.AmountMsat)
+	}
+	if invoice.Network != "bc" {
+		t.Errorf("network = %q, want bc", invoice.Network)
+	}
+	if invoice.PaymentHash != "0001020304050607080900010203040506070809000102030405060708090102" {
+		t.Errorf("payment hash = %s", invoice.PaymentHash)
+	}
+	if invoice.Description != "1 cup coffee" {
+		t.Errorf("description = %q", invoice.Description)
+	}
+	if invoice.Expiry != time.Minute {
+		t.Errorf("expiry = %s, want 1m", invoice.Expiry)
+	}
+	if invoice.CreatedAt.Unix() != 1496314658 {
+		t.Errorf("created at = %d", invoice.CreatedAt.Unix())
+	}
+}
+
+func TestInvoiceRoundTrip(t *testing.T) {
+	for _, amount := range []int64{1, 1000, 21_000, 123_456_789} {
+		invoice := Invoice{
+			CreatedAt:       time.Unix(1700000000, 0),
+			Network:         "bc",
+			PaymentHash:     "0001020304050607080900010203040506070809000102030405060708090102",
+			DescriptionHash: "3925b6f67e2c340036ed12093dd44e0368df1b6ea26c53dbe4811f58fd5db8c1",
+			Expiry:          10 * time.Minute,
+			AmountMsat:      amount,
+		}
+
+		decoded, err := DecodeInvoice(invoice.Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *decoded != invoice {
+			t.Errorf("decoded %+v, want %+v", *decoded, invoice)
+		}
+	}
+}
+
+func TestParseInvoiceAmount(t *testing.T) {
+	tests := []struct {
+		amount string
+		msat   int64
+		valid  bool
+	}{
+		{"1", 100_000_000_000, true},
+		{"2m", 200_000_000, true},
+		{"2500u", 250_000_000, true},
+		{"10n", 1000, true},
+		{"10p", 1, true},
+		{"15p", 0, false},
+		{"10x", 0, false},
+		{"u", 0, false},
+	}
+
+	for _, test := range tests {
+		msat, err := parseInvoiceAmount(test.amount)
+		if (err == nil) != test.valid || msat != test.msat {
+			t.Errorf("parseInvoiceAmount(%q) = %d, %v", test.amount, msat, err)
+		}
+	}
+}
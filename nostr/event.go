@@ -0,0 +1,215 @@
+package nostr
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/pkg/errors"
+)
+
+// Event is a single NIP-01 Nostr event.
+type Event struct {
+	ID        string `json:"id"`
+	PubKey    string `json:"pubkey"`
+	CreatedAt int64  `json:"created_at"`
+	Kind      int    `json:"kind"`
+	Tags      Tags   `json:"tags"`
+	Content   string `json:"content"`
+	Sig       string `json:"sig"`
+}
+
+// Tag is a single event tag, for example ["p", "<pubkey>"].
+type Tag []string
+
+// Tags is the list of tags attached to an event.
+type Tags []Tag
+
+// Key returns the tag name.
+func (t Tag) Key() string {
+	if len(t) == 0 {
+		return ""
+	}
+	return t[0]
+}
+
+// Value returns the first value of the tag.
+func (t Tag) Value() string {
+	if len(t) < 2 {
+		return ""
+	}
+	return t[1]
+}
+
+// GetFirst returns the first tag with the given name.
+func (t Tags) GetFirst(name string) Tag {
+	for _, tag := range t {
+		if tag.Key() == name {
+			return tag
+		}
+	}
+	return nil
+}
+
+// GetAll returns every tag with the given name.
+func (t Tags) GetAll(name string) Tags {
+	result := Tags{}
+	for _, tag := range t {
+		if tag.Key() == name {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// Value returns the value of the first tag with the given name.
+func (t Tags) Value(name string) string {
+	return t.GetFirst(name).Value()
+}
+
+// CreatedAtTime returns the event creation time.
+func (e *Event) CreatedAtTime() time.Time {
+	return time.Unix(e.CreatedAt, 0)
+}
+
+// Serialize returns the canonical NIP-01 serialization used to compute the event ID.
+func (e *Event) Serialize() []byte {
+	var b strings.Builder
+	b.WriteString(`[0,"`)
+	b.WriteString(e.PubKey)
+	b.WriteString(`",`)
+	b.WriteString(strconv.FormatInt(e.CreatedAt, 10))
+	b.WriteString(",")
+	b.WriteString(strconv.Itoa(e.Kind))
+	b.WriteString(",[")
+	for i, tag := range e.Tags {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("[")
+		for j, value := range tag {
+			if j > 0 {
+				b.WriteString(",")
+			}
+			writeEscapedString(&b, value)
+		}
+		b.WriteString("]")
+	}
+	b.WriteString("],")
+	writeEscapedString(&b, e.Content)
+	b.WriteString("]")
+
+	return []byte(b.String())
+}
+
+// GetID computes the event ID from its serialized contents.
+func (e *Event) GetID() string {
+	hash := sha256.Sum256(e.Serialize())
+	return hex.EncodeToString(hash[:])
+}
+
+// CheckID will return if the event ID matches its contents.
+func (e *Event) CheckID() bool {
+	return e.ID == e.GetID()
+}
+
+// CheckSignature verifies the Schnorr signature of the event against its pubkey.
+func (e *Event) CheckSignature() (bool, error) {
+	pubkeyBytes, err := hex.DecodeString(e.PubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid event pubkey")
+	}
+	pubkey, err := schnorr.ParsePubKey(pubkeyBytes)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid event pubkey")
+	}
+
+	sigBytes, err := hex.DecodeString(e.Sig)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid event signature")
+	}
+	sig, err := schnorr.ParseSignature(sigBytes)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid event signature")
+	}
+
+	hash := sha256.Sum256(e.Serialize())
+	return sig.Verify(hash[:], pubkey), nil
+}
+
+// Verify will check both the event ID and its signature.
+func (e *Event) Verify() error {
+	if !e.CheckID() {
+		return errors.New("event id does not match its contents")
+	}
+
+	valid, err := e.CheckSignature()
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("event signature is invalid")
+	}
+
+	return nil
+}
+
+// Sign sets the pubkey, ID and signature of the event using a hex private key.
+func (e *Event) Sign(privateKey string) error {
+	keyBytes, err := hex.DecodeString(privateKey)
+	if err != nil || len(keyBytes) != 32 {
+		return errors.New("invalid private key")
+	}
+
+	sk, pk := btcec.PrivKeyFromBytes(keyBytes)
+	e.PubKey = hex.EncodeToString(schnorr.SerializePubKey(pk))
+	if e.Tags == nil {
+		e.Tags = Tags{}
+	}
+
+	hash := sha256.Sum256(e.Serialize())
+	sig, err := schnorr.Sign(sk, hash[:])
+	if err != nil {
+		return errors.Wrap(err, "unable to sign event")
+	}
+
+	e.ID = hex.EncodeToString(hash[:])
+	e.Sig = hex.EncodeToString(sig.Serialize())
+
+	return nil
+}
+
+// writeEscapedString writes a JSON string escaped exactly as NIP-01 requires.
+func writeEscapedString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+}
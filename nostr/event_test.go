@@ -0,0 +1,76 @@
+package nostr
+
+import (
+	"testing"
+)
+
+func TestSerializeEscaping(t *testing.T) {
+	event := Event{
+		PubKey:    "abc",
+		CreatedAt: 1700000000,
+		Kind:      1,
+		Tags:      Tags{{"t", "<tag>"}},
+		Content:   "line\n\"quoted\" & \\  ",
+	}
+
+	want := "[0,\"abc\",1700000000,1,[[\"t\",\"<tag>\"]],\"line\\n\\\"quoted\\\" & \\\\  \"]"
+	if got := string(event.Serialize()); got != want {
+		t.Errorf("Serialize() = %s, want %s", got, want)
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	privateKey, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkey, err := GetPublicKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !IsValidPublicKey(pubkey) {
+		t.Errorf("%s should be a valid public key", pubkey)
+	}
+
+	event := Event{CreatedAt: 1700000000, Kind: 1, Content: "hello"}
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+
+	if event.PubKey != pubkey {
+		t.Errorf("event pubkey = %s, want %s", event.PubKey, pubkey)
+	}
+
+	if err := event.Verify(); err != nil {
+		t.Error(err)
+	}
+
+	event.Content = "tampered"
+	if err := event.Verify(); err == nil {
+		t.Error("tampered event should not verify")
+	}
+}
+
+func TestIsValidPublicKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		pubkey string
+		want   bool
+	}{
+		{"empty", "", false},
+		{"too short", "abcd", false},
+		{"not hex", "zz4e1b8c1c4e2f1e8d5f0d4b5c8b1e2a1f3b6c7d8e9f0a1b2c3d4e5f6a7b8c9d", false},
+		{"uppercase", "3BF0C63FCB93463407AF97A5E5EE64FA883D107EF9E558472C4EB9AAAEFA459D", false},
+		{"valid", "3bf0c63fcb93463407af97a5e5ee64fa883d107ef9e558472c4eb9aaaefa459d", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidPublicKey(tt.pubkey); got != tt.want {
+				t.Errorf("IsValidPublicKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
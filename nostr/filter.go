@@ -0,0 +1,117 @@
+package nostr
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// Filter selects events in a REQ subscription as described by NIP-01.
+type Filter struct {
+	Tags    map[string][]string `json:"-"`
+	IDs     []string            `json:"ids,omitempty"`
+	Authors []string            `json:"authors,omitempty"`
+	Kinds   []int               `json:"kinds,omitempty"`
+	Since   int64               `json:"since,omitempty"`
+	Until   int64               `json:"until,omitempty"`
+	Limit   int                 `json:"limit,omitempty"`
+}
+
+type filterFields Filter
+
+// MarshalJSON encodes the filter including its "#<tag>" conditions.
+func (f Filter) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(filterFields(f))
+	if err != nil || len(f.Tags) == 0 {
+		return b, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for name, values := range f.Tags {
+		encoded, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		fields["#"+name] = encoded
+	}
+
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes the filter including its "#<tag>" conditions.
+func (f *Filter) UnmarshalJSON(b []byte) error {
+	var fields filterFields
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for key, value := range raw {
+		if !strings.HasPrefix(key, "#") || len(key) < 2 {
+			continue
+		}
+		var values []string
+		if err := json.Unmarshal(value, &values); err != nil {
+			return err
+		}
+		if fields.Tags == nil {
+			fields.Tags = map[string][]string{}
+		}
+		fields.Tags[key[1:]] = values
+	}
+
+	*f = Filter(fields)
+	return nil
+}
+
+// Matches returns if the event satisfies every condition of the filter.
+func (f Filter) Matches(e *Event) bool {
+	if e == nil {
+		return false
+	}
+	if len(f.IDs) > 0 && !slices.Contains(f.IDs, e.ID) {
+		return false
+	}
+	if len(f.Authors) > 0 && !slices.Contains(f.Authors, e.PubKey) {
+		return false
+	}
+	if len(f.Kinds) > 0 && !slices.Contains(f.Kinds, e.Kind) {
+		return false
+	}
+	if f.Since != 0 && e.CreatedAt < f.Since {
+		return false
+	}
+	if f.Until != 0 && e.CreatedAt > f.Until {
+		return false
+	}
+	for name, values := range f.Tags {
+		found := false
+		for _, tag := range e.Tags.GetAll(name) {
+			if slices.Contains(values, tag.Value()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchesAny returns if the event satisfies at least one of the filters.
+func MatchesAny(filters []Filter, e *Event) bool {
+	for _, f := range filters {
+		if f.Matches(e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package nostr
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFilterJSON(t *testing.T) {
+	filter := Filter{
+		Kinds: []int{1311},
+		Tags:  map[string][]string{"a": {"30311:abc:oni-1"}},
+		Limit: 10,
+	}
+
+	b, err := json.Marshal(filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"#a":["30311:abc:oni-1"],"kinds":[1311],"limit":10}`
+	if string(b) != want {
+		t.Errorf("Marshal() = %s, want %s", b, want)
+	}
+
+	var decoded Filter
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Tags["a"][0] != "30311:abc:oni-1" || decoded.Kinds[0] != 1311 || decoded.Limit != 10 {
+		t.Errorf("Unmarshal() = %+v", decoded)
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	event := &Event{ID: "1", PubKey: "abc", Kind: 1311, CreatedAt: 100, Tags: Tags{{"a", "30311:abc:oni-1"}}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"kind", Filter{Kinds: []int{1311}}, true},
+		{"wrong kind", Filter{Kinds: []int{1}}, false},
+		{"author", Filter{Authors: []string{"abc"}}, true},
+		{"wrong author", Filter{Authors: []string{"def"}}, false},
+		{"since", Filter{Since: 50}, true},
+		{"too old", Filter{Since: 150}, false},
+		{"until", Filter{Until: 50}, false},
+		{"tag", Filter{Tags: map[string][]string{"a": {"30311:abc:oni-1"}}}, true},
+		{"wrong tag", Filter{Tags: map[string][]string{"a": {"30311:abc:oni-2"}}}, false},
+		{"missing tag", Filter{Tags: map[string][]string{"e": {"1"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(event); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package identity
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Stored keys are "v1:" followed by base64(salt | nonce | ciphertext).
+const encryptedKeyPrefix = "v1:"
+
+const (
+	saltLength   = 16
+	secretLength = 32
+
+	// scrypt cost parameters for deriving the encryption key.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// isEncrypted returns if a stored value was written by encrypt.
+func isEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedKeyPrefix)
+}
+
+// encrypt seals the plaintext with AES-256-GCM using a key derived from the secret.
+func encrypt(plaintext string, secret []byte) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	gcm, err := newCipher(secret, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := append(salt, nonce...)
+	sealed = gcm.Seal(sealed, nonce, []byte(plaintext), nil)
+
+	return encryptedKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt opens a value written by encrypt.
+func decrypt(value string, secret []byte) (string, error) {
+	if !isEncrypted(value) {
+		return "", errors.New("value is not encrypted")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedKeyPrefix))
+	if err != nil {
+		return "", errors.Wrap(err, "encrypted key is not valid base64")
+	}
+	if len(sealed) < saltLength {
+		return "", errors.New("encrypted key is too short")
+	}
+
+	salt := sealed[:saltLength]
+	gcm, err := newCipher(secret, salt)
+	if err != nil {
+		return "", err
+	}
+
+	sealed = sealed[saltLength:]
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("encrypted key is too short")
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("unable to decrypt the key, the passphrase or key file may have changed")
+	}
+
+	return string(plaintext), nil
+}
+
+func newCipher(secret, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(secret, salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// loadSecret returns the secret the stored key is encrypted with. The
+// passphrase takes precedence, otherwise the key file is used and created
+// with a random secret if it does not exist yet.
+func loadSecret() ([]byte, error) {
+	if config.NostrKeyPassphrase != "" {
+		return []byte(config.NostrKeyPassphrase), nil
+	}
+
+	path := config.NostrKeyFile
+	b, err := os.ReadFile(path) // nolint:gosec
+	if err == nil {
+		secret := strings.TrimSpace(string(b))
+		if secret == "" {
+			return nil, errors.Errorf("nostr key file %s is empty", path)
+		}
+		return []byte(secret), nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "unable to read nostr key file")
+	}
+
+	random := make([]byte, secretLength)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+	secret := hex.EncodeToString(random)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, errors.Wrap(err, "unable to create nostr key file directory")
+	}
+	if err := os.WriteFile(path, []byte(secret+"\n"), 0o600); err != nil {
+		return nil, errors.Wrap(err, "unable to write nostr key file")
+	}
+	log.Infoln("Created", path, "to encrypt the server Nostr key. Keep it with your database backups.")
+
+	return []byte(secret), nil
+}
+
+// EncryptSecret seals another secret, such as a wallet connection, the same
+// way the server key is stored.
+func EncryptSecret(plaintext string) (string, error) {
+	secret, err := loadSecret()
+	if err != nil {
+		return "", err
+	}
+
+	return encrypt(plaintext, secret)
+}
+
+// DecryptSecret opens a value written by EncryptSecret.
+func DecryptSecret(value string) (string, error) {
+	secret, err := loadSecret()
+	if err != nil {
+		return "", err
+	}
+
+	return decrypt(value, secret)
+}
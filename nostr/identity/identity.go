@@ -0,0 +1,180 @@
+// Package identity manages the Nostr keypair the server signs events with.
+// The private key is kept encrypted in the config store and only held in
+// plain text in memory.
+package identity
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	privateKey string
+	lock       sync.RWMutex
+)
+
+// Setup will load the server's Nostr key, generating one if none exists
+// and encrypting a key that is still stored in plain text.
+func Setup() error {
+	lock.Lock()
+	defer lock.Unlock()
+
+	privateKey = ""
+
+	stored := configrepository.Get().GetNostrPrivateKey()
+	if stored == "" {
+		generated, err := nostr.GeneratePrivateKey()
+		if err != nil {
+			return err
+		}
+		if err := save(generated); err != nil {
+			return err
+		}
+		log.Infoln("Generated a new server Nostr identity")
+		return nil
+	}
+
+	secret, err := loadSecret()
+	if err != nil {
+		return err
+	}
+
+	if !isEncrypted(stored) {
+		if !nostr.IsValidPrivateKey(stored) {
+			return errors.New("stored nostr private key is invalid")
+		}
+		if err := saveWithSecret(stored, secret); err != nil {
+			return err
+		}
+		log.Infoln("Encrypted the stored server Nostr key")
+		return nil
+	}
+
+	decrypted, err := decrypt(stored, secret)
+	if err != nil {
+		return err
+	}
+	if !nostr.IsValidPrivateKey(decrypted) {
+		return errors.New("stored nostr private key is invalid")
+	}
+
+	privateKey = decrypted
+	return nil
+}
+
+// PrivateKey returns the hex private key, or an empty string when the
+// identity could not be loaded.
+func PrivateKey() string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return privateKey
+}
+
+// PublicKey returns the hex public key, or an empty string when the
+// identity could not be loaded.
+func PublicKey() string {
+	pubkey, err := nostr.GetPublicKey(PrivateKey())
+	if err != nil {
+		return ""
+	}
+
+	return pubkey
+}
+
+// Npub returns the NIP-19 encoded public key.
+func Npub() string {
+	npub, err := nostr.EncodePublicKey(PublicKey())
+	if err != nil {
+		return ""
+	}
+
+	return npub
+}
+
+// Sign signs the event with the server's key.
+func Sign(event *nostr.Event) error {
+	key := PrivateKey()
+	if key == "" {
+		return errors.New("the server nostr identity is not available")
+	}
+
+	return event.Sign(key)
+}
+
+// Import replaces the server's key with an existing nsec or hex private key.
+// The new public key is returned.
+func Import(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(strings.ToLower(key), nostr.PrivateKeyPrefix+"1") {
+		prefix, decoded, err := nostr.DecodeKey(key)
+		if err != nil {
+			return "", err
+		}
+		if prefix != nostr.PrivateKeyPrefix {
+			return "", errors.New("key is not an nsec")
+		}
+		key = decoded
+	}
+
+	key = strings.ToLower(key)
+	if !nostr.IsValidPrivateKey(key) {
+		return "", errors.New("key must be an nsec or a 64 character hex private key")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := save(key); err != nil {
+		return "", err
+	}
+
+	return nostr.GetPublicKey(key)
+}
+
+// Rotate replaces the server's key with a newly generated one.
+// The new public key is returned.
+func Rotate() (string, error) {
+	key, err := nostr.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := save(key); err != nil {
+		return "", err
+	}
+
+	return nostr.GetPublicKey(key)
+}
+
+// save encrypts and stores the key. Must be called with the lock held.
+func save(key string) error {
+	secret, err := loadSecret()
+	if err != nil {
+		return err
+	}
+
+	return saveWithSecret(key, secret)
+}
+
+func saveWithSecret(key string, secret []byte) error {
+	encrypted, err := encrypt(key, secret)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt nostr private key")
+	}
+
+	if err := configrepository.Get().SetNostrPrivateKey(encrypted); err != nil {
+		return errors.Wrap(err, "unable to save nostr private key")
+	}
+
+	privateKey = key
+	return nil
+}
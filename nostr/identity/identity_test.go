@@ -0,0 +1,156 @@
+package identity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-identity-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	config.NostrKeyFile = filepath.Join(dir, "nostr.secret")
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	encrypted, err := encrypt("secret value", []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isEncrypted(encrypted) {
+		t.Error("expected the encrypted prefix")
+	}
+
+	decrypted, err := decrypt(encrypted, []byte("passphrase"))
+	if err != nil || decrypted != "secret value" {
+		t.Errorf("decrypt() = %s, %v", decrypted, err)
+	}
+
+	if _, err := decrypt(encrypted, []byte("wrong")); err == nil {
+		t.Error("expected decrypting with the wrong secret to fail")
+	}
+}
+
+func TestSetupGeneratesEncryptedKey(t *testing.T) {
+	configRepository := configrepository.Get()
+	_ = configRepository.SetNostrPrivateKey("")
+
+	if err := Setup(); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := configRepository.GetNostrPrivateKey()
+	if !isEncrypted(stored) {
+		t.Fatal("key was not stored encrypted")
+	}
+	if !nostr.IsValidPrivateKey(PrivateKey()) || PublicKey() == "" || Npub() == "" {
+		t.Fatal("identity was not loaded")
+	}
+
+	// Loading again returns the same identity.
+	pubkey := PublicKey()
+	if err := Setup(); err != nil {
+		t.Fatal(err)
+	}
+	if PublicKey() != pubkey {
+		t.Error("identity changed after reloading")
+	}
+}
+
+func TestSetupEncryptsPlaintextKey(t *testing.T) {
+	privateKey, _ := nostr.GeneratePrivateKey()
+	configRepository := configrepository.Get()
+	_ = configRepository.SetNostrPrivateKey(privateKey)
+
+	if err := Setup(); err != nil {
+		t.Fatal(err)
+	}
+
+	if PrivateKey() != privateKey {
+		t.Error("existing key was not kept")
+	}
+	if !isEncrypted(configRepository.GetNostrPrivateKey()) {
+		t.Error("existing key was not encrypted")
+	}
+}
+
+func TestSetupWithChangedPassphrase(t *testing.T) {
+	defer func() { config.NostrKeyPassphrase = "" }()
+
+	config.NostrKeyPassphrase = "first passphrase"
+	if _, err := Rotate(); err != nil {
+		t.Fatal(err)
+	}
+	stored := configrepository.Get().GetNostrPrivateKey()
+
+	config.NostrKeyPassphrase = "second passphrase"
+	if err := Setup(); err == nil {
+		t.Fatal("expected setup to fail with the wrong passphrase")
+	}
+	if PrivateKey() != "" {
+		t.Error("expected no identity to be loaded")
+	}
+	if configrepository.Get().GetNostrPrivateKey() != stored {
+		t.Error("stored key must not be replaced when it cannot be decrypted")
+	}
+
+	config.NostrKeyPassphrase = "first passphrase"
+	if err := Setup(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestImportAndRotate(t *testing.T) {
+	// Test vector from NIP-19.
+	nsec := "nsec1vl029mgpspedva04g90vltkh6fvh240zqtv9k0t9af8935ke9laqsnlfe5"
+	privateKey := "67dea2ed018072d675f5415ecfaed7d2597555e202d85b3d65ea4e58d2d92ffa"
+
+	pubkey, err := Import(nsec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PrivateKey() != privateKey || PublicKey() != pubkey {
+		t.Error("nsec was not imported")
+	}
+
+	if _, err := Import(privateKey); err != nil {
+		t.Error(err)
+	}
+
+	for _, invalid := range []string{"", "nsec1invalid", "npub10elfcs4fr0l0r8af98jlmgdh9c8tcxjvz9qkw038js35mp4dma8qzvjptg", "abcd"} {
+		if _, err := Import(invalid); err == nil {
+			t.Errorf("expected importing %q to fail", invalid)
+		}
+	}
+	if PrivateKey() != privateKey {
+		t.Error("failed import replaced the key")
+	}
+
+	rotated, err := Rotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated == pubkey || PublicKey() != rotated {
+		t.Error("key was not rotated")
+	}
+
+	event := &nostr.Event{Kind: 1, CreatedAt: 1}
+	if err := Sign(event); err != nil || event.PubKey != rotated || event.Verify() != nil {
+		t.Error("event was not signed with the rotated key")
+	}
+}
@@ -0,0 +1,55 @@
+package nostr
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/pkg/errors"
+)
+
+// GeneratePrivateKey returns a new random hex encoded secp256k1 private key.
+func GeneratePrivateKey() (string, error) {
+	sk, err := btcec.NewPrivateKey()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to generate private key")
+	}
+
+	return hex.EncodeToString(sk.Serialize()), nil
+}
+
+// GetPublicKey returns the hex x-only public key for a hex private key.
+func GetPublicKey(privateKey string) (string, error) {
+	b, err := hex.DecodeString(privateKey)
+	if err != nil || len(b) != 32 {
+		return "", errors.New("invalid private key")
+	}
+
+	_, pk := btcec.PrivKeyFromBytes(b)
+	return hex.EncodeToString(schnorr.SerializePubKey(pk)), nil
+}
+
+// IsValidPrivateKey returns if the string is a usable hex secp256k1 private key.
+func IsValidPrivateKey(privateKey string) bool {
+	b, err := hex.DecodeString(privateKey)
+	if err != nil || len(b) != 32 {
+		return false
+	}
+
+	var scalar btcec.ModNScalar
+	overflow := scalar.SetByteSlice(b)
+	return !overflow && !scalar.IsZero()
+}
+
+// IsValidPublicKey returns if the string is a valid lowercase hex x-only public key.
+func IsValidPublicKey(pubkey string) bool {
+	if len(pubkey) != 64 {
+		return false
+	}
+	b, err := hex.DecodeString(pubkey)
+	if err != nil || hex.EncodeToString(b) != pubkey {
+		return false
+	}
+	_, err = schnorr.ParsePubKey(b)
+	return err == nil
+}
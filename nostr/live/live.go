@@ -0,0 +1,169 @@
+package live
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How often the participant counts of a live activity are refreshed.
+	updateInterval = 5 * time.Minute
+
+	// How long relays have to acknowledge a published live activity.
+	publishTimeout = 10 * time.Second
+)
+
+var (
+	getStatus func() models.Status
+	current   *nostr.LiveActivity
+	lock      sync.Mutex
+
+	// Closed to stop refreshing the live activity of the current stream.
+	stopUpdates chan struct{}
+)
+
+// Setup will prepare publishing NIP-53 live activities.
+func Setup(statusFunc func() models.Status) {
+	getStatus = statusFunc
+}
+
+// StreamStarted will publish a new live activity for the stream that just started.
+func StreamStarted() {
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := time.Now()
+	current = &nostr.LiveActivity{
+		Identifier: fmt.Sprintf("oni-%d", now.Unix()),
+		Starts:     now,
+		Status:     nostr.LiveStatusLive,
+	}
+	publishCurrent()
+	go PublishProfile()
+
+	stopUpdating()
+	stopUpdates = make(chan struct{})
+	go refreshPeriodically(stopUpdates)
+}
+
+// refreshPeriodically re-publishes the live activity until stop is closed.
+func refreshPeriodically(stop chan struct{}) {
+	ticker := time.NewTicker(updateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			StreamUpdated()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// stopUpdating stops refreshing the live activity. Must be called with the
+// lock held.
+func stopUpdating() {
+	if stopUpdates != nil {
+		close(stopUpdates)
+		stopUpdates = nil
+	}
+}
+
+// StreamUpdated will re-publish the current live activity, for example
+// after the stream title changed. Does nothing when no stream is live.
+func StreamUpdated() {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if current == nil || current.Status != nostr.LiveStatusLive {
+		return
+	}
+
+	publishCurrent()
+}
+
+// StreamEnded will mark the current live activity as ended.
+func StreamEnded() {
+	lock.Lock()
+	defer lock.Unlock()
+
+	stopUpdating()
+
+	if current == nil {
+		return
+	}
+
+	current.Status = nostr.LiveStatusEnded
+	current.Ends = time.Now()
+	publishCurrent()
+	go recordBroadcast(*current, identity.PublicKey())
+	current = nil
+}
+
+// ActivityAddress returns the address of the live activity for the current
+// stream, or an empty string when no stream is live.
+func ActivityAddress() string {
+	lock.Lock()
+	defer lock.Unlock()
+
+	pubkey := identity.PublicKey()
+	if current == nil || pubkey == "" {
+		return ""
+	}
+
+	return current.Address(pubkey)
+}
+
+// publishCurrent refreshes the metadata of the current activity and sends it
+// to the configured relays. Must be called with the lock held.
+func publishCurrent() {
+	configRepository := configrepository.Get()
+
+	serverURL := strings.TrimSuffix(configRepository.GetServerURL(), "/")
+	if serverURL == "" {
+		log.Debugln("Server URL is not set, not publishing the Nostr live activity.")
+		return
+	}
+
+	relays := relay.Get().Relays()
+	if len(relays) == 0 {
+		return
+	}
+
+	title := configRepository.GetStreamTitle()
+	if title == "" {
+		title = configRepository.GetServerName()
+	}
+
+	current.Title = title
+	current.Summary = configRepository.GetServerSummary()
+	current.Image = serverURL + "/thumbnail.jpg"
+	current.StreamingURL = serverURL + "/hls/stream.m3u8"
+	current.Hashtags = configRepository.GetServerMetadataTags()
+	current.HostPubkey = configRepository.GetAdminNostrPubkey()
+	current.Relays = relays
+
+	if getStatus != nil {
+		status := getStatus()
+		current.CurrentParticipants = status.ViewerCount
+		current.TotalParticipants = status.SessionMaxViewerCount
+	}
+
+	event := current.Event()
+	if err := identity.Sign(event); err != nil {
+		log.Errorln("Unable to sign Nostr live activity", err)
+		return
+	}
+
+	relay.Get().PublishInBackground(event, publishTimeout)
+}
@@ -0,0 +1,43 @@
+package live
+
+import (
+	"strings"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	log "github.com/sirupsen/logrus"
+)
+
+// PublishProfile will publish the server's kind 0 profile so Nostr clients
+// can show who publishes the live activities and where to zap them.
+func PublishProfile() {
+	configRepository := configrepository.Get()
+
+	serverURL := strings.TrimSuffix(configRepository.GetServerURL(), "/")
+	if serverURL == "" || len(relay.Get().Relays()) == 0 {
+		return
+	}
+
+	profile := nostr.Profile{
+		Name:    configRepository.GetServerName(),
+		About:   configRepository.GetServerSummary(),
+		Picture: serverURL + "/logo/external",
+		Website: serverURL,
+		LUD16:   zaps.LightningAddress(),
+	}
+
+	event, err := profile.Event()
+	if err != nil {
+		log.Errorln("Unable to create Nostr profile", err)
+		return
+	}
+	if err := identity.Sign(event); err != nil {
+		log.Errorln("Unable to sign Nostr profile", err)
+		return
+	}
+
+	relay.Get().PublishInBackground(event, publishTimeout)
+}
@@ -0,0 +1,184 @@
+package live
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/recordingrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// recordBroadcast saves the live activity that just ended so its recording
+// can be announced, right away if it already has a video to play.
+func recordBroadcast(activity nostr.LiveActivity, pubkey string) {
+	recordingRepository := recordingrepository.Get()
+
+	recording := recordingRepository.GetRecording(activity.Identifier)
+	if recording == nil {
+		recording = &models.Recording{ID: activity.Identifier}
+	}
+
+	recording.StartedAt = activity.Starts
+	recording.EndedAt = activity.Ends
+	if recording.Title == "" {
+		recording.Title = activity.Title
+	}
+	if recording.Summary == "" {
+		recording.Summary = activity.Summary
+	}
+	if pubkey != "" {
+		recording.LiveActivity = activity.Address(pubkey)
+	}
+
+	if !recording.HasVideo() {
+		if err := recordingRepository.SetRecording(recording); err != nil {
+			log.Errorln("error saving recording", err)
+		}
+		return
+	}
+
+	if err := PublishRecording(recording); err != nil {
+		log.Errorln("Unable to announce the stream recording", err)
+	}
+}
+
+// PublishRecording will announce the recording with a NIP-71 video event,
+// replacing the one announcing it before, and save it.
+func PublishRecording(recording *models.Recording) error {
+	if !recording.HasVideo() {
+		return errors.New("the recording has no video to announce")
+	}
+	if len(relay.Get().Relays()) == 0 {
+		return errors.New("no Nostr relays are configured")
+	}
+
+	if recording.PublishedAt == nil {
+		now := time.Now()
+		recording.PublishedAt = &now
+	}
+
+	video := recordingVideo(recording)
+	kind := video.Kind()
+	event := video.Event(kind)
+	if err := identity.Sign(event); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+	if !accepted(relay.Get().Publish(ctx, event)) {
+		return errors.New("no relay accepted the video event")
+	}
+
+	// Video events are not replaceable, so an edit has to delete the old one.
+	if recording.EventID != "" {
+		deleteEvent(nostr.DeletionRequest([]string{recording.EventID}, recording.EventKind, "Replaced by an updated video"))
+	}
+	recording.EventID = event.ID
+	recording.EventKind = kind
+
+	if configrepository.Get().GetNostrLegacyVideoEvents() {
+		legacy := video.Event(nostr.KindAddressableVideo)
+		if err := identity.Sign(legacy); err != nil {
+			return err
+		}
+		relay.Get().PublishInBackground(legacy, publishTimeout)
+		recording.LegacyEventID = legacy.ID
+	} else if recording.LegacyEventID != "" {
+		deleteEvent(legacyDeletionRequest(recording))
+		recording.LegacyEventID = ""
+	}
+
+	return recordingrepository.Get().SetRecording(recording)
+}
+
+// DeleteRecordingAnnouncement will ask relays to delete the events that
+// announced the recording. The recording itself is kept.
+func DeleteRecordingAnnouncement(recording *models.Recording) error {
+	if recording.EventID != "" {
+		deleteEvent(nostr.DeletionRequest([]string{recording.EventID}, recording.EventKind, "Video removed"))
+	}
+	if recording.LegacyEventID != "" {
+		deleteEvent(legacyDeletionRequest(recording))
+	}
+
+	recording.EventID = ""
+	recording.LegacyEventID = ""
+	recording.EventKind = 0
+	recording.PublishedAt = nil
+
+	return recordingrepository.Get().SetRecording(recording)
+}
+
+// recordingVideo returns the NIP-71 video for the recording, with a
+// source for each way it can be played.
+func recordingVideo(recording *models.Recording) nostr.Video {
+	video := nostr.Video{
+		Identifier:   recording.ID,
+		Title:        recording.Title,
+		Summary:      recording.Summary,
+		LiveActivity: recording.LiveActivity,
+		Hashtags:     configrepository.Get().GetServerMetadataTags(),
+	}
+	if recording.PublishedAt != nil {
+		video.PublishedAt = *recording.PublishedAt
+	}
+	if recording.EndedAt.After(recording.StartedAt) {
+		video.Duration = recording.EndedAt.Sub(recording.StartedAt)
+	}
+	if video.Title == "" {
+		video.Title = configrepository.Get().GetServerName()
+	}
+
+	for _, url := range []string{recording.PlaylistURL, recording.VideoURL} {
+		if url == "" {
+			continue
+		}
+		video.Sources = append(video.Sources, nostr.VideoSource{
+			URL:      url,
+			MimeType: nostr.VideoMimeType(url),
+			Image:    recording.ThumbnailURL,
+			Width:    recording.Width,
+			Height:   recording.Height,
+		})
+	}
+
+	return video
+}
+
+// legacyDeletionRequest returns the request to delete the kind 34235 event
+// of the recording, by ID and by address.
+func legacyDeletionRequest(recording *models.Recording) *nostr.Event {
+	request := nostr.DeletionRequest([]string{recording.LegacyEventID}, nostr.KindAddressableVideo, "Video removed")
+	if pubkey := identity.PublicKey(); pubkey != "" {
+		request.Tags = append(request.Tags, nostr.Tag{"a", strconv.Itoa(nostr.KindAddressableVideo) + ":" + pubkey + ":" + recording.ID})
+	}
+
+	return request
+}
+
+func deleteEvent(request *nostr.Event) {
+	if err := identity.Sign(request); err != nil {
+		log.Errorln("Unable to sign Nostr deletion request", err)
+		return
+	}
+
+	relay.Get().PublishInBackground(request, publishTimeout)
+}
+
+func accepted(results []relay.PublishResult) bool {
+	for _, result := range results {
+		if result.Accepted {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+package live
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/relaytest"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/recordingrepository"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-live-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	config.NostrKeyFile = filepath.Join(dir, "nostr.secret")
+	if err := identity.Setup(); err != nil {
+		panic(err)
+	}
+	_ = configrepository.Get().SetServerURL("https://oni.example.com")
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func waitUntil(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func eventsOfKind(fake *relaytest.Relay, kind int) []*nostr.Event {
+	events := []*nostr.Event{}
+	for _, event := range fake.Events() {
+		if event.Kind == kind {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestRecordingAnnouncement(t *testing.T) {
+	fake := relaytest.NewRelay()
+	defer fake.Close()
+	relay.Get().SetRelays([]string{fake.URL()})
+	defer relay.Get().SetRelays(nil)
+	waitUntil(t, "relay connection", func() bool {
+		health := relay.Get().Health()
+		return len(health) == 1 && health[0].Status == relay.StatusConnected
+	})
+
+	// Created before the broadcast is recorded in the background.
+	recordings := recordingrepository.Get()
+
+	StreamStarted()
+	address := ActivityAddress()
+	StreamEnded()
+	var id string
+	waitUntil(t, "the broadcast to be recorded", func() bool {
+		saved, _, _ := recordings.GetRecordings(10, 0)
+		if len(saved) == 1 {
+			id = saved[0].ID
+		}
+		return id != ""
+	})
+
+	recording := recordings.GetRecording(id)
+	if recording.LiveActivity != address || recording.EventID != "" {
+		t.Fatalf("unexpected recording %+v", recording)
+	}
+	if err := PublishRecording(recording); err == nil {
+		t.Fatal("expected an error announcing a recording without a video")
+	}
+
+	recording.PlaylistURL = "https://cdn.example.com/vod/stream.m3u8"
+	recording.ThumbnailURL = "https://cdn.example.com/vod/thumbnail.jpg"
+	if err := PublishRecording(recording); err != nil {
+		t.Fatal(err)
+	}
+
+	videos := eventsOfKind(fake, nostr.KindVideo)
+	if len(videos) != 1 {
+		t.Fatalf("expected a video event, got %d", len(videos))
+	}
+	if videos[0].Tags.Value("a") != address || videos[0].Tags.GetFirst("imeta") == nil {
+		t.Errorf("unexpected video event %+v", videos[0])
+	}
+	firstID := videos[0].ID
+
+	// Editing replaces the video event and deletes the old one.
+	_ = configrepository.Get().SetNostrLegacyVideoEvents(true)
+	defer func() { _ = configrepository.Get().SetNostrLegacyVideoEvents(false) }()
+	recording.Title = "Edited title"
+	if err := PublishRecording(recording); err != nil {
+		t.Fatal(err)
+	}
+
+	waitUntil(t, "the old video event to be deleted", func() bool {
+		for _, deletion := range eventsOfKind(fake, nostr.KindDeletion) {
+			if deletion.Tags.Value("e") == firstID {
+				return true
+			}
+		}
+		return false
+	})
+	waitUntil(t, "the legacy video event", func() bool {
+		return len(eventsOfKind(fake, nostr.KindAddressableVideo)) == 1
+	})
+
+	saved := recordings.GetRecording(id)
+	if saved.EventID == firstID || saved.LegacyEventID == "" || saved.Title != "Edited title" {
+		t.Errorf("unexpected saved recording %+v", saved)
+	}
+
+	eventID, legacyEventID := saved.EventID, saved.LegacyEventID
+	if err := DeleteRecordingAnnouncement(saved); err != nil {
+		t.Fatal(err)
+	}
+	waitUntil(t, "the video events to be deleted", func() bool {
+		deleted := map[string]bool{}
+		for _, deletion := range eventsOfKind(fake, nostr.KindDeletion) {
+			for _, eventID := range nostr.DeletedEventIDs(deletion) {
+				deleted[eventID] = true
+			}
+		}
+		return deleted[eventID] && deleted[legacyEventID]
+	})
+
+	if saved = recordings.GetRecording(id); saved.EventID != "" || saved.PublishedAt != nil {
+		t.Errorf("expected the announcement to be cleared, got %+v", saved)
+	}
+}
@@ -0,0 +1,114 @@
+package nostr
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/pkg/errors"
+)
+
+// NIP-04 ciphertexts are base64(ciphertext) + "?iv=" + base64(iv).
+const nip04IVSeparator = "?iv="
+
+// EncryptDirectMessage encrypts the plaintext for the recipient's public key
+// as described in NIP-04.
+func EncryptDirectMessage(privateKey, recipient, plaintext string) (string, error) {
+	key, err := sharedSecret(privateKey, recipient)
+	if err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return "", err
+	}
+
+	// PKCS#7 padding up to the next full block.
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	padded := append([]byte(plaintext), bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	ciphertext := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, padded)
+
+	return base64.StdEncoding.EncodeToString(ciphertext) + nip04IVSeparator + base64.StdEncoding.EncodeToString(iv), nil
+}
+
+// DecryptDirectMessage decrypts NIP-04 content sent by the sender's public key.
+func DecryptDirectMessage(privateKey, sender, content string) (string, error) {
+	encoded, encodedIV, found := strings.Cut(content, nip04IVSeparator)
+	if !found {
+		return "", errors.New("encrypted content has no iv")
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.Wrap(err, "encrypted content is not valid base64")
+	}
+	iv, err := base64.StdEncoding.DecodeString(encodedIV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return "", errors.New("encrypted content has an invalid iv")
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return "", errors.New("encrypted content has an invalid length")
+	}
+
+	key, err := sharedSecret(privateKey, sender)
+	if err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return "", errors.New("unable to decrypt content")
+	}
+	for _, b := range plaintext[len(plaintext)-padding:] {
+		if int(b) != padding {
+			return "", errors.New("unable to decrypt content")
+		}
+	}
+
+	return string(plaintext[:len(plaintext)-padding]), nil
+}
+
+// sharedSecret returns the x coordinate of the ECDH point shared between the
+// private key and the x-only public key.
+func sharedSecret(privateKey, pubkey string) ([]byte, error) {
+	sk, err := hex.DecodeString(privateKey)
+	if err != nil || len(sk) != 32 {
+		return nil, errors.New("invalid private key")
+	}
+
+	pk, err := hex.DecodeString(pubkey)
+	if err != nil || len(pk) != 32 {
+		return nil, errors.New("invalid public key")
+	}
+
+	// x-only keys always refer to the point with an even y coordinate.
+	publicKey, err := btcec.ParsePubKey(append([]byte{0x02}, pk...))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes(sk)
+
+	return btcec.GenerateSharedSecret(privKey, publicKey), nil
+}
@@ -0,0 +1,43 @@
+package nostr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDirectMessageEncryption(t *testing.T) {
+	alice := mustGenerateKey(t)
+	bob := mustGenerateKey(t)
+	alicePubkey, _ := GetPublicKey(alice)
+	bobPubkey, _ := GetPublicKey(bob)
+
+	for _, plaintext := range []string{"", "hello", strings.Repeat("x", 16), `{"method":"get_balance","params":{}}`, "⚡ emoji"} {
+		encrypted, err := EncryptDirectMessage(alice, bobPubkey, plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(encrypted, "?iv=") {
+			t.Errorf("%q: encrypted content has no iv: %s", plaintext, encrypted)
+		}
+
+		decrypted, err := DecryptDirectMessage(bob, alicePubkey, encrypted)
+		if err != nil {
+			t.Fatalf("%q: %v", plaintext, err)
+		}
+		if decrypted != plaintext {
+			t.Errorf("decrypted %q, want %q", decrypted, plaintext)
+		}
+	}
+
+	encrypted, _ := EncryptDirectMessage(alice, bobPubkey, "secret")
+	eve := mustGenerateKey(t)
+	if decrypted, err := DecryptDirectMessage(eve, alicePubkey, encrypted); err == nil && decrypted == "secret" {
+		t.Error("message was decrypted with the wrong key")
+	}
+
+	for _, invalid := range []string{"", "bm9pdg==", "bm9pdg==?iv=short", "?iv=AAAAAAAAAAAAAAAAAAAAAA=="} {
+		if _, err := DecryptDirectMessage(bob, alicePubkey, invalid); err == nil {
+			t.Errorf("%q: expected an error", invalid)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// NIP05RootName is the name of the identifier displayed as just the domain.
+const NIP05RootName = "_"
+
+// The longest NIP-05 name accepted.
+const maxNIP05NameLength = 64
+
+// The largest nostr.json document read when verifying an identifier.
+const maxNIP05DocumentSize = 1 << 20
+
+// The scheme nostr.json documents are fetched with. Tests use plain http.
+var nip05Scheme = "https"
+
+// NIP-05 forbids following redirects when fetching nostr.json.
+var nip05Client = &http.Client{
+	Timeout: 10 * time.Second,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// NIP05Document is the /.well-known/nostr.json response mapping names to
+// pubkeys, and pubkeys to the relays they can be found on.
+type NIP05Document struct {
+	Names  map[string]string   `json:"names"`
+	Relays map[string][]string `json:"relays,omitempty"`
+}
+
+// IsValidNIP05Name returns if the name is made of the characters NIP-05
+// allows in the local part of an identifier.
+func IsValidNIP05Name(name string) bool {
+	if name == "" || len(name) > maxNIP05NameLength {
+		return false
+	}
+
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NIP05NameFromDisplayName returns a valid NIP-05 name resembling the
+// display name, or an empty string when nothing usable is left.
+func NIP05NameFromDisplayName(displayName string) string {
+	b := strings.Builder{}
+	for _, r := range strings.ToLower(strings.TrimSpace(displayName)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteRune('_')
+		}
+		if b.Len() >= maxNIP05NameLength {
+			break
+		}
+	}
+
+	name := strings.Trim(b.String(), "._-")
+	if !IsValidNIP05Name(name) {
+		return ""
+	}
+
+	return name
+}
+
+// ParseNIP05Identifier splits a name@domain identifier. A bare domain is
+// the root name of that domain.
+func ParseNIP05Identifier(identifier string) (name string, domain string, err error) {
+	identifier = strings.ToLower(strings.TrimSpace(identifier))
+	name, domain, found := strings.Cut(identifier, "@")
+	if !found {
+		name, domain = NIP05RootName, identifier
+	}
+
+	if !IsValidNIP05Name(name) {
+		return "", "", errors.New("invalid NIP-05 name")
+	}
+	if domain == "" || strings.ContainsAny(domain, "/?#@ ") {
+		return "", "", errors.New("invalid NIP-05 domain")
+	}
+
+	return name, domain, nil
+}
+
+// VerifyNIP05 fetches the nostr.json of the identifier's domain and returns
+// if it maps the identifier to the pubkey.
+func VerifyNIP05(ctx context.Context, identifier, pubkey string) (bool, error) {
+	name, domain, err := ParseNIP05Identifier(identifier)
+	if err != nil {
+		return false, err
+	}
+
+	u := url.URL{
+		Scheme:   nip05Scheme,
+		Host:     domain,
+		Path:     "/.well-known/nostr.json",
+		RawQuery: url.Values{"name": {name}}.Encode(),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := nip05Client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to fetch nostr.json")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("nostr.json returned status %d", resp.StatusCode)
+	}
+
+	document := NIP05Document{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxNIP05DocumentSize)).Decode(&document); err != nil {
+		return false, errors.Wrap(err, "nostr.json is not valid")
+	}
+
+	return strings.EqualFold(document.Names[name], pubkey), nil
+}
@@ -0,0 +1,169 @@
+// Package nip05 builds the server's /.well-known/nostr.json, verifying the
+// stream's federation username, the admin, any extra names the admin set
+// up and the chat users who opted in as NIP-05 identifiers on this domain.
+package nip05
+
+import (
+	"strings"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/nip05repository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// AdminName is the name the admin pubkey is served as unless the admin
+// configured it as an extra name.
+const AdminName = "admin"
+
+// Names chat users may not take, as they would look like they speak for
+// the server.
+var reservedNames = map[string]bool{
+	AdminName: true, "administrator": true, "root": true, "owner": true,
+	"staff": true, "official": true, "system": true, "server": true,
+	"support": true, "help": true, "info": true, "contact": true,
+	"security": true, "abuse": true, "postmaster": true, "hostmaster": true,
+	"webmaster": true, "noreply": true, "no-reply": true, "oni": true,
+	"owncast": true,
+}
+
+// Names only chat moderators may take.
+var moderatorNames = map[string]bool{
+	"mod": true, "mods": true, "moderator": true, "moderators": true,
+}
+
+// ServerNames returns the names owned by the server: the federation
+// username and the root name for the server key, the admin pubkey, and
+// the extra names configured by the admin.
+func ServerNames() map[string]string {
+	configRepository := configrepository.Get()
+	names := map[string]string{}
+
+	if adminPubkey := strings.ToLower(configRepository.GetAdminNostrPubkey()); nostr.IsValidPublicKey(adminPubkey) {
+		names[AdminName] = adminPubkey
+	}
+
+	serverPubkey := identity.PublicKey()
+	if serverPubkey != "" {
+		names[nostr.NIP05RootName] = serverPubkey
+	}
+
+	for name, pubkey := range configRepository.GetNostrNIP05Names() {
+		if nostr.IsValidNIP05Name(name) && nostr.IsValidPublicKey(pubkey) {
+			names[name] = pubkey
+		}
+	}
+
+	// The federation username always identifies the server itself.
+	if username := strings.ToLower(configRepository.GetFederationUsername()); serverPubkey != "" && nostr.IsValidNIP05Name(username) {
+		names[username] = serverPubkey
+	}
+
+	return names
+}
+
+// Document returns the nostr.json response. When name is set only that
+// name is included, as NIP-05 clients ask for a single name.
+func Document(name string) *nostr.NIP05Document {
+	name = strings.ToLower(name)
+	names := ServerNames()
+
+	// Server names take precedence over the names of chat users.
+	if name != "" {
+		if _, ok := names[name]; !ok {
+			if pubkey := nip05repository.Get().GetPubkey(name); pubkey != "" {
+				names[name] = pubkey
+			}
+		}
+	} else {
+		users, err := nip05repository.Get().GetNames()
+		if err != nil {
+			log.Errorln("Unable to load the NIP-05 names of chat users", err)
+		}
+		for userName, pubkey := range users {
+			if _, ok := names[userName]; !ok {
+				names[userName] = pubkey
+			}
+		}
+	}
+
+	document := &nostr.NIP05Document{
+		Names:  map[string]string{},
+		Relays: map[string][]string{},
+	}
+
+	for n, pubkey := range names {
+		if name == "" || n == name {
+			document.Names[n] = pubkey
+		}
+	}
+
+	// The server's events can be found on the relays it publishes to.
+	serverPubkey := identity.PublicKey()
+	relays := configrepository.Get().GetNostrRelays()
+	for _, pubkey := range document.Names {
+		if pubkey == serverPubkey && len(relays) > 0 {
+			document.Relays[pubkey] = relays
+		}
+	}
+
+	return document
+}
+
+// Identifier returns the full NIP-05 identifier for a name on this server,
+// or an empty string when the server URL is not set.
+func Identifier(name string) string {
+	host := utils.GetHostnameFromURLString(configrepository.Get().GetServerURL())
+	if host == "" {
+		return ""
+	}
+
+	return name + "@" + host
+}
+
+// OptIn gives a Nostr authenticated chat user a name based on their
+// display name, or the name they asked for, and returns it.
+func OptIn(user *models.User, name string) (string, error) {
+	pubkey := userrepository.Get().GetAuthForUser(user.ID, models.Nostr)
+	if pubkey == "" {
+		return "", errors.New("only users authenticated with Nostr can have a NIP-05 identifier")
+	}
+
+	if name == "" {
+		name = nostr.NIP05NameFromDisplayName(user.DisplayName)
+	}
+	name = strings.ToLower(name)
+	if !nostr.IsValidNIP05Name(name) || name == nostr.NIP05RootName {
+		return "", errors.New("names may only contain the letters a-z, numbers, dashes, underscores and dots")
+	}
+
+	if _, reserved := ServerNames()[name]; reserved || !mayTakeName(user, name) {
+		return "", nip05repository.ErrNameTaken
+	}
+
+	if err := nip05repository.Get().SetUserName(user.ID, name, pubkey); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// mayTakeName returns if the chat user may take a name, which is not the
+// case for reserved names or those of a role they do not have.
+func mayTakeName(user *models.User, name string) bool {
+	if reservedNames[name] {
+		return false
+	}
+
+	return !moderatorNames[name] || user.IsModerator()
+}
+
+// OptOut removes the chat user's name.
+func OptOut(user *models.User) error {
+	return nip05repository.Get().RemoveUser(user.ID)
+}
@@ -0,0 +1,158 @@
+package nip05
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TekkadanPlays/oni/auth"
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-nip05-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	auth.Setup(data.GetDatastore())
+	config.NostrKeyFile = filepath.Join(dir, "nostr.secret")
+	if err := identity.Setup(); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func mustGeneratePubkey(t *testing.T) string {
+	t.Helper()
+
+	key, err := nostr.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, _ := nostr.GetPublicKey(key)
+	return pubkey
+}
+
+func TestDocument(t *testing.T) {
+	configRepository := configrepository.Get()
+	adminPubkey := mustGeneratePubkey(t)
+	extraPubkey := mustGeneratePubkey(t)
+
+	_ = configRepository.SetFederationUsername("Streamer")
+	_ = configRepository.SetAdminNostrPubkey(adminPubkey)
+	_ = configRepository.SetNostrRelays([]string{"wss://relay.one"})
+	_ = configRepository.SetNostrNIP05Names(map[string]string{
+		"friend":   extraPubkey,
+		"streamer": extraPubkey,
+		"Invalid!": extraPubkey,
+	})
+
+	document := Document("")
+	expected := map[string]string{
+		"streamer":          identity.PublicKey(),
+		nostr.NIP05RootName: identity.PublicKey(),
+		AdminName:           adminPubkey,
+		"friend":            extraPubkey,
+	}
+	if len(document.Names) != len(expected) {
+		t.Errorf("names = %v", document.Names)
+	}
+	for name, pubkey := range expected {
+		if document.Names[name] != pubkey {
+			t.Errorf("%s = %q, want %q", name, document.Names[name], pubkey)
+		}
+	}
+	if relays := document.Relays[identity.PublicKey()]; len(relays) != 1 || relays[0] != "wss://relay.one" {
+		t.Errorf("relays = %v", document.Relays)
+	}
+
+	single := Document("Friend")
+	if len(single.Names) != 1 || single.Names["friend"] != extraPubkey {
+		t.Errorf("names for friend = %v", single.Names)
+	}
+	if len(single.Relays) != 0 {
+		t.Errorf("relays for friend = %v", single.Relays)
+	}
+
+	if unknown := Document("nobody"); len(unknown.Names) != 0 {
+		t.Errorf("names for an unknown name = %v", unknown.Names)
+	}
+}
+
+func TestOptIn(t *testing.T) {
+	userRepository := userrepository.Get()
+	_ = configrepository.Get().SetFederationUsername("streamer")
+
+	user, _, err := userRepository.CreateAnonymousUser("Cool Cat")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OptIn(user, ""); err == nil {
+		t.Error("user without a Nostr identity was given a name")
+	}
+
+	pubkey := mustGeneratePubkey(t)
+	if err := userRepository.AddAuth(user.ID, pubkey, models.Nostr); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, reserved := range []string{"streamer", AdminName, nostr.NIP05RootName, "not valid", "support", "moderator"} {
+		if _, err := OptIn(user, reserved); err == nil {
+			t.Errorf("user was given the name %q", reserved)
+		}
+	}
+
+	name, err := OptIn(user, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "cool_cat" {
+		t.Errorf("name = %q", name)
+	}
+	if pubkeys := Document("cool_cat").Names; pubkeys["cool_cat"] != pubkey {
+		t.Errorf("opted in user is not served: %v", pubkeys)
+	}
+
+	// Moderators can take the names of their role.
+	moderator, _, _ := userRepository.CreateAnonymousUser("mod")
+	_ = userRepository.AddAuth(moderator.ID, mustGeneratePubkey(t), models.Nostr)
+	moderator.Scopes = []string{"MODERATOR"}
+	if _, err := OptIn(moderator, "moderator"); err != nil {
+		t.Errorf("moderator was not given the name moderator: %v", err)
+	}
+
+	// Another user cannot take the name.
+	other, _, _ := userRepository.CreateAnonymousUser("other")
+	_ = userRepository.AddAuth(other.ID, mustGeneratePubkey(t), models.Nostr)
+	if _, err := OptIn(other, "cool_cat"); err == nil {
+		t.Error("name of another user was taken")
+	}
+
+	// Disabled users are not served.
+	_ = userRepository.SetEnabled(user.ID, false)
+	if names := Document("").Names; names["cool_cat"] != "" {
+		t.Error("disabled user is served")
+	}
+	_ = userRepository.SetEnabled(user.ID, true)
+
+	if err := OptOut(user); err != nil {
+		t.Fatal(err)
+	}
+	if names := Document("cool_cat").Names; len(names) != 0 {
+		t.Errorf("user is served after opting out: %v", names)
+	}
+}
@@ -0,0 +1,112 @@
+package nostr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNIP05Names(t *testing.T) {
+	valid := []string{"bob", "_", "bob.smith", "bob-smith_2"}
+	invalid := []string{"", "Bob", "bob smith", "bob@example.com", "böb"}
+
+	for _, name := range valid {
+		if !IsValidNIP05Name(name) {
+			t.Errorf("%q should be valid", name)
+		}
+	}
+	for _, name := range invalid {
+		if IsValidNIP05Name(name) {
+			t.Errorf("%q should be invalid", name)
+		}
+	}
+
+	tests := []struct {
+		displayName string
+		name        string
+	}{
+		{"Bob", "bob"},
+		{"Bob Smith", "bob_smith"},
+		{"  ~Cool Cat~ ", "cool_cat"},
+		{"Ünïcode", "ncode"},
+		{"🎉🎉", ""},
+	}
+
+	for _, test := range tests {
+		if name := NIP05NameFromDisplayName(test.displayName); name != test.name {
+			t.Errorf("NIP05NameFromDisplayName(%q) = %q, want %q", test.displayName, name, test.name)
+		}
+	}
+}
+
+func TestParseNIP05Identifier(t *testing.T) {
+	tests := []struct {
+		identifier string
+		name       string
+		domain     string
+		wantErr    bool
+	}{
+		{"bob@example.com", "bob", "example.com", false},
+		{"Bob@Example.com", "bob", "example.com", false},
+		{"example.com", "_", "example.com", false},
+		{"bob smith@example.com", "", "", true},
+		{"bob@", "", "", true},
+		{"bob@example.com/path", "", "", true},
+	}
+
+	for _, tt := range tests {
+		name, domain, err := ParseNIP05Identifier(tt.identifier)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseNIP05Identifier(%q) error = %v", tt.identifier, err)
+			continue
+		}
+		if name != tt.name || domain != tt.domain {
+			t.Errorf("ParseNIP05Identifier(%q) = %q, %q", tt.identifier, name, domain)
+		}
+	}
+}
+
+func TestVerifyNIP05(t *testing.T) {
+	pubkey := strings.Repeat("ab", 32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("name") {
+		case "redirect":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		default:
+			_ = json.NewEncoder(w).Encode(NIP05Document{Names: map[string]string{"bob": pubkey, "_": pubkey}})
+		}
+	}))
+	defer server.Close()
+
+	previous := nip05Scheme
+	nip05Scheme = "http"
+	defer func() { nip05Scheme = previous }()
+
+	domain := strings.TrimPrefix(server.URL, "http://")
+	tests := []struct {
+		identifier string
+		pubkey     string
+		verified   bool
+		wantErr    bool
+	}{
+		{"bob@" + domain, pubkey, true, false},
+		{domain, pubkey, true, false},
+		{"bob@" + domain, strings.Repeat("cd", 32), false, false},
+		{"alice@" + domain, pubkey, false, false},
+		{"redirect@" + domain, pubkey, false, true},
+	}
+
+	for _, tt := range tests {
+		verified, err := VerifyNIP05(context.Background(), tt.identifier, tt.pubkey)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("VerifyNIP05(%q) error = %v", tt.identifier, err)
+			continue
+		}
+		if verified != tt.verified {
+			t.Errorf("VerifyNIP05(%q) = %v, want %v", tt.identifier, verified, tt.verified)
+		}
+	}
+}
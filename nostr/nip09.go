@@ -0,0 +1,37 @@
+package nostr
+
+import (
+	"strconv"
+	"time"
+)
+
+// KindDeletion is the NIP-09 event deletion request kind.
+const KindDeletion = 5
+
+// DeletionRequest returns an unsigned request to delete the given events.
+// Relays only honour it when signed by the author of the events.
+func DeletionRequest(eventIDs []string, kind int, reason string) *Event {
+	tags := make(Tags, 0, len(eventIDs)+1)
+	for _, id := range eventIDs {
+		tags = append(tags, Tag{"e", id})
+	}
+	tags = append(tags, Tag{"k", strconv.Itoa(kind)})
+
+	return &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindDeletion,
+		Tags:      tags,
+		Content:   reason,
+	}
+}
+
+// DeletedEventIDs returns the IDs of the events a deletion request refers to.
+func DeletedEventIDs(event *Event) []string {
+	ids := []string{}
+	for _, tag := range event.Tags.GetAll("e") {
+		if id := tag.Value(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,177 @@
+package nostr
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Direct message event kinds from NIP-04, NIP-17 and NIP-59.
+const (
+	KindEncryptedDirectMessage = 4
+	KindSeal                   = 13
+	KindPrivateDirectMessage   = 14
+	KindGiftWrap               = 1059
+	KindDMRelayList            = 10050
+)
+
+// Gift wraps and seals are backdated by up to two days so their timestamps
+// do not reveal when the message was sent.
+const giftWrapTimestampJitter = 2 * 24 * time.Hour
+
+// EncryptedDirectMessage returns a signed NIP-04 kind 4 direct message.
+func EncryptedDirectMessage(privateKey, recipient, message string) (*Event, error) {
+	content, err := EncryptDirectMessage(privateKey, recipient, message)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindEncryptedDirectMessage,
+		Tags:      Tags{{"p", recipient}},
+		Content:   content,
+	}
+	if err := event.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// GiftWrapDirectMessage returns a NIP-17 private direct message to the
+// recipient: an unsigned kind 14 rumor sealed by the sender and gift
+// wrapped with a throwaway key so only the recipient learns who sent it.
+func GiftWrapDirectMessage(privateKey, recipient, message string) (*Event, error) {
+	sender, err := GetPublicKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rumor := &Event{
+		PubKey:    sender,
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindPrivateDirectMessage,
+		Tags:      Tags{{"p", recipient}},
+		Content:   message,
+	}
+	rumor.ID = rumor.GetID()
+
+	seal, err := sealEvent(privateKey, recipient, KindSeal, Tags{}, rumor)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapKey, err := GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return sealEvent(wrapKey, recipient, KindGiftWrap, Tags{{"p", recipient}}, seal)
+}
+
+// UnwrapGiftWrap opens a gift wrap addressed to the private key and returns
+// the rumor inside it, checking that the seal was signed by its author.
+func UnwrapGiftWrap(privateKey string, wrap *Event) (*Event, error) {
+	if wrap.Kind != KindGiftWrap {
+		return nil, errors.New("event is not a gift wrap")
+	}
+
+	seal, err := openSealedEvent(privateKey, wrap)
+	if err != nil {
+		return nil, err
+	}
+	if seal.Kind != KindSeal {
+		return nil, errors.New("gift wrap does not contain a seal")
+	}
+	if err := seal.Verify(); err != nil {
+		return nil, errors.Wrap(err, "invalid seal")
+	}
+
+	rumor, err := openSealedEvent(privateKey, seal)
+	if err != nil {
+		return nil, err
+	}
+	if rumor.PubKey != seal.PubKey {
+		return nil, errors.New("seal and message authors do not match")
+	}
+
+	return rumor, nil
+}
+
+type unsignedEvent struct {
+	ID        string `json:"id"`
+	PubKey    string `json:"pubkey"`
+	CreatedAt int64  `json:"created_at"`
+	Kind      int    `json:"kind"`
+	Tags      Tags   `json:"tags"`
+	Content   string `json:"content"`
+}
+
+// sealEvent encrypts the inner event to the recipient with NIP-44 inside a
+// new event of the given kind signed by the key.
+func sealEvent(privateKey, recipient string, kind int, tags Tags, inner *Event) (*Event, error) {
+	conversationKey, err := ConversationKey(privateKey, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	var b []byte
+	if inner.Sig == "" {
+		// Rumors are unsigned and are sent without a sig field.
+		b, err = json.Marshal(unsignedEvent{inner.ID, inner.PubKey, inner.CreatedAt, inner.Kind, inner.Tags, inner.Content})
+	} else {
+		b, err = json.Marshal(inner)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := EncryptNIP44(conversationKey, string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		CreatedAt: randomPastTimestamp(),
+		Kind:      kind,
+		Tags:      tags,
+		Content:   content,
+	}
+	if err := event.Sign(privateKey); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func openSealedEvent(privateKey string, event *Event) (*Event, error) {
+	conversationKey, err := ConversationKey(privateKey, event.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := DecryptNIP44(conversationKey, event.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	inner := &Event{}
+	if err := json.Unmarshal([]byte(plaintext), inner); err != nil {
+		return nil, errors.Wrap(err, "sealed content is not an event")
+	}
+
+	return inner, nil
+}
+
+func randomPastTimestamp() int64 {
+	jitter, err := rand.Int(rand.Reader, big.NewInt(int64(giftWrapTimestampJitter/time.Second)))
+	if err != nil {
+		return time.Now().Unix()
+	}
+
+	return time.Now().Unix() - jitter.Int64()
+}
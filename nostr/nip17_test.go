@@ -0,0 +1,108 @@
+package nostr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGiftWrapDirectMessage(t *testing.T) {
+	sender, _ := GeneratePrivateKey()
+	senderPubkey, _ := GetPublicKey(sender)
+	recipient, _ := GeneratePrivateKey()
+	recipientPubkey, _ := GetPublicKey(recipient)
+
+	wrap, err := GiftWrapDirectMessage(sender, recipientPubkey, "We are live!")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wrap.Kind != KindGiftWrap {
+		t.Errorf("kind = %d", wrap.Kind)
+	}
+	if err := wrap.Verify(); err != nil {
+		t.Errorf("gift wrap is not signed: %v", err)
+	}
+	if wrap.PubKey == senderPubkey {
+		t.Error("gift wrap must not be signed by the sender")
+	}
+	if wrap.Tags.Value("p") != recipientPubkey {
+		t.Errorf("p tag = %q", wrap.Tags.Value("p"))
+	}
+	if wrap.CreatedAt > time.Now().Unix() || wrap.CreatedAt < time.Now().Add(-giftWrapTimestampJitter).Unix() {
+		t.Errorf("created_at %d is outside of the allowed range", wrap.CreatedAt)
+	}
+
+	rumor, err := UnwrapGiftWrap(recipient, wrap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rumor.Kind != KindPrivateDirectMessage || rumor.Content != "We are live!" {
+		t.Errorf("unexpected rumor %+v", rumor)
+	}
+	if rumor.PubKey != senderPubkey {
+		t.Errorf("rumor author = %s, want %s", rumor.PubKey, senderPubkey)
+	}
+	if rumor.Sig != "" {
+		t.Error("rumor must not be signed")
+	}
+	if !rumor.CheckID() {
+		t.Error("rumor id does not match its content")
+	}
+
+	other, _ := GeneratePrivateKey()
+	if _, err := UnwrapGiftWrap(other, wrap); err == nil {
+		t.Error("expected another key to be unable to unwrap the message")
+	}
+}
+
+func TestUnwrapRejectsForgedSeal(t *testing.T) {
+	sender, _ := GeneratePrivateKey()
+	impersonated, _ := GeneratePrivateKey()
+	impersonatedPubkey, _ := GetPublicKey(impersonated)
+	recipient, _ := GeneratePrivateKey()
+	recipientPubkey, _ := GetPublicKey(recipient)
+
+	// A rumor claiming to be from someone other than the seal's signer.
+	rumor := &Event{PubKey: impersonatedPubkey, CreatedAt: time.Now().Unix(), Kind: KindPrivateDirectMessage, Tags: Tags{}, Content: "hi"}
+	rumor.ID = rumor.GetID()
+	seal, err := sealEvent(sender, recipientPubkey, KindSeal, Tags{}, rumor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapKey, _ := GeneratePrivateKey()
+	wrap, err := sealEvent(wrapKey, recipientPubkey, KindGiftWrap, Tags{{"p", recipientPubkey}}, seal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := UnwrapGiftWrap(recipient, wrap); err == nil {
+		t.Error("expected a forged rumor author to be rejected")
+	}
+}
+
+func TestEncryptedDirectMessage(t *testing.T) {
+	sender, _ := GeneratePrivateKey()
+	senderPubkey, _ := GetPublicKey(sender)
+	recipient, _ := GeneratePrivateKey()
+	recipientPubkey, _ := GetPublicKey(recipient)
+
+	event, err := EncryptedDirectMessage(sender, recipientPubkey, "We are live!")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.Kind != KindEncryptedDirectMessage || event.Tags.Value("p") != recipientPubkey {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if err := event.Verify(); err != nil {
+		t.Error(err)
+	}
+
+	message, err := DecryptDirectMessage(recipient, senderPubkey, event.Content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message != "We are live!" {
+		t.Errorf("message = %q", message)
+	}
+}
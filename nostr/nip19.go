@@ -0,0 +1,187 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NIP-19 human readable prefixes for bare keys.
+const (
+	PublicKeyPrefix  = "npub"
+	PrivateKeyPrefix = "nsec"
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// EncodePublicKey returns the npub encoding of a hex public key.
+func EncodePublicKey(publicKey string) (string, error) {
+	return encodeKey(PublicKeyPrefix, publicKey)
+}
+
+// EncodePrivateKey returns the nsec encoding of a hex private key.
+func EncodePrivateKey(privateKey string) (string, error) {
+	return encodeKey(PrivateKeyPrefix, privateKey)
+}
+
+// DecodeKey decodes an npub or nsec string, returning its prefix and the
+// hex encoded key.
+func DecodeKey(encoded string) (prefix string, key string, err error) {
+	prefix, data, err := bech32Decode(encoded)
+	if err != nil {
+		return "", "", err
+	}
+
+	if prefix != PublicKeyPrefix && prefix != PrivateKeyPrefix {
+		return "", "", errors.Errorf("unsupported prefix %q", prefix)
+	}
+
+	b, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return "", "", err
+	}
+	if len(b) != 32 {
+		return "", "", errors.New("encoded key has the wrong length")
+	}
+
+	return prefix, hex.EncodeToString(b), nil
+}
+
+// ParsePublicKey accepts a public key as an npub or hex string and returns
+// it as lowercase hex.
+func ParsePublicKey(value string) (string, error) {
+	pubkey := strings.TrimSpace(value)
+	if strings.HasPrefix(strings.ToLower(pubkey), PublicKeyPrefix+"1") {
+		prefix, decoded, err := DecodeKey(pubkey)
+		if err != nil {
+			return "", err
+		}
+		if prefix != PublicKeyPrefix {
+			return "", errors.New("not a public key")
+		}
+		pubkey = decoded
+	}
+
+	pubkey = strings.ToLower(pubkey)
+	if !IsValidPublicKey(pubkey) {
+		return "", errors.New("invalid public key")
+	}
+
+	return pubkey, nil
+}
+
+func encodeKey(prefix, key string) (string, error) {
+	b, err := hex.DecodeString(key)
+	if err != nil || len(b) != 32 {
+		return "", errors.New("key must be 32 bytes of hex")
+	}
+
+	data, err := convertBits(b, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32Encode(prefix, data), nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+func bech32Encode(hrp string, data []byte) string {
+	values := append(bech32HRPExpand(hrp), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ 1
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		sb.WriteByte(bech32Charset[d])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+func bech32Decode(encoded string) (string, []byte, error) {
+	if strings.ToLower(encoded) != encoded && strings.ToUpper(encoded) != encoded {
+		return "", nil, errors.New("mixed case bech32 string")
+	}
+	encoded = strings.ToLower(encoded)
+
+	separator := strings.LastIndexByte(encoded, '1')
+	if separator < 1 || separator+7 > len(encoded) {
+		return "", nil, errors.New("invalid bech32 string")
+	}
+
+	hrp := encoded[:separator]
+	data := make([]byte, 0, len(encoded)-separator-1)
+	for _, c := range encoded[separator+1:] {
+		i := strings.IndexRune(bech32Charset, c)
+		if i < 0 {
+			return "", nil, errors.Errorf("invalid bech32 character %q", c)
+		}
+		data = append(data, byte(i))
+	}
+
+	if bech32Polymod(append(bech32HRPExpand(hrp), data...)) != 1 {
+		return "", nil, errors.New("invalid bech32 checksum")
+	}
+
+	return hrp, data[:len(data)-6], nil
+}
+
+// convertBits regroups a byte slice from one bit width to another.
+func convertBits(data []byte, from, to uint, pad bool) ([]byte, error) {
+	acc := uint32(0)
+	bits := uint(0)
+	maxv := uint32(1)<<to - 1
+	out := make([]byte, 0, len(data)*int(from)/int(to)+1)
+
+	for _, value := range data {
+		if uint32(value)>>from != 0 {
+			return nil, errors.New("invalid data range")
+		}
+		acc = acc<<from | uint32(value)
+		bits += from
+		for bits >= to {
+			bits -= to
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(to-bits)&maxv))
+		}
+	} else if bits >= from || acc<<(to-bits)&maxv != 0 {
+		return nil, errors.New("invalid padding")
+	}
+
+	return out, nil
+}
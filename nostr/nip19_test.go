@@ -0,0 +1,80 @@
+package nostr
+
+import "testing"
+
+// Test vectors from NIP-19.
+const (
+	nip19PublicKey  = "7e7e9c42a91bfef19fa929e5fda1b72e0ebc1a4c1141673e2794234d86addf4e"
+	nip19Npub       = "npub10elfcs4fr0l0r8af98jlmgdh9c8tcxjvz9qkw038js35mp4dma8qzvjptg"
+	nip19PrivateKey = "67dea2ed018072d675f5415ecfaed7d2597555e202d85b3d65ea4e58d2d92ffa"
+	nip19Nsec       = "nsec1vl029mgpspedva04g90vltkh6fvh240zqtv9k0t9af8935ke9laqsnlfe5"
+)
+
+func TestEncodeKeys(t *testing.T) {
+	npub, err := EncodePublicKey(nip19PublicKey)
+	if err != nil || npub != nip19Npub {
+		t.Errorf("EncodePublicKey() = %s, %v", npub, err)
+	}
+
+	nsec, err := EncodePrivateKey(nip19PrivateKey)
+	if err != nil || nsec != nip19Nsec {
+		t.Errorf("EncodePrivateKey() = %s, %v", nsec, err)
+	}
+
+	if _, err := EncodePublicKey("abc"); err == nil {
+		t.Error("expected an error encoding an invalid key")
+	}
+}
+
+func TestDecodeKey(t *testing.T) {
+	tests := []struct {
+		encoded string
+		prefix  string
+		key     string
+		wantErr bool
+	}{
+		{nip19Npub, PublicKeyPrefix, nip19PublicKey, false},
+		{nip19Nsec, PrivateKeyPrefix, nip19PrivateKey, false},
+		{"NPUB10ELFCS4FR0L0R8AF98JLMGDH9C8TCXJVZ9QKW038JS35MP4DMA8QZVJPTG", PublicKeyPrefix, nip19PublicKey, false},
+		{nip19Npub[:len(nip19Npub)-1] + "q", "", "", true},
+		{"npub1", "", "", true},
+		{nip19PublicKey, "", "", true},
+	}
+
+	for _, tt := range tests {
+		prefix, key, err := DecodeKey(tt.encoded)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("DecodeKey(%s) error = %v", tt.encoded, err)
+			continue
+		}
+		if prefix != tt.prefix || key != tt.key {
+			t.Errorf("DecodeKey(%s) = %s, %s", tt.encoded, prefix, key)
+		}
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{nip19Npub, false},
+		{" " + nip19Npub + " ", false},
+		{nip19PublicKey, false},
+		{"7E7E9C42A91BFEF19FA929E5FDA1B72E0EBC1A4C1141673E2794234D86ADDF4E", false},
+		{nip19Nsec, true},
+		{nip19PublicKey[:62], true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		pubkey, err := ParsePublicKey(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePublicKey(%q) error = %v", tt.value, err)
+			continue
+		}
+		if !tt.wantErr && pubkey != nip19PublicKey {
+			t.Errorf("ParsePublicKey(%q) = %s", tt.value, pubkey)
+		}
+	}
+}
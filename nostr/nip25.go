@@ -0,0 +1,4 @@
+package nostr
+
+// KindReaction is the NIP-25 reaction event kind.
+const KindReaction = 7
@@ -0,0 +1,76 @@
+package nostr
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// KindClientAuth is the NIP-42 event kind clients sign to authenticate to
+// a relay.
+const KindClientAuth = 22242
+
+// DefaultClientAuthWindow is how far the created_at of an auth event may
+// drift from the relay clock.
+const DefaultClientAuthWindow = 10 * time.Minute
+
+// ClientAuthEvent returns an unsigned NIP-42 event answering the relay's
+// challenge.
+func ClientAuthEvent(relayURL, challenge string) *Event {
+	return &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindClientAuth,
+		Tags: Tags{
+			{"relay", relayURL},
+			{"challenge", challenge},
+		},
+	}
+}
+
+// VerifyClientAuth validates a NIP-42 auth event against the challenge the
+// relay sent and the URLs it can be reached at.
+func VerifyClientAuth(event *Event, challenge string, relayURLs []string) error {
+	if event.Kind != KindClientAuth {
+		return errors.New("auth event has the wrong kind")
+	}
+
+	now := time.Now()
+	createdAt := event.CreatedAtTime()
+	if createdAt.Before(now.Add(-DefaultClientAuthWindow)) || createdAt.After(now.Add(DefaultClientAuthWindow)) {
+		return errors.New("auth event is outside the allowed time window")
+	}
+
+	if challenge == "" || event.Tags.Value("challenge") != challenge {
+		return errors.New("auth event does not answer the challenge")
+	}
+
+	if !relayURLMatches(event.Tags.Value("relay"), relayURLs) {
+		return errors.New("auth event is for a different relay")
+	}
+
+	return event.Verify()
+}
+
+// relayURLMatches compares relay URLs by host and path only, since clients
+// disagree on schemes and trailing slashes.
+func relayURLMatches(signed string, urls []string) bool {
+	normalize := func(u string) string {
+		u = strings.ToLower(strings.TrimSpace(u))
+		if i := strings.Index(u, "://"); i >= 0 {
+			u = u[i+3:]
+		}
+		return strings.TrimSuffix(u, "/")
+	}
+
+	signed = normalize(signed)
+	if signed == "" {
+		return false
+	}
+	for _, u := range urls {
+		if u != "" && normalize(u) == signed {
+			return true
+		}
+	}
+	return false
+}
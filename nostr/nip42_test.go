@@ -0,0 +1,43 @@
+package nostr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVerifyClientAuth(t *testing.T) {
+	privateKey, _ := GeneratePrivateKey()
+	urls := []string{"wss://example.com/nostr"}
+
+	event := ClientAuthEvent("wss://example.com/nostr/", "challenge")
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyClientAuth(event, "challenge", urls); err != nil {
+		t.Errorf("expected the auth event to verify, got %v", err)
+	}
+	if err := VerifyClientAuth(event, "another challenge", urls); err == nil {
+		t.Error("expected a different challenge to be rejected")
+	}
+	if err := VerifyClientAuth(event, "challenge", []string{"wss://other.example.com/nostr"}); err == nil {
+		t.Error("expected a different relay to be rejected")
+	}
+
+	old := ClientAuthEvent("wss://example.com/nostr", "challenge")
+	old.CreatedAt = time.Now().Add(-time.Hour).Unix()
+	if err := old.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyClientAuth(old, "challenge", urls); err == nil {
+		t.Error("expected an old auth event to be rejected")
+	}
+
+	tampered := ClientAuthEvent("wss://example.com/nostr", "challenge")
+	if err := tampered.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	tampered.Tags[1][1] = "forged"
+	if err := VerifyClientAuth(tampered, "forged", urls); err == nil {
+		t.Error("expected a tampered auth event to be rejected")
+	}
+}
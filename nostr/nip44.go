@@ -0,0 +1,166 @@
+package nostr
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/hkdf"
+)
+
+// NIP-44 version 2 payload layout and limits.
+const (
+	nip44Version       = 2
+	nip44NonceLength   = 32
+	nip44MACLength     = 32
+	nip44MinPlaintext  = 1
+	nip44MaxPlaintext  = 65535
+	nip44MinPadded     = 32
+	nip44MinPayloadLen = 1 + nip44NonceLength + 2 + nip44MinPadded + nip44MACLength
+)
+
+var nip44Salt = []byte("nip44-v2")
+
+// ConversationKey returns the NIP-44 conversation key shared between the
+// private key and the public key. It is the same from both sides.
+func ConversationKey(privateKey, pubkey string) ([]byte, error) {
+	shared, err := sharedSecret(privateKey, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return hkdf.Extract(sha256.New, shared, nip44Salt), nil
+}
+
+// EncryptNIP44 encrypts the plaintext with a NIP-44 version 2 conversation key.
+func EncryptNIP44(conversationKey []byte, plaintext string) (string, error) {
+	nonce := make([]byte, nip44NonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return encryptNIP44(conversationKey, nonce, plaintext)
+}
+
+// DecryptNIP44 decrypts a NIP-44 version 2 payload.
+func DecryptNIP44(conversationKey []byte, payload string) (string, error) {
+	if payload == "" || payload[0] == '#' {
+		return "", errors.New("unsupported encryption version")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", errors.Wrap(err, "encrypted content is not valid base64")
+	}
+	if len(data) < nip44MinPayloadLen {
+		return "", errors.New("encrypted content is too short")
+	}
+	if data[0] != nip44Version {
+		return "", errors.Errorf("unsupported encryption version %d", data[0])
+	}
+
+	nonce := data[1 : 1+nip44NonceLength]
+	ciphertext := data[1+nip44NonceLength : len(data)-nip44MACLength]
+	mac := data[len(data)-nip44MACLength:]
+
+	chachaKey, chachaNonce, hmacKey, err := nip44MessageKeys(conversationKey, nonce)
+	if err != nil {
+		return "", err
+	}
+
+	if !hmac.Equal(mac, nip44MAC(hmacKey, nonce, ciphertext)) {
+		return "", errors.New("invalid mac")
+	}
+
+	padded := make([]byte, len(ciphertext))
+	cipher, err := chacha20.NewUnauthenticatedCipher(chachaKey, chachaNonce)
+	if err != nil {
+		return "", err
+	}
+	cipher.XORKeyStream(padded, ciphertext)
+
+	length := int(binary.BigEndian.Uint16(padded[:2]))
+	if length < nip44MinPlaintext || 2+length > len(padded) || len(padded) != 2+nip44PaddedLength(length) {
+		return "", errors.New("invalid padding")
+	}
+
+	return string(padded[2 : 2+length]), nil
+}
+
+func encryptNIP44(conversationKey, nonce []byte, plaintext string) (string, error) {
+	if len(plaintext) < nip44MinPlaintext || len(plaintext) > nip44MaxPlaintext {
+		return "", errors.New("plaintext length must be between 1 and 65535 bytes")
+	}
+
+	chachaKey, chachaNonce, hmacKey, err := nip44MessageKeys(conversationKey, nonce)
+	if err != nil {
+		return "", err
+	}
+
+	padded := make([]byte, 2+nip44PaddedLength(len(plaintext)))
+	binary.BigEndian.PutUint16(padded, uint16(len(plaintext)))
+	copy(padded[2:], plaintext)
+
+	cipher, err := chacha20.NewUnauthenticatedCipher(chachaKey, chachaNonce)
+	if err != nil {
+		return "", err
+	}
+	ciphertext := make([]byte, len(padded))
+	cipher.XORKeyStream(ciphertext, padded)
+
+	payload := make([]byte, 0, 1+len(nonce)+len(ciphertext)+nip44MACLength)
+	payload = append(payload, nip44Version)
+	payload = append(payload, nonce...)
+	payload = append(payload, ciphertext...)
+	payload = append(payload, nip44MAC(hmacKey, nonce, ciphertext)...)
+
+	return base64.StdEncoding.EncodeToString(payload), nil
+}
+
+func nip44MessageKeys(conversationKey, nonce []byte) (chachaKey, chachaNonce, hmacKey []byte, err error) {
+	if len(conversationKey) != 32 {
+		return nil, nil, nil, errors.New("invalid conversation key")
+	}
+	if len(nonce) != nip44NonceLength {
+		return nil, nil, nil, errors.New("invalid nonce")
+	}
+
+	keys := make([]byte, 76)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, conversationKey, nonce), keys); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return keys[:32], keys[32:44], keys[44:], nil
+}
+
+func nip44MAC(hmacKey, nonce, ciphertext []byte) []byte {
+	h := hmac.New(sha256.New, hmacKey)
+	h.Write(nonce)
+	h.Write(ciphertext)
+	return h.Sum(nil)
+}
+
+// nip44PaddedLength rounds the plaintext length up so messages of similar
+// size can not be told apart.
+func nip44PaddedLength(length int) int {
+	if length <= nip44MinPadded {
+		return nip44MinPadded
+	}
+
+	nextPower := 1
+	for nextPower < length {
+		nextPower <<= 1
+	}
+
+	chunk := 32
+	if nextPower > 256 {
+		chunk = nextPower / 8
+	}
+
+	return chunk * ((length-1)/chunk + 1)
+}
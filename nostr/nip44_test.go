@@ -0,0 +1,78 @@
+package nostr
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestNIP44Vectors(t *testing.T) {
+	// From the NIP-44 test vectors.
+	secret1 := strings.Repeat("0", 63) + "1"
+	secret2 := strings.Repeat("0", 63) + "2"
+	pubkey2, _ := GetPublicKey(secret2)
+
+	conversationKey, err := ConversationKey(secret1, pubkey2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(conversationKey) != "c41c775356fd92eadc63ff5a0dc1da211b268cbea22316767095b2871ea1412d" {
+		t.Errorf("conversation key = %x", conversationKey)
+	}
+
+	nonce, _ := hex.DecodeString(strings.Repeat("0", 63) + "1")
+	payload, err := encryptNIP44(conversationKey, nonce, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "AgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABee0G5VSK0/9YypIObAtDKfYEAjD35uVkHyB0F4DwrcNaCXlCWZKaArsGrY6M9wnuTMxWfp1RTN9Xga8no+kF5Vsb"
+	if payload != expected {
+		t.Errorf("payload = %s", payload)
+	}
+
+	plaintext, err := DecryptNIP44(conversationKey, expected)
+	if err != nil || plaintext != "a" {
+		t.Errorf("decrypted %q, %v", plaintext, err)
+	}
+}
+
+func TestNIP44PaddedLength(t *testing.T) {
+	tests := [][2]int{{16, 32}, {32, 32}, {33, 64}, {64, 64}, {65, 96}, {100, 128}, {200, 224}, {320, 320}, {384, 384}, {400, 448}, {515, 640}, {900, 1024}, {65535, 65536}}
+	for _, test := range tests {
+		if padded := nip44PaddedLength(test[0]); padded != test[1] {
+			t.Errorf("nip44PaddedLength(%d) = %d, want %d", test[0], padded, test[1])
+		}
+	}
+}
+
+func TestNIP44RoundTrip(t *testing.T) {
+	alice := mustGenerateKey(t)
+	bob := mustGenerateKey(t)
+	alicePubkey, _ := GetPublicKey(alice)
+	bobPubkey, _ := GetPublicKey(bob)
+
+	sending, _ := ConversationKey(alice, bobPubkey)
+	receiving, _ := ConversationKey(bob, alicePubkey)
+
+	for _, plaintext := range []string{"a", "The stream is live!", strings.Repeat("🎉", 500)} {
+		payload, err := EncryptNIP44(sending, plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decrypted, err := DecryptNIP44(receiving, payload)
+		if err != nil || decrypted != plaintext {
+			t.Errorf("decrypted %q, %v", decrypted, err)
+		}
+	}
+
+	if _, err := EncryptNIP44(sending, ""); err == nil {
+		t.Error("empty plaintext was encrypted")
+	}
+
+	payload, _ := EncryptNIP44(sending, "hello")
+	tampered := []byte(payload)
+	tampered[10] ^= 1
+	if _, err := DecryptNIP44(receiving, string(tampered)); err == nil {
+		t.Error("tampered payload was decrypted")
+	}
+}
@@ -0,0 +1,97 @@
+package nostr
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NIP-47 Nostr Wallet Connect event kinds.
+const (
+	KindWalletInfo     = 13194
+	KindWalletRequest  = 23194
+	KindWalletResponse = 23195
+)
+
+// WalletConnectScheme is the URI scheme of Nostr Wallet Connect connection strings.
+const WalletConnectScheme = "nostr+walletconnect"
+
+// WalletConnection is a parsed nostr+walletconnect:// connection URI.
+type WalletConnection struct {
+	// WalletPubkey is the key of the wallet service requests are sent to.
+	WalletPubkey string
+
+	// Secret is the private key requests are signed and encrypted with.
+	Secret string
+
+	// LUD16 is the wallet's lightning address, if it has one.
+	LUD16 string
+
+	Relays []string
+}
+
+// ParseWalletConnectURI parses a NIP-47 connection URI of the form
+// nostr+walletconnect://<wallet pubkey>?relay=<url>&secret=<hex key>.
+func ParseWalletConnectURI(uri string) (*WalletConnection, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid wallet connect uri")
+	}
+
+	// Some wallets hand out nostrwalletconnect:// URIs.
+	if u.Scheme != WalletConnectScheme && u.Scheme != "nostrwalletconnect" {
+		return nil, errors.New("wallet connect uri must start with " + WalletConnectScheme + "://")
+	}
+
+	wallet := u.Host
+	if wallet == "" {
+		wallet = strings.TrimPrefix(u.Opaque, "//")
+	}
+	wallet = strings.ToLower(wallet)
+	if !IsValidPublicKey(wallet) {
+		return nil, errors.New("wallet connect uri has an invalid wallet pubkey")
+	}
+
+	query := u.Query()
+
+	relays := []string{}
+	for _, relay := range query["relay"] {
+		if relay = strings.TrimSpace(relay); relay != "" {
+			relays = append(relays, relay)
+		}
+	}
+	if len(relays) == 0 {
+		return nil, errors.New("wallet connect uri has no relay")
+	}
+
+	secret := strings.ToLower(query.Get("secret"))
+	if !IsValidPrivateKey(secret) {
+		return nil, errors.New("wallet connect uri has an invalid secret")
+	}
+
+	return &WalletConnection{
+		WalletPubkey: wallet,
+		Secret:       secret,
+		LUD16:        query.Get("lud16"),
+		Relays:       relays,
+	}, nil
+}
+
+// URI returns the connection as a nostr+walletconnect:// URI.
+func (c WalletConnection) URI() string {
+	query := url.Values{}
+	query["relay"] = c.Relays
+	query.Set("secret", c.Secret)
+	if c.LUD16 != "" {
+		query.Set("lud16", c.LUD16)
+	}
+
+	return WalletConnectScheme + "://" + c.WalletPubkey + "?" + query.Encode()
+}
+
+// ClientPubkey returns the public key of the connection secret.
+func (c WalletConnection) ClientPubkey() string {
+	pubkey, _ := GetPublicKey(c.Secret)
+	return pubkey
+}
@@ -0,0 +1,46 @@
+package nostr
+
+import "testing"
+
+func TestParseWalletConnectURI(t *testing.T) {
+	secret := mustGenerateKey(t)
+	wallet, _ := GetPublicKey(mustGenerateKey(t))
+
+	tests := []struct {
+		name  string
+		uri   string
+		valid bool
+	}{
+		{"valid", "nostr+walletconnect://" + wallet + "?relay=wss%3A%2F%2Frelay.one&secret=" + secret, true},
+		{"legacy scheme", "nostrwalletconnect://" + wallet + "?relay=wss://relay.one&secret=" + secret, true},
+		{"several relays", "nostr+walletconnect://" + wallet + "?relay=wss://relay.one&relay=wss://relay.two&secret=" + secret + "&lud16=me@example.com", true},
+		{"wrong scheme", "nostr://" + wallet + "?relay=wss://relay.one&secret=" + secret, false},
+		{"invalid wallet", "nostr+walletconnect://abc?relay=wss://relay.one&secret=" + secret, false},
+		{"no relay", "nostr+walletconnect://" + wallet + "?secret=" + secret, false},
+		{"no secret", "nostr+walletconnect://" + wallet + "?relay=wss://relay.one", false},
+	}
+
+	for _, test := range tests {
+		conn, err := ParseWalletConnectURI(test.uri)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got error %v", test.name, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		if conn.WalletPubkey != wallet || conn.Secret != secret {
+			t.Errorf("%s: parsed %+v", test.name, conn)
+		}
+
+		// The URI survives a round trip.
+		reparsed, err := ParseWalletConnectURI(conn.URI())
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if reparsed.WalletPubkey != conn.WalletPubkey || len(reparsed.Relays) != len(conn.Relays) || reparsed.LUD16 != conn.LUD16 {
+			t.Errorf("%s: round trip changed the connection: %+v", test.name, reparsed)
+		}
+	}
+}
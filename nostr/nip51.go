@@ -0,0 +1,48 @@
+package nostr
+
+import (
+	"slices"
+	"strings"
+)
+
+// KindMuteList is the NIP-51 mute list kind.
+const KindMuteList = 10000
+
+// MuteList is the public part of a NIP-51 mute list. Private entries are
+// encrypted to the author and can only be read with their key.
+type MuteList struct {
+	Pubkeys []string
+	Words   []string
+}
+
+// ParseMuteList returns the muted pubkeys and words of a mute list event.
+// Words are lower cased as they are matched without regard to case.
+func ParseMuteList(event *Event) MuteList {
+	list := MuteList{Pubkeys: []string{}, Words: []string{}}
+
+	for _, tag := range event.Tags.GetAll("p") {
+		if pubkey := strings.ToLower(tag.Value()); IsValidPublicKey(pubkey) && !slices.Contains(list.Pubkeys, pubkey) {
+			list.Pubkeys = append(list.Pubkeys, pubkey)
+		}
+	}
+
+	for _, tag := range event.Tags.GetAll("word") {
+		if word := strings.ToLower(strings.TrimSpace(tag.Value())); word != "" && !slices.Contains(list.Words, word) {
+			list.Words = append(list.Words, word)
+		}
+	}
+
+	return list
+}
+
+// ContainsMutedWord returns the first of the muted words found in the text.
+func (l MuteList) ContainsMutedWord(text string) (string, bool) {
+	text = strings.ToLower(text)
+	for _, word := range l.Words {
+		if strings.Contains(text, word) {
+			return word, true
+		}
+	}
+
+	return "", false
+}
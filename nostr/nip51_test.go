@@ -0,0 +1,39 @@
+package nostr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMuteList(t *testing.T) {
+	pubkey := "3bf0c63fcb93463407af97a5e5ee64fa883d107ef9e558472c4eb9aaaefa459d"
+	event := &Event{
+		Kind: KindMuteList,
+		Tags: Tags{
+			{"p", pubkey},
+			{"p", "3BF0C63FCB93463407AF97A5E5EE64FA883D107EF9E558472C4EB9AAAEFA459D"},
+			{"p", "not a pubkey"},
+			{"t", "hashtag"},
+			{"word", " Spam "},
+			{"word", "spam"},
+			{"word", ""},
+			{"e", "0000000000000000000000000000000000000000000000000000000000000000"},
+		},
+		Content: "encrypted private entries",
+	}
+
+	list := ParseMuteList(event)
+	if !reflect.DeepEqual(list.Pubkeys, []string{pubkey}) {
+		t.Errorf("pubkeys = %v", list.Pubkeys)
+	}
+	if !reflect.DeepEqual(list.Words, []string{"spam"}) {
+		t.Errorf("words = %v", list.Words)
+	}
+
+	if word, ok := list.ContainsMutedWord("Buy cheap SPAMMY things"); !ok || word != "spam" {
+		t.Errorf("expected the muted word to be found, got %q %v", word, ok)
+	}
+	if _, ok := list.ContainsMutedWord("hello"); ok {
+		t.Error("expected no muted word")
+	}
+}
@@ -0,0 +1,97 @@
+package nostr
+
+import (
+	"strconv"
+	"time"
+)
+
+// KindLiveEvent is the NIP-53 live activity event kind.
+const KindLiveEvent = 30311
+
+// KindLiveChatMessage is the NIP-53 live chat message event kind.
+const KindLiveChatMessage = 1311
+
+// Live activity statuses.
+const (
+	LiveStatusPlanned = "planned"
+	LiveStatusLive    = "live"
+	LiveStatusEnded   = "ended"
+)
+
+// LiveActivity describes a NIP-53 live stream.
+type LiveActivity struct {
+	Starts              time.Time
+	Ends                time.Time
+	Identifier          string
+	Title               string
+	Summary             string
+	Image               string
+	StreamingURL        string
+	Status              string
+	HostPubkey          string
+	Hashtags            []string
+	Relays              []string
+	CurrentParticipants int
+	TotalParticipants   int
+}
+
+// Event returns the unsigned kind 30311 event for the live activity.
+func (a LiveActivity) Event() *Event {
+	tags := Tags{{"d", a.Identifier}}
+
+	if a.Title != "" {
+		tags = append(tags, Tag{"title", a.Title})
+	}
+	if a.Summary != "" {
+		tags = append(tags, Tag{"summary", a.Summary})
+	}
+	if a.Image != "" {
+		tags = append(tags, Tag{"image", a.Image})
+	}
+	if a.StreamingURL != "" {
+		tags = append(tags, Tag{"streaming", a.StreamingURL})
+	}
+	if !a.Starts.IsZero() {
+		tags = append(tags, Tag{"starts", strconv.FormatInt(a.Starts.Unix(), 10)})
+	}
+	if !a.Ends.IsZero() {
+		tags = append(tags, Tag{"ends", strconv.FormatInt(a.Ends.Unix(), 10)})
+	}
+	tags = append(tags,
+		Tag{"status", a.Status},
+		Tag{"current_participants", strconv.Itoa(a.CurrentParticipants)},
+		Tag{"total_participants", strconv.Itoa(a.TotalParticipants)},
+	)
+	for _, hashtag := range a.Hashtags {
+		tags = append(tags, Tag{"t", hashtag})
+	}
+	if a.HostPubkey != "" {
+		tags = append(tags, Tag{"p", a.HostPubkey, "", "Host"})
+	}
+	if len(a.Relays) > 0 {
+		tags = append(tags, append(Tag{"relays"}, a.Relays...))
+	}
+
+	return &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindLiveEvent,
+		Tags:      tags,
+	}
+}
+
+// Address returns the NIP-33 "a" tag coordinate for the live activity
+// published by the given pubkey.
+func (a LiveActivity) Address(pubkey string) string {
+	return strconv.Itoa(KindLiveEvent) + ":" + pubkey + ":" + a.Identifier
+}
+
+// LiveChatMessage returns an unsigned kind 1311 chat message for the live
+// activity with the given address.
+func LiveChatMessage(activityAddress, content string) *Event {
+	return &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindLiveChatMessage,
+		Tags:      Tags{{"a", activityAddress, "", "root"}},
+		Content:   content,
+	}
+}
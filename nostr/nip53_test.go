@@ -0,0 +1,58 @@
+package nostr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLiveActivityEvent(t *testing.T) {
+	activity := LiveActivity{
+		Identifier:          "oni-1",
+		Title:               "my stream",
+		StreamingURL:        "https://example.com/hls/stream.m3u8",
+		Status:              LiveStatusLive,
+		Starts:              time.Unix(1700000000, 0),
+		HostPubkey:          "abc",
+		Hashtags:            []string{"music", "live"},
+		Relays:              []string{"wss://relay.one", "wss://relay.two"},
+		CurrentParticipants: 3,
+		TotalParticipants:   7,
+	}
+
+	event := activity.Event()
+	if event.Kind != KindLiveEvent {
+		t.Errorf("kind = %d, want %d", event.Kind, KindLiveEvent)
+	}
+
+	expected := map[string]string{
+		"d":                    "oni-1",
+		"title":                "my stream",
+		"streaming":            "https://example.com/hls/stream.m3u8",
+		"status":               "live",
+		"starts":               "1700000000",
+		"current_participants": "3",
+		"total_participants":   "7",
+		"p":                    "abc",
+	}
+	for name, want := range expected {
+		if got := event.Tags.Value(name); got != want {
+			t.Errorf("tag %s = %q, want %q", name, got, want)
+		}
+	}
+
+	if event.Tags.GetFirst("summary") != nil {
+		t.Error("empty summary should not be tagged")
+	}
+
+	if len(event.Tags.GetAll("t")) != 2 {
+		t.Error("expected a t tag per hashtag")
+	}
+
+	if relays := event.Tags.GetFirst("relays"); len(relays) != 3 {
+		t.Errorf("relays tag = %v", relays)
+	}
+
+	if got := activity.Address("abc"); got != "30311:abc:oni-1" {
+		t.Errorf("Address() = %s", got)
+	}
+}
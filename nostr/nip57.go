@@ -0,0 +1,192 @@
+package nostr
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NIP-57 Lightning zap event kinds.
+const (
+	KindZapRequest = 9734
+	KindZapReceipt = 9735
+)
+
+// Zap is a validated NIP-57 zap receipt.
+type Zap struct {
+	PaidAt     time.Time
+	Receipt    *Event
+	Request    *Event
+	Invoice    *Invoice
+	Sender     string
+	Recipient  string
+	EventID    string
+	Address    string
+	Comment    string
+	AmountMsat int64
+}
+
+// ValidateZapRequest checks a zap request sent to an LNURL-pay callback as
+// described in NIP-57 appendix D.
+func ValidateZapRequest(request *Event, amountMsat int64) error {
+	if request.Kind != KindZapRequest {
+		return errors.New("zap request has the wrong kind")
+	}
+
+	if err := request.Verify(); err != nil {
+		return errors.Wrap(err, "invalid zap request")
+	}
+
+	if len(request.Tags) == 0 {
+		return errors.New("zap request has no tags")
+	}
+
+	recipients := request.Tags.GetAll("p")
+	if len(recipients) != 1 || !IsValidPublicKey(recipients[0].Value()) {
+		return errors.New("zap request must have exactly one recipient")
+	}
+
+	if events := request.Tags.GetAll("e"); len(events) > 1 {
+		return errors.New("zap request may reference at most one event")
+	}
+
+	if relays := request.Tags.GetFirst("relays"); len(relays) < 2 {
+		return errors.New("zap request has no relays")
+	}
+
+	if amount := request.Tags.Value("amount"); amount != "" && amount != strconv.FormatInt(amountMsat, 10) {
+		return errors.New("zap request amount does not match the requested amount")
+	}
+
+	if address := request.Tags.Value("a"); address != "" && !isValidAddress(address) {
+		return errors.New("zap request has an invalid event coordinate")
+	}
+
+	return nil
+}
+
+// ParseZapReceipt validates a zap receipt as described in NIP-57 appendix F
+// and returns the zap it describes. providerPubkey is the nostrPubkey the
+// recipient's LNURL-pay server announced, the only key allowed to sign
+// receipts for them.
+func ParseZapReceipt(receipt *Event, providerPubkey string) (*Zap, error) {
+	if receipt.Kind != KindZapReceipt {
+		return nil, errors.New("zap receipt has the wrong kind")
+	}
+
+	if providerPubkey == "" || receipt.PubKey != providerPubkey {
+		return nil, errors.New("zap receipt was not signed by the recipient's lightning provider")
+	}
+
+	if err := receipt.Verify(); err != nil {
+		return nil, errors.Wrap(err, "invalid zap receipt")
+	}
+
+	invoice, err := DecodeInvoice(receipt.Tags.Value("bolt11"))
+	if err != nil {
+		return nil, errors.Wrap(err, "zap receipt has an invalid invoice")
+	}
+
+	description := receipt.Tags.Value("description")
+	request := &Event{}
+	if err := json.Unmarshal([]byte(description), request); err != nil {
+		return nil, errors.Wrap(err, "zap receipt description is not a zap request")
+	}
+
+	if err := ValidateZapRequest(request, invoice.AmountMsat); err != nil {
+		return nil, err
+	}
+
+	// The invoice must commit to the zap request it was created for.
+	if invoice.DescriptionHash != "" {
+		hash := sha256.Sum256([]byte(description))
+		if !strings.EqualFold(invoice.DescriptionHash, hex.EncodeToString(hash[:])) {
+			return nil, errors.New("zap receipt invoice does not match the zap request")
+		}
+	} else if invoice.Description != description {
+		return nil, errors.New("zap receipt invoice does not match the zap request")
+	}
+
+	if invoice.AmountMsat <= 0 {
+		return nil, errors.New("zap receipt invoice has no amount")
+	}
+
+	zap := &Zap{
+		PaidAt:     receipt.CreatedAtTime(),
+		Receipt:    receipt,
+		Request:    request,
+		Invoice:    invoice,
+		Sender:     request.PubKey,
+		Recipient:  request.Tags.Value("p"),
+		EventID:    request.Tags.Value("e"),
+		Address:    request.Tags.Value("a"),
+		Comment:    request.Content,
+		AmountMsat: invoice.AmountMsat,
+	}
+
+	if receipt.Tags.Value("p") != zap.Recipient {
+		return nil, errors.New("zap receipt recipient does not match the zap request")
+	}
+	if receipt.Tags.Value("e") != zap.EventID || receipt.Tags.Value("a") != zap.Address {
+		return nil, errors.New("zap receipt does not reference the zapped event")
+	}
+
+	return zap, nil
+}
+
+// ZapReceipt returns the unsigned kind 9735 receipt an LNURL-pay server
+// publishes once the invoice created for the zap request has been paid.
+// description must be the zap request exactly as the invoice committed to it.
+func ZapReceipt(description, bolt11, preimage string, paidAt time.Time) (*Event, error) {
+	request := &Event{}
+	if err := json.Unmarshal([]byte(description), request); err != nil {
+		return nil, errors.Wrap(err, "description is not a zap request")
+	}
+
+	tags := Tags{{"p", request.Tags.Value("p")}}
+	if eventID := request.Tags.Value("e"); eventID != "" {
+		tags = append(tags, Tag{"e", eventID})
+	}
+	if address := request.Tags.Value("a"); address != "" {
+		tags = append(tags, Tag{"a", address})
+	}
+	tags = append(tags,
+		Tag{"P", request.PubKey},
+		Tag{"bolt11", bolt11},
+		Tag{"description", description},
+	)
+	if preimage != "" {
+		tags = append(tags, Tag{"preimage", preimage})
+	}
+
+	return &Event{
+		CreatedAt: paidAt.Unix(),
+		Kind:      KindZapReceipt,
+		Tags:      tags,
+	}, nil
+}
+
+// AddressIdentifier returns the "d" identifier part of a NIP-33 coordinate.
+func AddressIdentifier(address string) string {
+	parts := strings.SplitN(address, ":", 3)
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[2]
+}
+
+func isValidAddress(address string) bool {
+	parts := strings.SplitN(address, ":", 3)
+	if len(parts) != 3 {
+		return false
+	}
+	if _, err := strconv.Atoi(parts[0]); err != nil {
+		return false
+	}
+	return IsValidPublicKey(parts[1])
+}
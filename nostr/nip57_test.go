@@ -0,0 +1,124 @@
+package nostr
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func signedZapRequest(t *testing.T, tags Tags) *Event {
+	t.Helper()
+
+	key, _ := GeneratePrivateKey()
+	request := &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindZapRequest,
+		Tags:      tags,
+		Content:   "great stream",
+	}
+	if err := request.Sign(key); err != nil {
+		t.Fatal(err)
+	}
+	return request
+}
+
+func TestValidateZapRequest(t *testing.T) {
+	recipient, _ := GetPublicKey(mustGenerateKey(t))
+	address := "30311:" + recipient + ":oni-1"
+
+	tests := []struct {
+		name  string
+		tags  Tags
+		valid bool
+	}{
+		{"valid", Tags{{"p", recipient}, {"a", address}, {"relays", "wss://relay.one"}, {"amount", "21000"}}, true},
+		{"no amount tag", Tags{{"p", recipient}, {"relays", "wss://relay.one"}}, true},
+		{"wrong amount", Tags{{"p", recipient}, {"relays", "wss://relay.one"}, {"amount", "1000"}}, false},
+		{"no recipient", Tags{{"relays", "wss://relay.one"}}, false},
+		{"two recipients", Tags{{"p", recipient}, {"p", recipient}, {"relays", "wss://relay.one"}}, false},
+		{"two events", Tags{{"p", recipient}, {"e", "a"}, {"e", "b"}, {"relays", "wss://relay.one"}}, false},
+		{"no relays", Tags{{"p", recipient}}, false},
+		{"invalid coordinate", Tags{{"p", recipient}, {"a", "oni-1"}, {"relays", "wss://relay.one"}}, false},
+	}
+
+	for _, test := range tests {
+		err := ValidateZapRequest(signedZapRequest(t, test.tags), 21000)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got error %v", test.name, err)
+		}
+	}
+
+	tampered := signedZapRequest(t, tests[0].tags)
+	tampered.Content = "tampered"
+	if ValidateZapRequest(tampered, 21000) == nil {
+		t.Error("tampered zap request was accepted")
+	}
+}
+
+func TestParseZapReceipt(t *testing.T) {
+	providerKey := mustGenerateKey(t)
+	provider, _ := GetPublicKey(providerKey)
+	recipient, _ := GetPublicKey(mustGenerateKey(t))
+	address := "30311:" + recipient + ":oni-1"
+
+	request := signedZapRequest(t, Tags{{"p", recipient}, {"a", address}, {"relays", "wss://relay.one"}, {"amount", "21000"}})
+	b, _ := json.Marshal(request)
+	description := string(b)
+
+	receiptFor := func(invoice Invoice) *Event {
+		receipt, err := ZapReceipt(description, invoice.Encode(), "", time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := receipt.Sign(providerKey); err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+
+	hash := sha256.Sum256([]byte(description))
+	valid := Invoice{
+		CreatedAt:       time.Now(),
+		AmountMsat:      21000,
+		DescriptionHash: hex.EncodeToString(hash[:]),
+	}
+
+	zap, err := ParseZapReceipt(receiptFor(valid), provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zap.Sender != request.PubKey || zap.Recipient != recipient || zap.Address != address {
+		t.Errorf("unexpected zap %+v", zap)
+	}
+	if zap.AmountMsat != 21000 || zap.Comment != "great stream" {
+		t.Errorf("amount = %d, comment = %q", zap.AmountMsat, zap.Comment)
+	}
+
+	if _, err := ParseZapReceipt(receiptFor(valid), recipient); err == nil {
+		t.Error("receipt signed by another key than the provider was accepted")
+	}
+
+	wrongAmount := valid
+	wrongAmount.AmountMsat = 1000
+	if _, err := ParseZapReceipt(receiptFor(wrongAmount), provider); err == nil {
+		t.Error("receipt for a different amount than requested was accepted")
+	}
+
+	wrongHash := valid
+	wrongHash.DescriptionHash = hex.EncodeToString(make([]byte, 32))
+	if _, err := ParseZapReceipt(receiptFor(wrongHash), provider); err == nil {
+		t.Error("receipt with an invoice for another zap request was accepted")
+	}
+}
+
+func mustGenerateKey(t *testing.T) string {
+	t.Helper()
+
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
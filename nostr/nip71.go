@@ -0,0 +1,122 @@
+package nostr
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NIP-71 video event kinds. Kind 34235 is the addressable video event
+// older clients still look for.
+const (
+	KindVideo            = 21
+	KindShortVideo       = 22
+	KindAddressableVideo = 34235
+)
+
+// VideoSource is one of the files a video can be played from.
+type VideoSource struct {
+	URL      string
+	MimeType string
+	Image    string
+	Width    int
+	Height   int
+}
+
+// Video describes a NIP-71 video, such as the recording of a live stream.
+type Video struct {
+	PublishedAt time.Time
+	// Identifier is the d tag of the addressable event.
+	Identifier string
+	Title      string
+	Summary    string
+	// LiveActivity is the address of the NIP-53 live activity the video
+	// is a recording of.
+	LiveActivity string
+	Hashtags     []string
+	Sources      []VideoSource
+	Duration     time.Duration
+}
+
+// Kind returns the NIP-71 kind for the video: short for portrait videos
+// and the normal video kind for everything else.
+func (v Video) Kind() int {
+	for _, source := range v.Sources {
+		if source.Width > 0 && source.Height > source.Width {
+			return KindShortVideo
+		}
+	}
+
+	return KindVideo
+}
+
+// Event returns the unsigned video event of the given kind. The d tag is
+// only added for the addressable kind.
+func (v Video) Event(kind int) *Event {
+	tags := Tags{}
+	if kind == KindAddressableVideo {
+		tags = append(tags, Tag{"d", v.Identifier})
+	}
+
+	tags = append(tags, Tag{"title", v.Title})
+	if !v.PublishedAt.IsZero() {
+		tags = append(tags, Tag{"published_at", strconv.FormatInt(v.PublishedAt.Unix(), 10)})
+	}
+	tags = append(tags, Tag{"alt", "Video: " + v.Title})
+	if v.Duration > 0 {
+		tags = append(tags, Tag{"duration", strconv.FormatInt(int64(v.Duration/time.Second), 10)})
+	}
+	for _, source := range v.Sources {
+		tags = append(tags, source.imeta())
+	}
+	for _, hashtag := range v.Hashtags {
+		tags = append(tags, Tag{"t", hashtag})
+	}
+	if v.LiveActivity != "" {
+		tags = append(tags, Tag{"a", v.LiveActivity})
+	}
+
+	return &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      kind,
+		Tags:      tags,
+		Content:   v.Summary,
+	}
+}
+
+// imeta returns the NIP-92 media tag describing the source.
+func (s VideoSource) imeta() Tag {
+	tag := Tag{"imeta", "url " + s.URL}
+	if s.MimeType != "" {
+		tag = append(tag, "m "+s.MimeType)
+	}
+	if s.Width > 0 && s.Height > 0 {
+		tag = append(tag, "dim "+strconv.Itoa(s.Width)+"x"+strconv.Itoa(s.Height))
+	}
+	if s.Image != "" {
+		tag = append(tag, "image "+s.Image)
+	}
+
+	return tag
+}
+
+// VideoMimeType guesses the mime type of a video from its URL.
+func VideoMimeType(url string) string {
+	path := strings.ToLower(url)
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	switch {
+	case strings.HasSuffix(path, ".m3u8"):
+		return "application/x-mpegURL"
+	case strings.HasSuffix(path, ".mp4"), strings.HasSuffix(path, ".m4v"):
+		return "video/mp4"
+	case strings.HasSuffix(path, ".webm"):
+		return "video/webm"
+	case strings.HasSuffix(path, ".mov"):
+		return "video/quicktime"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,83 @@
+package nostr
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestVideoEvent(t *testing.T) {
+	video := Video{
+		Identifier:   "oni-1700000000",
+		Title:        "my stream",
+		Summary:      "replay of the stream",
+		PublishedAt:  time.Unix(1700003600, 0),
+		LiveActivity: "30311:abc:oni-1700000000",
+		Hashtags:     []string{"music"},
+		Duration:     90 * time.Minute,
+		Sources: []VideoSource{
+			{URL: "https://example.com/vod/stream.m3u8", MimeType: "application/x-mpegURL", Image: "https://example.com/thumbnail.jpg", Width: 1920, Height: 1080},
+			{URL: "https://example.com/vod/stream.mp4", MimeType: "video/mp4"},
+		},
+	}
+
+	if video.Kind() != KindVideo {
+		t.Errorf("Kind() = %d, want %d", video.Kind(), KindVideo)
+	}
+
+	event := video.Event(video.Kind())
+	if event.Kind != KindVideo || event.Content != "replay of the stream" {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if event.Tags.GetFirst("d") != nil {
+		t.Error("regular video events should not have a d tag")
+	}
+
+	expected := map[string]string{
+		"title":        "my stream",
+		"published_at": "1700003600",
+		"duration":     "5400",
+		"a":            "30311:abc:oni-1700000000",
+		"t":            "music",
+	}
+	for name, want := range expected {
+		if got := event.Tags.Value(name); got != want {
+			t.Errorf("tag %s = %q, want %q", name, got, want)
+		}
+	}
+
+	imeta := event.Tags.GetAll("imeta")
+	if len(imeta) != 2 {
+		t.Fatalf("expected an imeta tag per source, got %d", len(imeta))
+	}
+	want := Tag{"imeta", "url https://example.com/vod/stream.m3u8", "m application/x-mpegURL", "dim 1920x1080", "image https://example.com/thumbnail.jpg"}
+	if !slices.Equal(imeta[0], want) {
+		t.Errorf("imeta = %v, want %v", imeta[0], want)
+	}
+
+	legacy := video.Event(KindAddressableVideo)
+	if legacy.Kind != KindAddressableVideo || legacy.Tags.Value("d") != "oni-1700000000" {
+		t.Errorf("unexpected addressable event %+v", legacy)
+	}
+}
+
+func TestVideoKind(t *testing.T) {
+	portrait := Video{Sources: []VideoSource{{URL: "https://example.com/a.mp4", Width: 1080, Height: 1920}}}
+	if portrait.Kind() != KindShortVideo {
+		t.Errorf("portrait video kind = %d, want %d", portrait.Kind(), KindShortVideo)
+	}
+}
+
+func TestVideoMimeType(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/vod/stream.m3u8":   "application/x-mpegURL",
+		"https://example.com/Stream.MP4?dl=1":   "video/mp4",
+		"https://example.com/recording.webm#t1": "video/webm",
+		"https://example.com/watch":             "",
+	}
+	for url, want := range tests {
+		if got := VideoMimeType(url); got != want {
+			t.Errorf("VideoMimeType(%s) = %q, want %q", url, got, want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package nostr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// KindHTTPAuth is the NIP-98 HTTP auth event kind.
+const KindHTTPAuth = 27235
+
+// HTTPAuthScheme is the Authorization header scheme used by NIP-98.
+const HTTPAuthScheme = "Nostr"
+
+// DefaultHTTPAuthWindow is how far created_at may drift from the server clock.
+const DefaultHTTPAuthWindow = 60 * time.Second
+
+// MaxHTTPAuthBodySize is the largest request body read to check the payload
+// tag, as it is read before the request is authenticated.
+const MaxHTTPAuthBodySize = 10 << 20
+
+// HTTPAuthVerifier validates NIP-98 Authorization headers and rejects replays
+// of events it has already accepted.
+type HTTPAuthVerifier struct {
+	seen   map[string]time.Time
+	window time.Duration
+	mu     sync.Mutex
+}
+
+// NewHTTPAuthVerifier returns a verifier accepting events created within window of now.
+func NewHTTPAuthVerifier(window time.Duration) *HTTPAuthVerifier {
+	return &HTTPAuthVerifier{
+		seen:   map[string]time.Time{},
+		window: window,
+	}
+}
+
+// Verify validates a NIP-98 Authorization header value against the request
+// method, the list of acceptable absolute URLs and the request body.
+// The signed event is returned on success.
+func (v *HTTPAuthVerifier) Verify(authHeader, method string, urls []string, body []byte) (*Event, error) {
+	if !strings.HasPrefix(authHeader, HTTPAuthScheme+" ") {
+		return nil, errors.New("missing nostr authorization header")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(authHeader[len(HTTPAuthScheme)+1:]))
+	if err != nil {
+		return nil, errors.Wrap(err, "authorization event is not valid base64")
+	}
+
+	event := &Event{}
+	if err := json.Unmarshal(decoded, event); err != nil {
+		return nil, errors.Wrap(err, "authorization event is not valid json")
+	}
+
+	if event.Kind != KindHTTPAuth {
+		return nil, errors.New("authorization event has the wrong kind")
+	}
+
+	now := time.Now()
+	createdAt := event.CreatedAtTime()
+	if createdAt.Before(now.Add(-v.window)) || createdAt.After(now.Add(v.window)) {
+		return nil, errors.New("authorization event is outside the allowed time window")
+	}
+
+	if !strings.EqualFold(event.Tags.Value("method"), method) {
+		return nil, errors.New("authorization event method does not match the request")
+	}
+
+	if !urlMatches(event.Tags.Value("u"), urls) {
+		return nil, errors.New("authorization event url does not match the request")
+	}
+
+	// Requests with a body must sign it, or the event could be replayed with
+	// another one. Without a body the payload tag is optional.
+	payload := event.Tags.GetFirst("payload")
+	if payload == nil && len(body) > 0 {
+		return nil, errors.New("authorization event has no payload for the request body")
+	}
+	if payload != nil {
+		hash := sha256.Sum256(body)
+		if !strings.EqualFold(payload.Value(), hex.EncodeToString(hash[:])) {
+			return nil, errors.New("authorization event payload does not match the request body")
+		}
+	}
+
+	if err := event.Verify(); err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.prune(now)
+	if _, exists := v.seen[event.ID]; exists {
+		return nil, errors.New("authorization event has already been used")
+	}
+	v.seen[event.ID] = createdAt.Add(v.window)
+
+	return event, nil
+}
+
+// VerifyRequest validates the NIP-98 Authorization header of an HTTP request.
+// If no urls are provided the URL is derived from the request itself.
+// The request body is read and replaced so handlers can still consume it.
+func (v *HTTPAuthVerifier) VerifyRequest(r *http.Request, urls ...string) (*Event, error) {
+	var body []byte
+	if r.Body != nil {
+		b, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, MaxHTTPAuthBodySize))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read request body")
+		}
+		_ = r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(b))
+		body = b
+	}
+
+	if len(urls) == 0 {
+		urls = []string{RequestURL(r)}
+	}
+
+	return v.Verify(r.Header.Get("Authorization"), r.Method, urls, body)
+}
+
+// prune removes remembered events that can no longer pass the time window.
+// Must be called with the lock held.
+func (v *HTTPAuthVerifier) prune(now time.Time) {
+	for id, expiry := range v.seen {
+		if now.After(expiry) {
+			delete(v.seen, id)
+		}
+	}
+}
+
+// RequestURL returns the absolute URL the request was made to. The
+// forwarding headers are ignored, as any client can send them. Behind a
+// reverse proxy the configured server URL should be used instead.
+func RequestURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
+
+// CreateHTTPAuthHeader returns a signed NIP-98 Authorization header value.
+func CreateHTTPAuthHeader(privateKey, method, url string, body []byte) (string, error) {
+	event := &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindHTTPAuth,
+		Tags: Tags{
+			{"u", url},
+			{"method", strings.ToUpper(method)},
+		},
+	}
+
+	if len(body) > 0 {
+		hash := sha256.Sum256(body)
+		event.Tags = append(event.Tags, Tag{"payload", hex.EncodeToString(hash[:])})
+	}
+
+	if err := event.Sign(privateKey); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return HTTPAuthScheme + " " + base64.StdEncoding.EncodeToString(b), nil
+}
+
+func urlMatches(signed string, urls []string) bool {
+	if signed == "" {
+		return false
+	}
+	signed = strings.TrimSuffix(signed, "/")
+	for _, u := range urls {
+		if u != "" && strings.EqualFold(signed, strings.TrimSuffix(u, "/")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package nostr
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testURL = "https://example.com/api/admin/status"
+
+func signedHeader(t *testing.T, privateKey string, event Event) string {
+	t.Helper()
+
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return "Nostr " + base64.StdEncoding.EncodeToString(b)
+}
+
+func TestHTTPAuthVerify(t *testing.T) {
+	privateKey, _ := GeneratePrivateKey()
+	body := []byte(`{"value":"hello"}`)
+
+	header, err := CreateHTTPAuthHeader(privateKey, "post", testURL, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := NewHTTPAuthVerifier(DefaultHTTPAuthWindow)
+
+	if _, err := verifier.Verify(header, "GET", []string{testURL}, body); err == nil {
+		t.Error("wrong method should be rejected")
+	}
+
+	if _, err := verifier.Verify(header, "POST", []string{"https://example.com/api/admin/other"}, body); err == nil {
+		t.Error("wrong url should be rejected")
+	}
+
+	if _, err := verifier.Verify(header, "POST", []string{testURL}, []byte("different")); err == nil {
+		t.Error("mismatched payload should be rejected")
+	}
+
+	event, err := verifier.Verify(header, "POST", []string{"https://other.example", testURL}, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkey, _ := GetPublicKey(privateKey)
+	if event.PubKey != pubkey {
+		t.Errorf("event pubkey = %s, want %s", event.PubKey, pubkey)
+	}
+
+	if _, err := verifier.Verify(header, "POST", []string{testURL}, body); err == nil {
+		t.Error("replayed event should be rejected")
+	}
+}
+
+func TestHTTPAuthVerifyRejectsInvalidEvents(t *testing.T) {
+	privateKey, _ := GeneratePrivateKey()
+	verifier := NewHTTPAuthVerifier(DefaultHTTPAuthWindow)
+	now := time.Now().Unix()
+	tags := Tags{{"u", testURL}, {"method", "GET"}}
+
+	tests := []struct {
+		name   string
+		header string
+	}{
+		{"bearer", "Bearer 3bf0c63fcb93463407af97a5e5ee64fa883d107ef9e558472c4eb9aaaefa459d"},
+		{"not base64", "Nostr !!!"},
+		{"wrong kind", signedHeader(t, privateKey, Event{Kind: 1, CreatedAt: now, Tags: tags})},
+		{"expired", signedHeader(t, privateKey, Event{Kind: KindHTTPAuth, CreatedAt: now - 120, Tags: tags})},
+		{"future", signedHeader(t, privateKey, Event{Kind: KindHTTPAuth, CreatedAt: now + 120, Tags: tags})},
+		{"missing url", signedHeader(t, privateKey, Event{Kind: KindHTTPAuth, CreatedAt: now, Tags: Tags{{"method", "GET"}}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verifier.Verify(tt.header, "GET", []string{testURL}, nil); err == nil {
+				t.Error("expected the header to be rejected")
+			}
+		})
+	}
+
+	// A request body the event does not sign.
+	header := signedHeader(t, privateKey, Event{Kind: KindHTTPAuth, CreatedAt: now, Tags: Tags{{"u", testURL}, {"method", "POST"}}})
+	if _, err := verifier.Verify(header, "POST", []string{testURL}, []byte(`{"value":"hello"}`)); err == nil {
+		t.Error("body without a payload tag should be rejected")
+	}
+
+	// A valid event with a forged signature.
+	header = signedHeader(t, privateKey, Event{Kind: KindHTTPAuth, CreatedAt: now, Tags: tags})
+	decoded, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(header, "Nostr "))
+	event := Event{}
+	_ = json.Unmarshal(decoded, &event)
+	event.Sig = strings.Repeat("0", 128)
+	b, _ := json.Marshal(event)
+	if _, err := verifier.Verify("Nostr "+base64.StdEncoding.EncodeToString(b), "GET", []string{testURL}, nil); err == nil {
+		t.Error("forged signature should be rejected")
+	}
+}
+
+func TestHTTPAuthVerifyRequest(t *testing.T) {
+	privateKey, _ := GeneratePrivateKey()
+	body := `{"value":true}`
+
+	r := httptest.NewRequest("POST", "http://internal:8080/api/admin/config/chat/disable?x=1", strings.NewReader(body))
+
+	header, _ := CreateHTTPAuthHeader(privateKey, "POST", "http://internal:8080/api/admin/config/chat/disable?x=1", []byte(body))
+	r.Header.Set("Authorization", header)
+
+	verifier := NewHTTPAuthVerifier(DefaultHTTPAuthWindow)
+	if _, err := verifier.VerifyRequest(r); err != nil {
+		t.Fatal(err)
+	}
+
+	// The body must still be readable by the handler.
+	b, err := io.ReadAll(r.Body)
+	if err != nil || string(b) != body {
+		t.Errorf("request body = %q, want %q", string(b), body)
+	}
+}
+
+func TestHTTPAuthIgnoresForwardedHeaders(t *testing.T) {
+	privateKey, _ := GeneratePrivateKey()
+
+	// The event was signed for another host, which the client claims to be
+	// forwarded from.
+	r := httptest.NewRequest("GET", "http://internal:8080/api/admin/status", nil)
+	r.Header.Set("X-Forwarded-Proto", "https")
+	r.Header.Set("X-Forwarded-Host", "example.com")
+
+	header, _ := CreateHTTPAuthHeader(privateKey, "GET", "https://example.com/api/admin/status", nil)
+	r.Header.Set("Authorization", header)
+
+	verifier := NewHTTPAuthVerifier(DefaultHTTPAuthWindow)
+	if _, err := verifier.VerifyRequest(r); err == nil {
+		t.Error("an event signed for the forwarded host should be rejected")
+	}
+}
+
+func TestHTTPAuthRejectsLargeBodies(t *testing.T) {
+	privateKey, _ := GeneratePrivateKey()
+	body := strings.Repeat("a", MaxHTTPAuthBodySize+1)
+
+	r := httptest.NewRequest("POST", "http://internal:8080/api/admin/emoji/upload", strings.NewReader(body))
+	header, _ := CreateHTTPAuthHeader(privateKey, "POST", "http://internal:8080/api/admin/emoji/upload", nil)
+	r.Header.Set("Authorization", header)
+
+	verifier := NewHTTPAuthVerifier(DefaultHTTPAuthWindow)
+	if _, err := verifier.VerifyRequest(r); err == nil {
+		t.Error("a body over the size limit should be rejected")
+	}
+}
@@ -0,0 +1,295 @@
+// Package nwc is a NIP-47 Nostr Wallet Connect client. Requests are sent to
+// the wallet service as encrypted events over the relays named in the
+// connection URI and the wallet answers with encrypted response events.
+package nwc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Request methods defined by NIP-47.
+const (
+	MethodPayInvoice    = "pay_invoice"
+	MethodMakeInvoice   = "make_invoice"
+	MethodLookupInvoice = "lookup_invoice"
+	MethodGetBalance    = "get_balance"
+)
+
+// How long a request waits for the wallet to answer when the context has no deadline.
+const defaultRequestTimeout = 30 * time.Second
+
+// Error is an error response from the wallet service.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return "wallet error " + e.Code
+	}
+	return "wallet error " + e.Code + ": " + e.Message
+}
+
+// Request is the decrypted content of a request event.
+type Request struct {
+	Params json.RawMessage `json:"params"`
+	Method string          `json:"method"`
+}
+
+// Response is the decrypted content of a response event.
+type Response struct {
+	Error      *Error          `json:"error,omitempty"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	ResultType string          `json:"result_type"`
+}
+
+// Transaction is an invoice or payment as described by the wallet.
+type Transaction struct {
+	Type            string `json:"type"`
+	Invoice         string `json:"invoice,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DescriptionHash string `json:"description_hash,omitempty"`
+	Preimage        string `json:"preimage,omitempty"`
+	PaymentHash     string `json:"payment_hash"`
+	Amount          int64  `json:"amount"`
+	FeesPaid        int64  `json:"fees_paid,omitempty"`
+	CreatedAt       int64  `json:"created_at,omitempty"`
+	ExpiresAt       int64  `json:"expires_at,omitempty"`
+	SettledAt       int64  `json:"settled_at,omitempty"`
+}
+
+// IsSettled returns if the invoice has been paid.
+func (t *Transaction) IsSettled() bool {
+	return t.SettledAt > 0 || t.Preimage != ""
+}
+
+// MakeInvoiceParams are the parameters of a make_invoice request.
+type MakeInvoiceParams struct {
+	Description     string `json:"description,omitempty"`
+	DescriptionHash string `json:"description_hash,omitempty"`
+	Amount          int64  `json:"amount"`
+	Expiry          int64  `json:"expiry,omitempty"`
+}
+
+// Client sends requests to a single wallet service.
+type Client struct {
+	conn     *nostr.WalletConnection
+	pool     *relay.Pool
+	sub      *relay.Subscription
+	waiters  map[string]chan *nostr.Event
+	lock     sync.Mutex
+	closeOne sync.Once
+}
+
+// NewClient connects to the relays of the wallet connection.
+func NewClient(conn *nostr.WalletConnection) *Client {
+	pool := relay.NewPool()
+	pool.SetRelays(conn.Relays)
+
+	c := &Client{
+		conn:    conn,
+		pool:    pool,
+		waiters: map[string]chan *nostr.Event{},
+	}
+
+	c.sub = pool.Subscribe(nostr.Filter{
+		Kinds:   []int{nostr.KindWalletResponse},
+		Authors: []string{conn.WalletPubkey},
+		Tags:    map[string][]string{"p": {conn.ClientPubkey()}},
+		Since:   time.Now().Add(-time.Minute).Unix(),
+	})
+	go c.handleResponses()
+
+	return c
+}
+
+// Connection returns the wallet connection the client was created with.
+func (c *Client) Connection() *nostr.WalletConnection {
+	return c.conn
+}
+
+// Close disconnects from the wallet's relays.
+func (c *Client) Close() {
+	c.closeOne.Do(c.pool.Close)
+}
+
+// MakeInvoice asks the wallet to create an invoice.
+func (c *Client) MakeInvoice(ctx context.Context, params MakeInvoiceParams) (*Transaction, error) {
+	transaction := &Transaction{}
+	if err := c.Call(ctx, MethodMakeInvoice, params, transaction); err != nil {
+		return nil, err
+	}
+	if transaction.Invoice == "" {
+		return nil, errors.New("wallet did not return an invoice")
+	}
+
+	return transaction, nil
+}
+
+// LookupInvoice returns the state of an invoice by its payment hash.
+func (c *Client) LookupInvoice(ctx context.Context, paymentHash string) (*Transaction, error) {
+	transaction := &Transaction{}
+	params := map[string]string{"payment_hash": paymentHash}
+	if err := c.Call(ctx, MethodLookupInvoice, params, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// GetBalance returns the wallet balance in msat.
+func (c *Client) GetBalance(ctx context.Context) (int64, error) {
+	result := struct {
+		Balance int64 `json:"balance"`
+	}{}
+	if err := c.Call(ctx, MethodGetBalance, struct{}{}, &result); err != nil {
+		return 0, err
+	}
+
+	return result.Balance, nil
+}
+
+// PayInvoice pays an invoice from the wallet and returns the preimage.
+func (c *Client) PayInvoice(ctx context.Context, invoice string) (string, error) {
+	result := struct {
+		Preimage string `json:"preimage"`
+	}{}
+	params := map[string]string{"invoice": invoice}
+	if err := c.Call(ctx, MethodPayInvoice, params, &result); err != nil {
+		return "", err
+	}
+
+	return result.Preimage, nil
+}
+
+// Call sends a request to the wallet and decodes the result of its response
+// into result.
+func (c *Client) Call(ctx context.Context, method string, params, result interface{}) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+	}
+
+	request, err := c.requestEvent(method, params)
+	if err != nil {
+		return err
+	}
+
+	waiter := make(chan *nostr.Event, 1)
+	c.lock.Lock()
+	c.waiters[request.ID] = waiter
+	c.lock.Unlock()
+	defer func() {
+		c.lock.Lock()
+		delete(c.waiters, request.ID)
+		c.lock.Unlock()
+	}()
+
+	if err := c.pool.WaitForConnection(ctx); err != nil {
+		return errors.Wrap(err, "unable to reach the wallet relay")
+	}
+
+	accepted := false
+	var publishErr error
+	for _, r := range c.pool.Publish(ctx, request) {
+		if r.Accepted {
+			accepted = true
+		} else if r.Error != nil {
+			publishErr = r.Error
+		}
+	}
+	if !accepted {
+		return errors.Wrap(publishErr, "wallet relay did not accept the request")
+	}
+
+	select {
+	case event := <-waiter:
+		return c.decodeResponse(event, method, result)
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "no response from the wallet")
+	}
+}
+
+func (c *Client) requestEvent(method string, params interface{}) (*nostr.Event, error) {
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(Request{Method: method, Params: rawParams})
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := nostr.EncryptDirectMessage(c.conn.Secret, c.conn.WalletPubkey, string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	event := &nostr.Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      nostr.KindWalletRequest,
+		Tags:      nostr.Tags{{"p", c.conn.WalletPubkey}},
+		Content:   encrypted,
+	}
+	if err := event.Sign(c.conn.Secret); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (c *Client) decodeResponse(event *nostr.Event, method string, result interface{}) error {
+	content, err := nostr.DecryptDirectMessage(c.conn.Secret, c.conn.WalletPubkey, event.Content)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt the wallet response")
+	}
+
+	response := Response{}
+	if err := json.Unmarshal([]byte(content), &response); err != nil {
+		return errors.Wrap(err, "invalid wallet response")
+	}
+
+	if response.Error != nil && response.Error.Code != "" {
+		return response.Error
+	}
+	if response.ResultType != method {
+		return errors.Errorf("wallet answered %s with %s", method, response.ResultType)
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+// handleResponses passes response events to the request they answer.
+func (c *Client) handleResponses() {
+	for event := range c.sub.Events {
+		if event.PubKey != c.conn.WalletPubkey {
+			continue
+		}
+		if err := event.Verify(); err != nil {
+			log.Debugln("Ignoring invalid wallet response", err)
+			continue
+		}
+
+		c.lock.Lock()
+		waiter := c.waiters[event.Tags.Value("e")]
+		c.lock.Unlock()
+
+		if waiter != nil {
+			select {
+			case waiter <- event:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package nwc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/nwc"
+	"github.com/TekkadanPlays/oni/nostr/nwctest"
+	"github.com/TekkadanPlays/oni/nostr/relaytest"
+)
+
+func newClient(t *testing.T) (*nwc.Client, *nwctest.Wallet) {
+	t.Helper()
+
+	r := relaytest.NewRelay()
+	t.Cleanup(r.Close)
+
+	wallet := nwctest.NewWallet(r.URL())
+	t.Cleanup(wallet.Close)
+
+	conn, err := nostr.ParseWalletConnectURI(wallet.URI())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := nwc.NewClient(conn)
+	t.Cleanup(client.Close)
+
+	return client, wallet
+}
+
+func TestInvoices(t *testing.T) {
+	client, wallet := newClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	wallet.SetBalance(5000)
+	balance, err := client.GetBalance(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 5000 {
+		t.Errorf("balance = %d, want 5000", balance)
+	}
+
+	invoice, err := client.MakeInvoice(ctx, nwc.MakeInvoiceParams{Amount: 21000, Description: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := nostr.DecodeInvoice(invoice.Invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.AmountMsat != 21000 || decoded.PaymentHash != invoice.PaymentHash {
+		t.Errorf("unexpected invoice %+v", decoded)
+	}
+
+	lookup, err := client.LookupInvoice(ctx, invoice.PaymentHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lookup.IsSettled() {
+		t.Error("unpaid invoice is settled")
+	}
+
+	if err := wallet.Settle(invoice.Invoice); err != nil {
+		t.Fatal(err)
+	}
+
+	lookup, err = client.LookupInvoice(ctx, invoice.PaymentHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !lookup.IsSettled() {
+		t.Error("paid invoice is not settled")
+	}
+
+	if balance, _ := client.GetBalance(ctx); balance != 26000 {
+		t.Errorf("balance = %d, want 26000", balance)
+	}
+}
+
+func TestWalletErrors(t *testing.T) {
+	client, _ := newClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := client.LookupInvoice(ctx, "0000")
+	walletErr := &nwc.Error{}
+	if !errors.As(err, &walletErr) || walletErr.Code != "NOT_FOUND" {
+		t.Errorf("expected a NOT_FOUND error, got %v", err)
+	}
+
+	err = client.Call(ctx, "sign_message", struct{}{}, &struct{}{})
+	if !errors.As(err, &walletErr) || walletErr.Code != "NOT_IMPLEMENTED" {
+		t.Errorf("expected a NOT_IMPLEMENTED error, got %v", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	r := relaytest.NewRelay()
+	defer r.Close()
+
+	// A wallet that is not listening never answers.
+	wallet := nwctest.NewWallet(r.URL())
+	conn, _ := nostr.ParseWalletConnectURI(wallet.URI())
+	wallet.Close()
+
+	client := nwc.NewClient(conn)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetBalance(ctx); err == nil {
+		t.Error("expected a timeout")
+	}
+}
@@ -0,0 +1,289 @@
+// Package nwctest provides an in-process Nostr Wallet Connect wallet
+// service for tests.
+package nwctest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/nwc"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/pkg/errors"
+)
+
+// Wallet is a fake wallet service answering NIP-47 requests on a relay.
+// Invoices it creates are unpayable and only settle when Settle is called.
+type Wallet struct {
+	conn         nostr.WalletConnection
+	pool         *relay.Pool
+	privateKey   string
+	transactions map[string]*nwc.Transaction
+	requests     []string
+	balance      int64
+	lock         sync.Mutex
+}
+
+// NewWallet starts a wallet service listening on the relay.
+func NewWallet(relayURL string) *Wallet {
+	privateKey := mustGenerateKey()
+	pubkey, _ := nostr.GetPublicKey(privateKey)
+
+	w := &Wallet{
+		conn: nostr.WalletConnection{
+			WalletPubkey: pubkey,
+			Secret:       mustGenerateKey(),
+			Relays:       []string{relayURL},
+		},
+		pool:         relay.NewPool(),
+		privateKey:   privateKey,
+		transactions: map[string]*nwc.Transaction{},
+	}
+
+	w.pool.SetRelays(w.conn.Relays)
+	sub := w.pool.Subscribe(nostr.Filter{
+		Kinds: []int{nostr.KindWalletRequest},
+		Tags:  map[string][]string{"p": {pubkey}},
+	})
+	go func() {
+		for event := range sub.Events {
+			w.handleRequest(event)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = w.pool.WaitForConnection(ctx)
+
+	return w
+}
+
+// URI returns the nostr+walletconnect:// URI for connecting to the wallet.
+func (w *Wallet) URI() string {
+	return w.conn.URI()
+}
+
+// Close disconnects the wallet from the relay.
+func (w *Wallet) Close() {
+	w.pool.Close()
+}
+
+// SetBalance sets the balance in msat reported by get_balance.
+func (w *Wallet) SetBalance(balance int64) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.balance = balance
+}
+
+// Balance returns the wallet balance in msat.
+func (w *Wallet) Balance() int64 {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.balance
+}
+
+// Requests returns the methods called on the wallet so far.
+func (w *Wallet) Requests() []string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return append([]string{}, w.requests...)
+}
+
+// Invoices returns every invoice the wallet created.
+func (w *Wallet) Invoices() []*nwc.Transaction {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	invoices := []*nwc.Transaction{}
+	for _, transaction := range w.transactions {
+		if transaction.Type == "incoming" {
+			copied := *transaction
+			invoices = append(invoices, &copied)
+		}
+	}
+
+	return invoices
+}
+
+// Settle marks an invoice created by the wallet as paid.
+func (w *Wallet) Settle(bolt11 string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for _, transaction := range w.transactions {
+		if transaction.Invoice != bolt11 {
+			continue
+		}
+		if transaction.SettledAt == 0 {
+			transaction.SettledAt = time.Now().Unix()
+			w.balance += transaction.Amount
+		}
+		return nil
+	}
+
+	return errors.New("unknown invoice")
+}
+
+func (w *Wallet) handleRequest(event *nostr.Event) {
+	if event.Verify() != nil || event.PubKey != w.conn.ClientPubkey() {
+		return
+	}
+
+	content, err := nostr.DecryptDirectMessage(w.privateKey, event.PubKey, event.Content)
+	if err != nil {
+		return
+	}
+
+	request := nwc.Request{}
+	if err := json.Unmarshal([]byte(content), &request); err != nil {
+		return
+	}
+
+	w.lock.Lock()
+	w.requests = append(w.requests, request.Method)
+	w.lock.Unlock()
+
+	response := nwc.Response{ResultType: request.Method}
+	result, walletErr := w.handle(request)
+	if walletErr != nil {
+		response.Error = walletErr
+	} else {
+		response.Result, _ = json.Marshal(result)
+	}
+
+	w.respond(event, response)
+}
+
+func (w *Wallet) handle(request nwc.Request) (interface{}, *nwc.Error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	switch request.Method {
+	case nwc.MethodGetBalance:
+		return map[string]int64{"balance": w.balance}, nil
+
+	case nwc.MethodMakeInvoice:
+		params := nwc.MakeInvoiceParams{}
+		if err := json.Unmarshal(request.Params, &params); err != nil || params.Amount <= 0 {
+			return nil, &nwc.Error{Code: "OTHER", Message: "invalid amount"}
+		}
+
+		preimage := randomHex()
+		paymentHash := sha256.Sum256(mustDecodeHex(preimage))
+		transaction := &nwc.Transaction{
+			Type:            "incoming",
+			Description:     params.Description,
+			DescriptionHash: params.DescriptionHash,
+			Preimage:        preimage,
+			PaymentHash:     hex.EncodeToString(paymentHash[:]),
+			Amount:          params.Amount,
+			CreatedAt:       time.Now().Unix(),
+		}
+		transaction.Invoice = nostr.Invoice{
+			CreatedAt:       time.Now(),
+			AmountMsat:      params.Amount,
+			PaymentHash:     transaction.PaymentHash,
+			Description:     params.Description,
+			DescriptionHash: params.DescriptionHash,
+			Expiry:          time.Duration(params.Expiry) * time.Second,
+		}.Encode()
+		w.transactions[transaction.PaymentHash] = transaction
+
+		return w.public(transaction), nil
+
+	case nwc.MethodLookupInvoice:
+		params := map[string]string{}
+		_ = json.Unmarshal(request.Params, &params)
+		transaction, ok := w.transactions[params["payment_hash"]]
+		if !ok {
+			return nil, &nwc.Error{Code: "NOT_FOUND", Message: "invoice not found"}
+		}
+		return w.public(transaction), nil
+
+	case nwc.MethodPayInvoice:
+		params := map[string]string{}
+		_ = json.Unmarshal(request.Params, &params)
+		invoice, err := nostr.DecodeInvoice(params["invoice"])
+		if err != nil {
+			return nil, &nwc.Error{Code: "OTHER", Message: "invalid invoice"}
+		}
+		if invoice.AmountMsat > w.balance {
+			return nil, &nwc.Error{Code: "INSUFFICIENT_BALANCE", Message: "not enough funds"}
+		}
+		w.balance -= invoice.AmountMsat
+		w.transactions[invoice.PaymentHash] = &nwc.Transaction{
+			Type:        "outgoing",
+			Invoice:     params["invoice"],
+			PaymentHash: invoice.PaymentHash,
+			Amount:      invoice.AmountMsat,
+			CreatedAt:   time.Now().Unix(),
+			SettledAt:   time.Now().Unix(),
+		}
+		return map[string]string{"preimage": randomHex()}, nil
+	}
+
+	return nil, &nwc.Error{Code: "NOT_IMPLEMENTED", Message: request.Method + " is not supported"}
+}
+
+// public returns the transaction as the wallet reports it, without the
+// preimage of unpaid invoices.
+func (w *Wallet) public(transaction *nwc.Transaction) *nwc.Transaction {
+	copied := *transaction
+	if copied.SettledAt == 0 {
+		copied.Preimage = ""
+	}
+	return &copied
+}
+
+func (w *Wallet) respond(request *nostr.Event, response nwc.Response) {
+	content, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+
+	encrypted, err := nostr.EncryptDirectMessage(w.privateKey, request.PubKey, string(content))
+	if err != nil {
+		return
+	}
+
+	event := &nostr.Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      nostr.KindWalletResponse,
+		Tags:      nostr.Tags{{"p", request.PubKey}, {"e", request.ID}},
+		Content:   encrypted,
+	}
+	if err := event.Sign(w.privateKey); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	w.pool.Publish(ctx, event)
+}
+
+func mustGenerateKey() string {
+	key, err := nostr.GeneratePrivateKey()
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func randomHex() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func mustDecodeHex(s string) []byte {
+	b, _ := hex.DecodeString(s)
+	return b
+}
@@ -0,0 +1,64 @@
+package nostr
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// KindProfileMetadata is the NIP-01 user metadata event kind.
+const KindProfileMetadata = 0
+
+// Profile is the user metadata published in a kind 0 event.
+type Profile struct {
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	About       string `json:"about,omitempty"`
+	Picture     string `json:"picture,omitempty"`
+	Banner      string `json:"banner,omitempty"`
+	Website     string `json:"website,omitempty"`
+	NIP05       string `json:"nip05,omitempty"`
+	LUD16       string `json:"lud16,omitempty"`
+	Pubkey      string `json:"-"`
+	CreatedAt   int64  `json:"-"`
+}
+
+// ParseProfile reads the profile metadata from a kind 0 event.
+func ParseProfile(event *Event) (*Profile, error) {
+	if event.Kind != KindProfileMetadata {
+		return nil, errors.New("event is not a profile metadata event")
+	}
+
+	profile := &Profile{}
+	if err := json.Unmarshal([]byte(event.Content), profile); err != nil {
+		return nil, errors.Wrap(err, "profile metadata is not valid json")
+	}
+	profile.Pubkey = event.PubKey
+	profile.CreatedAt = event.CreatedAt
+
+	return profile, nil
+}
+
+// BestName returns the name the profile prefers to be shown as.
+func (p *Profile) BestName() string {
+	if p.DisplayName != "" {
+		return p.DisplayName
+	}
+	return p.Name
+}
+
+// Event returns the unsigned kind 0 event publishing the profile.
+func (p Profile) Event() (*Event, error) {
+	content, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      KindProfileMetadata,
+		Tags:      Tags{},
+		Content:   string(content),
+	}, nil
+}
@@ -0,0 +1,98 @@
+// Package profiles looks up and caches Nostr profile metadata from the
+// server's relays.
+package profiles
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How long a fetched profile is used before asking the relays again.
+	cacheDuration = time.Hour
+
+	// How long to wait before asking again for a profile no relay had.
+	missingCacheDuration = 10 * time.Minute
+
+	// The most profiles kept in memory.
+	maxCachedProfiles = 5000
+)
+
+type cachedProfile struct {
+	fetchedAt time.Time
+	profile   *nostr.Profile
+}
+
+var (
+	cache = map[string]cachedProfile{}
+	lock  sync.Mutex
+)
+
+// Get returns the profile for the pubkey, fetching it from the relays if
+// it is not cached. Returns nil when no relay has a profile for it.
+func Get(ctx context.Context, pubkey string) *nostr.Profile {
+	lock.Lock()
+	cached, ok := cache[pubkey]
+	lock.Unlock()
+
+	if ok {
+		maxAge := cacheDuration
+		if cached.profile == nil {
+			maxAge = missingCacheDuration
+		}
+		if time.Since(cached.fetchedAt) < maxAge {
+			return cached.profile
+		}
+	}
+
+	return Fetch(ctx, pubkey)
+}
+
+// Fetch asks the relays for the latest profile of the pubkey and caches it.
+func Fetch(ctx context.Context, pubkey string) *nostr.Profile {
+	events := relay.Get().Query(ctx, nostr.Filter{
+		Kinds:   []int{nostr.KindProfileMetadata},
+		Authors: []string{pubkey},
+	})
+
+	var profile *nostr.Profile
+	for _, event := range events {
+		if event.PubKey != pubkey || (profile != nil && event.CreatedAt <= profile.CreatedAt) {
+			continue
+		}
+		parsed, err := nostr.ParseProfile(event)
+		if err != nil {
+			log.Debugln("Ignoring invalid Nostr profile for", pubkey, err)
+			continue
+		}
+		profile = parsed
+	}
+
+	// Don't remember a miss caused by giving up early.
+	if profile == nil && ctx.Err() != nil {
+		return nil
+	}
+
+	Set(pubkey, profile)
+	return profile
+}
+
+// Set caches a profile, for example one received on a subscription.
+func Set(pubkey string, profile *nostr.Profile) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if existing, ok := cache[pubkey]; ok && existing.profile != nil && profile != nil && existing.profile.CreatedAt > profile.CreatedAt {
+		return
+	}
+
+	if len(cache) >= maxCachedProfiles {
+		cache = map[string]cachedProfile{}
+	}
+	cache[pubkey] = cachedProfile{profile: profile, fetchedAt: time.Now()}
+}
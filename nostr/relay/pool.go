@@ -0,0 +1,433 @@
+package relay
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = 5 * time.Minute
+
+	// How many events may queue on a subscription before new ones are dropped.
+	subscriptionBufferSize = 256
+)
+
+var (
+	_pool     *Pool
+	_poolLock sync.Mutex
+)
+
+// Get returns the shared pool of relays the server is connected to.
+func Get() *Pool {
+	_poolLock.Lock()
+	defer _poolLock.Unlock()
+
+	if _pool == nil {
+		_pool = NewPool()
+	}
+
+	return _pool
+}
+
+// LocalRelay is a relay running inside this server that the pool hands
+// events to directly instead of over a websocket.
+type LocalRelay interface {
+	// URL returns the public websocket URL of the relay, if it has one.
+	URL() string
+	// Publish stores an event, returning why it was refused if it was.
+	Publish(event *nostr.Event) error
+	// Query returns the stored events matching the filters.
+	Query(filters []nostr.Filter) []*nostr.Event
+}
+
+// Pool keeps connections to a set of relays, publishing events to all of
+// them and fanning subscriptions out across them.
+type Pool struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	local         LocalRelay
+	relays        map[string]*Relay
+	subscriptions map[string]*Subscription
+	minBackoff    time.Duration
+	maxBackoff    time.Duration
+	lock          sync.Mutex
+}
+
+// NewPool returns a pool with no relays.
+func NewPool() *Pool {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Pool{
+		ctx:           ctx,
+		cancel:        cancel,
+		relays:        map[string]*Relay{},
+		subscriptions: map[string]*Subscription{},
+		minBackoff:    defaultMinBackoff,
+		maxBackoff:    defaultMaxBackoff,
+	}
+}
+
+// SetRelays connects to any new relays and disconnects from relays that
+// are no longer in the list.
+func (p *Pool) SetRelays(urls []string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	wanted := map[string]bool{}
+	for _, url := range urls {
+		url = normalizeURL(url)
+		if url == "" {
+			continue
+		}
+		wanted[url] = true
+
+		if _, exists := p.relays[url]; !exists {
+			relay := newRelay(p, url)
+			p.relays[url] = relay
+			relay.start(p.ctx)
+		}
+	}
+
+	for url, relay := range p.relays {
+		if !wanted[url] {
+			relay.stop()
+			delete(p.relays, url)
+		}
+	}
+}
+
+// SetLocal sets the relay running inside this server, or removes it when
+// nil. Everything published through the pool is stored on it, as are the
+// events other relays send to the pool's subscriptions.
+func (p *Pool) SetLocal(local LocalRelay) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.local = local
+}
+
+// Relays returns the URLs of every relay in the pool, including the local
+// relay when it has a public URL.
+func (p *Pool) Relays() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	urls := make([]string, 0, len(p.relays)+1)
+	for url := range p.relays {
+		urls = append(urls, url)
+	}
+	if p.local != nil {
+		if url := normalizeURL(p.local.URL()); url != "" && p.relays[url] == nil {
+			urls = append(urls, url)
+		}
+	}
+	sort.Strings(urls)
+
+	return urls
+}
+
+// Health returns the connection health of every relay in the pool.
+func (p *Pool) Health() []Health {
+	relays := p.relayList()
+	health := make([]Health, 0, len(relays))
+	for _, relay := range relays {
+		health = append(health, relay.Health())
+	}
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].URL < health[j].URL
+	})
+
+	return health
+}
+
+// WaitForConnection blocks until at least one relay in the pool is
+// connected or the context expires.
+func (p *Pool) WaitForConnection(ctx context.Context) error {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		for _, relay := range p.relayList() {
+			if relay.IsConnected() {
+				return nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "no relay connected")
+		}
+	}
+}
+
+func waitForRelay(ctx context.Context, relay *Relay) {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	for !relay.IsConnected() {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Publish sends the event to every relay in the pool and waits for each of
+// them to acknowledge it or for the context to expire.
+func (p *Pool) Publish(ctx context.Context, event *nostr.Event) []PublishResult {
+	relays := p.relayList()
+	results := make([]PublishResult, len(relays), len(relays)+1)
+
+	wg := sync.WaitGroup{}
+	for i, relay := range relays {
+		wg.Add(1)
+		go func(i int, relay *Relay) {
+			defer wg.Done()
+			results[i] = relay.publish(ctx, event)
+		}(i, relay)
+	}
+	wg.Wait()
+
+	if local := p.localRelay(); local != nil {
+		result := PublishResult{Relay: local.URL(), Accepted: true}
+		if err := local.Publish(event); err != nil {
+			result.Accepted = false
+			result.Error = err
+			result.Message = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// PublishTo connects to relays outside of any pool just long enough to send
+// them the event, publishing to each relay as soon as it connects.
+func PublishTo(ctx context.Context, urls []string, event *nostr.Event) []PublishResult {
+	pool := NewPool()
+	defer pool.Close()
+	pool.SetRelays(urls)
+
+	relays := pool.relayList()
+	results := make([]PublishResult, len(relays))
+
+	wg := sync.WaitGroup{}
+	for i, relay := range relays {
+		wg.Add(1)
+		go func(i int, relay *Relay) {
+			defer wg.Done()
+			waitForRelay(ctx, relay)
+			results[i] = relay.publish(ctx, event)
+		}(i, relay)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// PublishInBackground publishes the event without blocking, logging the
+// outcome for each relay.
+func (p *Pool) PublishInBackground(event *nostr.Event, timeout time.Duration) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		for _, result := range p.Publish(ctx, event) {
+			if result.Error != nil {
+				log.Debugln("Unable to publish Nostr event", event.ID, "to", result.Relay, result.Error)
+			}
+		}
+	}()
+}
+
+// Subscribe opens a REQ subscription with the given filters on every relay
+// in the pool, including relays that connect later on.
+func (p *Pool) Subscribe(filters ...nostr.Filter) *Subscription {
+	id, _ := utils.GenerateRandomString(8)
+	sub := &Subscription{
+		ID:                id,
+		Filters:           filters,
+		Events:            make(chan *nostr.Event, subscriptionBufferSize),
+		EndOfStoredEvents: make(chan struct{}),
+		pool:              p,
+		seen:              map[string]struct{}{},
+		pending:           map[string]struct{}{},
+	}
+
+	p.lock.Lock()
+	p.subscriptions[id] = sub
+	p.lock.Unlock()
+
+	for _, relay := range p.relayList() {
+		if !relay.IsConnected() {
+			continue
+		}
+		if err := relay.sendREQ(sub); err != nil {
+			log.Debugln("Unable to subscribe on", relay.URL(), err)
+		}
+	}
+
+	if local := p.localRelay(); local != nil {
+		for _, event := range local.Query(filters) {
+			sub.dispatch(event)
+		}
+	}
+
+	// Nothing to wait for if no relay received the subscription.
+	sub.checkEOSE()
+
+	return sub
+}
+
+// Query returns the stored events matching the filters, waiting until every
+// relay has sent them or the context expires.
+func (p *Pool) Query(ctx context.Context, filters ...nostr.Filter) []*nostr.Event {
+	sub := p.Subscribe(filters...)
+	defer sub.Close()
+
+	results := []*nostr.Event{}
+	for {
+		select {
+		case event, ok := <-sub.Events:
+			if !ok {
+				return results
+			}
+			results = append(results, event)
+		case <-sub.EndOfStoredEvents:
+			// Collect anything dispatched before the final EOSE arrived.
+			for {
+				select {
+				case event, ok := <-sub.Events:
+					if !ok {
+						return results
+					}
+					results = append(results, event)
+				default:
+					return results
+				}
+			}
+		case <-ctx.Done():
+			return results
+		}
+	}
+}
+
+// Close disconnects from every relay and closes all subscriptions.
+func (p *Pool) Close() {
+	p.cancel()
+
+	p.lock.Lock()
+	relays := p.relays
+	subscriptions := p.subscriptions
+	p.relays = map[string]*Relay{}
+	p.subscriptions = map[string]*Subscription{}
+	p.lock.Unlock()
+
+	for _, relay := range relays {
+		relay.stop()
+	}
+	for _, sub := range subscriptions {
+		sub.closeChannels()
+	}
+}
+
+// Deliver hands an event to every matching subscription as if a relay had
+// sent it, for events published by clients of the local relay.
+func (p *Pool) Deliver(event *nostr.Event) {
+	for _, sub := range p.activeSubscriptions() {
+		sub.dispatch(event)
+	}
+}
+
+func (p *Pool) unsubscribe(sub *Subscription) {
+	p.lock.Lock()
+	delete(p.subscriptions, sub.ID)
+	p.lock.Unlock()
+
+	for _, relay := range p.relayList() {
+		_ = relay.sendCLOSE(sub.ID)
+	}
+}
+
+func (p *Pool) relayList() []*Relay {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	relays := make([]*Relay, 0, len(p.relays))
+	for _, relay := range p.relays {
+		relays = append(relays, relay)
+	}
+
+	return relays
+}
+
+func (p *Pool) activeSubscriptions() []*Subscription {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	subs := make([]*Subscription, 0, len(p.subscriptions))
+	for _, sub := range p.subscriptions {
+		subs = append(subs, sub)
+	}
+
+	return subs
+}
+
+func (p *Pool) localRelay() LocalRelay {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.local
+}
+
+func (p *Pool) subscription(id string) *Subscription {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.subscriptions[id]
+}
+
+func (p *Pool) handleEvent(subID string, event *nostr.Event) {
+	sub := p.subscription(subID)
+	if sub == nil {
+		return
+	}
+	sub.dispatch(event)
+
+	// Keep clients of the local relay up to date with what other relays have.
+	if local := p.localRelay(); local != nil {
+		_ = local.Publish(event)
+	}
+}
+
+func (p *Pool) handleEOSE(subID, url string) {
+	if sub := p.subscription(subID); sub != nil {
+		sub.receivedEOSE(url)
+	}
+}
+
+// relayDisconnected treats a dropped relay as finished sending stored events
+// so subscribers are not left waiting for it.
+func (p *Pool) relayDisconnected(relay *Relay) {
+	for _, sub := range p.activeSubscriptions() {
+		sub.receivedEOSE(relay.URL())
+	}
+}
+
+func (p *Pool) backoff(attempt int) time.Duration {
+	return exponentialBackoff(attempt, p.minBackoff, p.maxBackoff)
+}
+
+func normalizeURL(url string) string {
+	return strings.TrimSuffix(strings.TrimSpace(url), "/")
+}
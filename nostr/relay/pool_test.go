@@ -0,0 +1,218 @@
+package relay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/relaytest"
+)
+
+func newTestPool(t *testing.T, urls ...string) *Pool {
+	t.Helper()
+
+	pool := NewPool()
+	pool.minBackoff = 10 * time.Millisecond
+	pool.maxBackoff = 50 * time.Millisecond
+	pool.SetRelays(urls)
+	t.Cleanup(pool.Close)
+
+	waitFor(t, "relays to connect", func() bool {
+		for _, h := range pool.Health() {
+			if h.Status != StatusConnected {
+				return false
+			}
+		}
+		return true
+	})
+
+	return pool
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
+
+func signedEvent(t *testing.T, kind int, content string) *nostr.Event {
+	t.Helper()
+
+	privateKey, _ := nostr.GeneratePrivateKey()
+	event := &nostr.Event{Kind: kind, CreatedAt: time.Now().Unix(), Content: content}
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+func TestPublishTracksAcknowledgements(t *testing.T) {
+	accepting := relaytest.NewRelay()
+	defer accepting.Close()
+
+	rejecting := relaytest.NewRelay()
+	rejecting.Reject = func(*nostr.Event) string { return "blocked: not allowed" }
+	defer rejecting.Close()
+
+	pool := newTestPool(t, accepting.URL(), rejecting.URL())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	results := pool.Publish(ctx, signedEvent(t, 1, "hello"))
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+
+	for _, result := range results {
+		switch result.Relay {
+		case accepting.URL():
+			if !result.Accepted || result.Error != nil {
+				t.Errorf("expected event to be accepted: %+v", result)
+			}
+		case rejecting.URL():
+			if result.Accepted || result.Message != "blocked: not allowed" {
+				t.Errorf("expected event to be rejected: %+v", result)
+			}
+		default:
+			t.Errorf("unexpected relay %s", result.Relay)
+		}
+	}
+
+	if len(accepting.Events()) != 1 || len(rejecting.Events()) != 0 {
+		t.Error("events were not stored as expected")
+	}
+
+	for _, health := range pool.Health() {
+		if health.EventsPublished != 1 {
+			t.Errorf("%s published count = %d", health.URL, health.EventsPublished)
+		}
+	}
+}
+
+func TestPublishToConnectsForTheEvent(t *testing.T) {
+	first := relaytest.NewRelay()
+	defer first.Close()
+	second := relaytest.NewRelay()
+	defer second.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	results := PublishTo(ctx, []string{first.URL(), second.URL()}, signedEvent(t, 1, "hello"))
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, result := range results {
+		if !result.Accepted {
+			t.Errorf("expected event to be accepted: %+v", result)
+		}
+	}
+
+	if len(first.Events()) != 1 || len(second.Events()) != 1 {
+		t.Error("event was not published to every relay")
+	}
+}
+
+func TestSubscribeWithEOSE(t *testing.T) {
+	relayOne := relaytest.NewRelay()
+	defer relayOne.Close()
+	relayTwo := relaytest.NewRelay()
+	defer relayTwo.Close()
+
+	stored := signedEvent(t, 1311, "stored")
+	relayOne.Store(stored)
+	relayTwo.Store(stored)
+	relayOne.Store(signedEvent(t, 1, "other kind"))
+
+	pool := newTestPool(t, relayOne.URL(), relayTwo.URL())
+	sub := pool.Subscribe(nostr.Filter{Kinds: []int{1311}})
+	defer sub.Close()
+
+	select {
+	case <-sub.EndOfStoredEvents:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for EOSE")
+	}
+
+	// The stored event is sent by both relays but only delivered once.
+	if len(sub.Events) != 1 {
+		t.Fatalf("expected 1 stored event, got %d", len(sub.Events))
+	}
+	if e := <-sub.Events; e.ID != stored.ID {
+		t.Error("unexpected stored event")
+	}
+
+	live := signedEvent(t, 1311, "live")
+	relayTwo.Store(live)
+
+	select {
+	case e := <-sub.Events:
+		if e.ID != live.ID {
+			t.Error("unexpected live event")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for live event")
+	}
+}
+
+func TestReconnectResumesSubscriptions(t *testing.T) {
+	fake := relaytest.NewRelay()
+	defer fake.Close()
+
+	pool := newTestPool(t, fake.URL())
+	sub := pool.Subscribe(nostr.Filter{Kinds: []int{1311}})
+	defer sub.Close()
+
+	fake.DropConnections()
+
+	waitFor(t, "reconnect", func() bool {
+		health := pool.Health()[0]
+		return health.Status == StatusConnected && health.ReconnectAttempts > 0 && fake.ClientCount() == 1
+	})
+
+	event := signedEvent(t, 1311, "after reconnect")
+	// The subscription may not have been replayed the instant the socket opened.
+	waitFor(t, "resubscribed event", func() bool {
+		fake.Store(event)
+		select {
+		case e := <-sub.Events:
+			return e.ID == event.ID
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	})
+}
+
+func TestSetRelaysRemovesRelays(t *testing.T) {
+	fake := relaytest.NewRelay()
+	defer fake.Close()
+
+	pool := newTestPool(t, fake.URL()+"/")
+	if relays := pool.Relays(); len(relays) != 1 || relays[0] != fake.URL() {
+		t.Fatalf("unexpected relays %v", relays)
+	}
+
+	pool.SetRelays(nil)
+	if len(pool.Relays()) != 0 {
+		t.Error("relay was not removed")
+	}
+	waitFor(t, "disconnect", func() bool { return fake.ClientCount() == 0 })
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 4: 8 * time.Second, 20: time.Minute} {
+		got := exponentialBackoff(attempt, time.Second, time.Minute)
+		if got < want || got > want+want/4 {
+			t.Errorf("backoff(%d) = %s, want about %s", attempt, got, want)
+		}
+	}
+}
@@ -0,0 +1,421 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Connection statuses reported in the relay health.
+const (
+	StatusConnecting   = "connecting"
+	StatusConnected    = "connected"
+	StatusDisconnected = "disconnected"
+)
+
+const (
+	dialTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+	pingInterval = 30 * time.Second
+	readTimeout  = 2 * pingInterval
+)
+
+// Health describes the state of a single relay connection.
+type Health struct {
+	ConnectedAt       *time.Time `json:"connectedAt,omitempty"`
+	URL               string     `json:"url"`
+	Status            string     `json:"status"`
+	LastError         string     `json:"lastError,omitempty"`
+	ReconnectAttempts int        `json:"reconnectAttempts"`
+	EventsPublished   int        `json:"eventsPublished"`
+	EventsAccepted    int        `json:"eventsAccepted"`
+	EventsRejected    int        `json:"eventsRejected"`
+	EventsReceived    int        `json:"eventsReceived"`
+}
+
+// PublishResult is the outcome of publishing an event to a single relay.
+type PublishResult struct {
+	Error    error  `json:"-"`
+	Relay    string `json:"relay"`
+	Message  string `json:"message,omitempty"`
+	Accepted bool   `json:"accepted"`
+}
+
+type okResult struct {
+	message  string
+	accepted bool
+}
+
+// Relay is a single persistent relay connection that reconnects with backoff.
+type Relay struct {
+	connectedAt time.Time
+	conn        *websocket.Conn
+	pool        *Pool
+	cancel      context.CancelFunc
+	okWaiters   map[string]chan okResult
+	url         string
+	status      string
+	lastError   string
+	health      Health
+	writeLock   sync.Mutex
+	lock        sync.Mutex
+}
+
+func newRelay(pool *Pool, url string) *Relay {
+	return &Relay{
+		pool:      pool,
+		url:       url,
+		status:    StatusConnecting,
+		okWaiters: map[string]chan okResult{},
+	}
+}
+
+// URL returns the relay websocket URL.
+func (r *Relay) URL() string {
+	return r.url
+}
+
+// Health returns the current connection health of the relay.
+func (r *Relay) Health() Health {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	health := r.health
+	health.URL = r.url
+	health.Status = r.status
+	health.LastError = r.lastError
+	if r.status == StatusConnected {
+		connectedAt := r.connectedAt
+		health.ConnectedAt = &connectedAt
+	}
+
+	return health
+}
+
+// IsConnected returns if the relay currently has an open connection.
+func (r *Relay) IsConnected() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.status == StatusConnected
+}
+
+// start keeps the relay connected until the context is cancelled.
+func (r *Relay) start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	go func() {
+		attempts := 0
+		for {
+			err := r.connect(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err == nil {
+				// We had a working connection, so start backing off from scratch.
+				attempts = 0
+			}
+			attempts++
+
+			r.lock.Lock()
+			r.status = StatusDisconnected
+			r.health.ReconnectAttempts = attempts
+			if err != nil {
+				r.lastError = err.Error()
+			}
+			r.lock.Unlock()
+
+			select {
+			case <-time.After(r.pool.backoff(attempts)):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// stop closes the connection and stops reconnecting.
+func (r *Relay) stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+
+	r.lock.Lock()
+	if r.conn != nil {
+		_ = r.conn.Close()
+	}
+	r.status = StatusDisconnected
+	r.lock.Unlock()
+}
+
+// connect dials the relay and reads from it until the connection drops.
+// A nil error means the connection was established and later closed.
+func (r *Relay) connect(ctx context.Context) error {
+	r.lock.Lock()
+	r.status = StatusConnecting
+	r.lock.Unlock()
+
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	conn, _, err := websocket.DefaultDialer.DialContext(dialCtx, r.url, nil)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	r.conn = conn
+	r.status = StatusConnected
+	r.connectedAt = time.Now()
+	r.lastError = ""
+	r.lock.Unlock()
+
+	log.Debugln("Connected to Nostr relay", r.url)
+
+	// Stop the connection when the relay is removed from the pool.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = conn.Close()
+				return
+			case <-ticker.C:
+				r.writeLock.Lock()
+				_ = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout))
+				r.writeLock.Unlock()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// Resume every active subscription on the new connection.
+	for _, sub := range r.pool.activeSubscriptions() {
+		if err := r.sendREQ(sub); err != nil {
+			log.Debugln("Unable to resubscribe on", r.url, err)
+		}
+	}
+
+	err = r.readLoop(conn)
+
+	r.lock.Lock()
+	r.conn = nil
+	r.lastError = err.Error()
+	for id, waiter := range r.okWaiters {
+		close(waiter)
+		delete(r.okWaiters, id)
+	}
+	r.lock.Unlock()
+
+	r.pool.relayDisconnected(r)
+
+	log.Debugln("Disconnected from Nostr relay", r.url, err)
+	return nil
+}
+
+func (r *Relay) readLoop(conn *websocket.Conn) error {
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(readTimeout))
+	})
+
+	for {
+		var message []json.RawMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return err
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(readTimeout))
+
+		if len(message) < 2 {
+			continue
+		}
+
+		var label string
+		if err := json.Unmarshal(message[0], &label); err != nil {
+			continue
+		}
+
+		switch label {
+		case "EVENT":
+			r.handleEvent(message)
+		case "EOSE":
+			var subID string
+			if json.Unmarshal(message[1], &subID) == nil {
+				r.pool.handleEOSE(subID, r.url)
+			}
+		case "CLOSED":
+			var subID, reason string
+			_ = json.Unmarshal(message[1], &subID)
+			if len(message) > 2 {
+				_ = json.Unmarshal(message[2], &reason)
+			}
+			log.Debugln("Nostr relay", r.url, "closed subscription", subID, reason)
+			r.pool.handleEOSE(subID, r.url)
+		case "OK":
+			r.handleOK(message)
+		case "NOTICE":
+			var notice string
+			_ = json.Unmarshal(message[1], &notice)
+			log.Debugln("Notice from Nostr relay", r.url, notice)
+		}
+	}
+}
+
+func (r *Relay) handleEvent(message []json.RawMessage) {
+	if len(message) < 3 {
+		return
+	}
+
+	var subID string
+	event := &nostr.Event{}
+	if json.Unmarshal(message[1], &subID) != nil || json.Unmarshal(message[2], event) != nil {
+		return
+	}
+
+	// Never trust a relay to have validated what it sends us.
+	if err := event.Verify(); err != nil {
+		log.Debugln("Dropping invalid event from Nostr relay", r.url, err)
+		return
+	}
+
+	r.lock.Lock()
+	r.health.EventsReceived++
+	r.lock.Unlock()
+
+	r.pool.handleEvent(subID, event)
+}
+
+func (r *Relay) handleOK(message []json.RawMessage) {
+	if len(message) < 3 {
+		return
+	}
+
+	var id string
+	var result okResult
+	if json.Unmarshal(message[1], &id) != nil || json.Unmarshal(message[2], &result.accepted) != nil {
+		return
+	}
+	if len(message) > 3 {
+		_ = json.Unmarshal(message[3], &result.message)
+	}
+
+	r.lock.Lock()
+	waiter, ok := r.okWaiters[id]
+	delete(r.okWaiters, id)
+	if result.accepted {
+		r.health.EventsAccepted++
+	} else {
+		r.health.EventsRejected++
+	}
+	r.lock.Unlock()
+
+	if ok {
+		waiter <- result
+	}
+}
+
+// publish sends the event and waits for the relay's OK response.
+func (r *Relay) publish(ctx context.Context, event *nostr.Event) PublishResult {
+	result := PublishResult{Relay: r.url}
+
+	waiter := make(chan okResult, 1)
+	r.lock.Lock()
+	if r.status != StatusConnected {
+		r.lock.Unlock()
+		result.Error = errors.New("relay is not connected")
+		return result
+	}
+	r.okWaiters[event.ID] = waiter
+	r.health.EventsPublished++
+	r.lock.Unlock()
+
+	if err := r.send([]interface{}{"EVENT", event}); err != nil {
+		r.lock.Lock()
+		delete(r.okWaiters, event.ID)
+		r.lock.Unlock()
+		result.Error = err
+		return result
+	}
+
+	select {
+	case ok, open := <-waiter:
+		if !open {
+			result.Error = errors.New("relay disconnected before acknowledging the event")
+			return result
+		}
+		result.Accepted = ok.accepted
+		result.Message = ok.message
+		if !ok.accepted {
+			result.Error = errors.New("event rejected: " + ok.message)
+		}
+	case <-ctx.Done():
+		r.lock.Lock()
+		delete(r.okWaiters, event.ID)
+		r.lock.Unlock()
+		result.Error = errors.Wrap(ctx.Err(), "no acknowledgement from relay")
+	}
+
+	return result
+}
+
+func (r *Relay) sendREQ(sub *Subscription) error {
+	message := []interface{}{"REQ", sub.ID}
+	for _, filter := range sub.Filters {
+		message = append(message, filter)
+	}
+
+	if err := r.send(message); err != nil {
+		return err
+	}
+
+	sub.sentTo(r.url)
+	return nil
+}
+
+func (r *Relay) sendCLOSE(subID string) error {
+	return r.send([]interface{}{"CLOSE", subID})
+}
+
+func (r *Relay) send(message interface{}) error {
+	r.lock.Lock()
+	conn := r.conn
+	r.lock.Unlock()
+
+	if conn == nil {
+		return errors.New("relay is not connected")
+	}
+
+	r.writeLock.Lock()
+	defer r.writeLock.Unlock()
+
+	_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return conn.WriteJSON(message)
+}
+
+// exponentialBackoff returns the delay before the given reconnect attempt,
+// doubling from min up to max with some jitter so relays are not hammered
+// in lockstep.
+func exponentialBackoff(attempt int, minDelay, maxDelay time.Duration) time.Duration {
+	delay := minDelay
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	// nolint:gosec
+	jitter := time.Duration(rand.Int63n(int64(delay)/4 + 1))
+	return delay + jitter
+}
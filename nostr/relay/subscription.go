@@ -0,0 +1,105 @@
+package relay
+
+import (
+	"sync"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	log "github.com/sirupsen/logrus"
+)
+
+// How many event IDs a subscription remembers for de-duplication.
+const maxSeenEvents = 10000
+
+// Subscription is a REQ subscription spread across the relays of a pool.
+// Events are de-duplicated across relays. EndOfStoredEvents is closed once
+// every relay the subscription was sent to has signalled EOSE.
+type Subscription struct {
+	Events            chan *nostr.Event
+	EndOfStoredEvents chan struct{}
+	pool              *Pool
+	seen              map[string]struct{}
+	pending           map[string]struct{}
+	ID                string
+	Filters           []nostr.Filter
+	eose              bool
+	closed            bool
+	lock              sync.Mutex
+}
+
+// Close stops the subscription on every relay.
+func (s *Subscription) Close() {
+	s.pool.unsubscribe(s)
+	s.closeChannels()
+}
+
+func (s *Subscription) closeChannels() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	if !s.eose {
+		s.eose = true
+		close(s.EndOfStoredEvents)
+	}
+	close(s.Events)
+}
+
+// sentTo records a relay the REQ was sent to and that still owes an EOSE.
+func (s *Subscription) sentTo(url string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.eose {
+		s.pending[url] = struct{}{}
+	}
+}
+
+func (s *Subscription) receivedEOSE(url string) {
+	s.lock.Lock()
+	delete(s.pending, url)
+	s.lock.Unlock()
+
+	s.checkEOSE()
+}
+
+func (s *Subscription) checkEOSE() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.eose || s.closed || len(s.pending) > 0 {
+		return
+	}
+
+	s.eose = true
+	close(s.EndOfStoredEvents)
+}
+
+func (s *Subscription) dispatch(event *nostr.Event) {
+	if !nostr.MatchesAny(s.Filters, event) {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	if _, seen := s.seen[event.ID]; seen {
+		return
+	}
+	if len(s.seen) >= maxSeenEvents {
+		s.seen = map[string]struct{}{}
+	}
+	s.seen[event.ID] = struct{}{}
+
+	// Never block the relay read loop on a slow consumer.
+	select {
+	case s.Events <- event:
+	default:
+		log.Warnln("Nostr subscription", s.ID, "is full, dropping event", event.ID)
+	}
+}
@@ -0,0 +1,282 @@
+package relayserver
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Time allowed to write a message to the client.
+	writeWait = 10 * time.Second
+
+	// Time allowed to read the next pong message from the client.
+	pongWait = 60 * time.Second
+
+	// Send pings to the client with this period. Must be less than pongWait.
+	pingPeriod = (pongWait * 9) / 10
+
+	// How many messages may queue for a client before it is disconnected,
+	// enough for the results of a REQ.
+	sendBufferSize = 2 * maxLimit
+)
+
+// client is a single websocket connection to the relay.
+type client struct {
+	server        *Server
+	conn          *websocket.Conn
+	outbound      chan []byte
+	done          chan struct{}
+	rateLimiter   *rate.Limiter
+	subscriptions map[string][]nostr.Filter
+	pubkeys       map[string]struct{}
+	challenge     string
+	ipAddress     string
+	relayURLs     []string
+	closeOnce     sync.Once
+	lock          sync.Mutex
+}
+
+func newClient(server *Server, conn *websocket.Conn, ipAddress string, relayURLs []string) *client {
+	challenge, _ := utils.GenerateRandomString(16)
+
+	return &client{
+		server:   server,
+		conn:     conn,
+		outbound: make(chan []byte, sendBufferSize),
+		done:     make(chan struct{}),
+		// Allow a burst of 10 events, then one every two seconds.
+		rateLimiter:   rate.NewLimiter(rate.Every(2*time.Second), 10),
+		subscriptions: map[string][]nostr.Filter{},
+		pubkeys:       map[string]struct{}{},
+		challenge:     challenge,
+		ipAddress:     ipAddress,
+		relayURLs:     relayURLs,
+	}
+}
+
+func (c *client) readPump() {
+	defer c.close()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error { _ = c.conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
+
+	c.send("AUTH", c.challenge)
+
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var envelope []json.RawMessage
+		if err := json.Unmarshal(message, &envelope); err != nil || len(envelope) < 2 {
+			c.send("NOTICE", "error: unable to parse the message")
+			continue
+		}
+
+		var label string
+		_ = json.Unmarshal(envelope[0], &label)
+
+		switch label {
+		case "EVENT":
+			c.handleEvent(envelope[1])
+		case "REQ":
+			c.handleREQ(envelope[1:])
+		case "CLOSE":
+			var subID string
+			_ = json.Unmarshal(envelope[1], &subID)
+			c.lock.Lock()
+			delete(c.subscriptions, subID)
+			c.lock.Unlock()
+		case "AUTH":
+			c.handleAUTH(envelope[1])
+		default:
+			c.send("NOTICE", "error: unknown message type "+label)
+		}
+	}
+}
+
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.close()
+	}()
+
+	for {
+		select {
+		case message := <-c.outbound:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *client) handleEvent(raw json.RawMessage) {
+	event := &nostr.Event{}
+	if err := json.Unmarshal(raw, event); err != nil {
+		c.send("NOTICE", "invalid: unable to parse the event")
+		return
+	}
+
+	if err := event.Verify(); err != nil {
+		c.send("OK", event.ID, false, "invalid: "+err.Error())
+		return
+	}
+
+	if !c.isAuthenticatedAs(event.PubKey) {
+		c.send("OK", event.ID, false, "auth-required: authenticate as the author to publish")
+		return
+	}
+
+	if !c.rateLimiter.Allow() {
+		c.send("OK", event.ID, false, "rate-limited: slow down")
+		return
+	}
+
+	if reason := c.server.refuse(event); reason != "" {
+		c.send("OK", event.ID, false, reason)
+		return
+	}
+
+	stored, err := c.server.accept(event)
+	if err != nil {
+		log.Errorln("Unable to store Nostr event on the built-in relay", err)
+		c.send("OK", event.ID, false, "error: unable to store the event")
+		return
+	}
+	if !stored {
+		c.send("OK", event.ID, true, "duplicate: already have this event")
+		return
+	}
+
+	c.send("OK", event.ID, true, "")
+}
+
+func (c *client) handleREQ(args []json.RawMessage) {
+	var subID string
+	if err := json.Unmarshal(args[0], &subID); err != nil || subID == "" {
+		c.send("NOTICE", "invalid: subscription id must be a string")
+		return
+	}
+
+	if len(args)-1 > maxFilters {
+		c.send("CLOSED", subID, "error: too many filters")
+		return
+	}
+
+	filters := make([]nostr.Filter, 0, len(args)-1)
+	for _, raw := range args[1:] {
+		var filter nostr.Filter
+		if err := json.Unmarshal(raw, &filter); err != nil {
+			c.send("CLOSED", subID, "invalid: unable to parse the filter")
+			return
+		}
+		filters = append(filters, filter)
+	}
+
+	c.lock.Lock()
+	_, replacing := c.subscriptions[subID]
+	if !replacing && len(c.subscriptions) >= maxSubscriptions {
+		c.lock.Unlock()
+		c.send("CLOSED", subID, "error: too many subscriptions")
+		return
+	}
+	c.subscriptions[subID] = filters
+	c.lock.Unlock()
+
+	for _, event := range c.server.Query(filters) {
+		c.send("EVENT", subID, event)
+	}
+	c.send("EOSE", subID)
+}
+
+func (c *client) handleAUTH(raw json.RawMessage) {
+	event := &nostr.Event{}
+	if err := json.Unmarshal(raw, event); err != nil {
+		c.send("NOTICE", "invalid: unable to parse the auth event")
+		return
+	}
+
+	if err := nostr.VerifyClientAuth(event, c.challenge, c.relayURLs); err != nil {
+		c.send("OK", event.ID, false, "auth-required: "+err.Error())
+		return
+	}
+
+	if c.server.isBanned != nil && c.server.isBanned(event.PubKey) {
+		c.send("OK", event.ID, false, "blocked: you are banned from this stream")
+		return
+	}
+
+	c.lock.Lock()
+	c.pubkeys[event.PubKey] = struct{}{}
+	c.lock.Unlock()
+
+	c.send("OK", event.ID, true, "")
+}
+
+func (c *client) isAuthenticatedAs(pubkey string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, ok := c.pubkeys[pubkey]
+	return ok
+}
+
+// broadcast sends a newly stored event to the client's matching subscriptions.
+func (c *client) broadcast(event *nostr.Event) {
+	c.lock.Lock()
+	matching := []string{}
+	for subID, filters := range c.subscriptions {
+		if nostr.MatchesAny(filters, event) {
+			matching = append(matching, subID)
+		}
+	}
+	c.lock.Unlock()
+
+	for _, subID := range matching {
+		c.send("EVENT", subID, event)
+	}
+}
+
+// send queues a message for the client, disconnecting clients that don't
+// keep up.
+func (c *client) send(message ...interface{}) {
+	b, err := json.Marshal(message)
+	if err != nil {
+		log.Debugln(err)
+		return
+	}
+
+	select {
+	case <-c.done:
+	case c.outbound <- b:
+	default:
+		log.Debugln("Nostr relay client", c.ipAddress, "is not keeping up, disconnecting")
+		c.close()
+	}
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		_ = c.conn.Close()
+	})
+}
@@ -0,0 +1,86 @@
+package relayserver
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+)
+
+// The kinds the server itself publishes and the relay keeps.
+var serverKinds = map[int]bool{
+	nostr.KindProfileMetadata:  true,
+	nostr.KindDeletion:         true,
+	nostr.KindLiveChatMessage:  true,
+	nostr.KindLiveEvent:        true,
+	nostr.KindVideo:            true,
+	nostr.KindShortVideo:       true,
+	nostr.KindAddressableVideo: true,
+}
+
+// refuse returns why the event does not belong on the relay, prefixed as
+// NIP-01 describes, or an empty string when it does.
+func (s *Server) refuse(event *nostr.Event) string {
+	serverPubkey := identity.PublicKey()
+	if serverPubkey == "" {
+		return "error: the server has no Nostr identity"
+	}
+
+	if event.PubKey == serverPubkey {
+		if !serverKinds[event.Kind] {
+			return "blocked: this relay only keeps events about the stream"
+		}
+		return ""
+	}
+
+	if s.isBanned != nil && s.isBanned(event.PubKey) {
+		return "blocked: you are banned from this stream"
+	}
+
+	if !s.isRelevant(event, serverPubkey) {
+		return "blocked: this relay only keeps events about the stream"
+	}
+
+	return ""
+}
+
+// isRelevant returns if an event by somebody else is about the stream.
+func (s *Server) isRelevant(event *nostr.Event, serverPubkey string) bool {
+	switch event.Kind {
+	case nostr.KindLiveChatMessage:
+		return referencesActivity(event, serverPubkey)
+	case nostr.KindZapReceipt:
+		return referencesActivity(event, serverPubkey) || event.Tags.Value("p") == serverPubkey
+	case nostr.KindReaction:
+		return referencesActivity(event, serverPubkey) || s.store.has(referencedIDs(event), "")
+	case nostr.KindDeletion:
+		// Only for events the author has on the relay.
+		return s.store.has(nostr.DeletedEventIDs(event), event.PubKey)
+	default:
+		return false
+	}
+}
+
+// referencesActivity returns if the event has an "a" tag pointing at one
+// of the server's live activities.
+func referencesActivity(event *nostr.Event, serverPubkey string) bool {
+	prefix := strconv.Itoa(nostr.KindLiveEvent) + ":" + serverPubkey + ":"
+	for _, tag := range event.Tags.GetAll("a") {
+		if strings.HasPrefix(tag.Value(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedIDs returns the IDs of the events a reaction refers to.
+func referencedIDs(event *nostr.Event) []string {
+	ids := []string{}
+	for _, tag := range event.Tags.GetAll("e") {
+		if id := tag.Value(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
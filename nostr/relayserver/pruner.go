@@ -0,0 +1,50 @@
+package relayserver
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How often old events are removed.
+	pruneInterval = 5 * time.Minute
+
+	// How long events by others are kept, and how many of them at most.
+	// The server's own events are kept for as long as it has them.
+	maxEventAge     = 7 * 24 * time.Hour
+	maxStoredEvents = 20000
+)
+
+// prune removes old events so the relay doesn't keep more data than
+// needed for privacy and efficiency reasons.
+func (s *store) prune(serverPubkey string) {
+	s.datastore.DbLock.Lock()
+	defer s.datastore.DbLock.Unlock()
+
+	log.Traceln("Removing Nostr relay events older than", maxEventAge)
+
+	tx, err := s.datastore.DB.Begin()
+	if err != nil {
+		log.Debugln(err)
+		return
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	cutoff := time.Now().Add(-maxEventAge).Unix()
+	if err := deleteEvents(tx, `pubkey != ? AND received_at <= ?`, serverPubkey, cutoff); err != nil {
+		log.Debugln(err)
+		return
+	}
+
+	if err := deleteEvents(tx, `id IN (SELECT id FROM nostr_relay_events WHERE pubkey != ?
+		ORDER BY created_at DESC LIMIT -1 OFFSET ?)`, serverPubkey, maxStoredEvents); err != nil {
+		log.Debugln(err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Debugln(err)
+		return
+	}
+}
@@ -0,0 +1,335 @@
+// Package relayserver runs a minimal NIP-01 relay on the web server that
+// only stores and serves events related to this server's stream: its live
+// activities, the chat replies, zap receipts and reactions sent to them.
+package relayserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/authrepository"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Path is where the relay is served on the web server.
+const Path = "/nostr"
+
+const (
+	// The largest websocket message a client may send.
+	maxMessageSize = 64 * 1024
+
+	// The most open subscriptions per client and filters per subscription.
+	maxSubscriptions = 20
+	maxFilters       = 10
+
+	// The most events returned for a single REQ.
+	maxLimit = 500
+
+	// The most clients connected at the same time.
+	maxClients = 1000
+)
+
+var (
+	_server     *Server
+	_serverLock sync.Mutex
+)
+
+// Server is the built-in relay.
+type Server struct {
+	store    *store
+	isBanned func(pubkey string) bool
+	clients  map[*client]struct{}
+	upgrader websocket.Upgrader
+	enabled  bool
+	lock     sync.Mutex
+}
+
+// Setup will prepare the built-in relay, starting it if it is enabled.
+// isBanned reports if a pubkey may no longer write to or be read from
+// the relay.
+func Setup(isBanned func(pubkey string) bool) {
+	s := &Server{
+		store:    newStore(data.GetDatastore()),
+		isBanned: isBanned,
+		clients:  map[*client]struct{}{},
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+
+			// Nostr clients connect from anywhere.
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	_serverLock.Lock()
+	_server = s
+	_serverLock.Unlock()
+
+	s.SetEnabled(configrepository.Get().GetNostrRelayEnabled())
+
+	pruner := time.NewTicker(pruneInterval)
+	go func() {
+		s.store.prune(identity.PublicKey())
+		for range pruner.C {
+			s.store.prune(identity.PublicKey())
+		}
+	}()
+}
+
+// Get returns the built-in relay, or nil before Setup.
+func Get() *Server {
+	_serverLock.Lock()
+	defer _serverLock.Unlock()
+
+	return _server
+}
+
+// SetEnabled will start or stop accepting connections. While enabled the
+// relay pool stores every event it publishes or receives for the stream
+// on the built-in relay and advertises it to Nostr clients.
+func (s *Server) SetEnabled(enabled bool) {
+	s.lock.Lock()
+	s.enabled = enabled
+	clients := s.clientList()
+	s.lock.Unlock()
+
+	if enabled {
+		relay.Get().SetLocal(s)
+		return
+	}
+
+	relay.Get().SetLocal(nil)
+	for _, c := range clients {
+		c.close()
+	}
+}
+
+// IsEnabled returns if the relay is accepting connections.
+func (s *Server) IsEnabled() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.enabled
+}
+
+// URL returns the public websocket URL of the relay, or an empty string
+// when the server URL is not set.
+func (s *Server) URL() string {
+	serverURL := strings.TrimSuffix(configrepository.Get().GetServerURL(), "/")
+	switch {
+	case strings.HasPrefix(serverURL, "https://"):
+		return "wss://" + strings.TrimPrefix(serverURL, "https://") + Path
+	case strings.HasPrefix(serverURL, "http://"):
+		return "ws://" + strings.TrimPrefix(serverURL, "http://") + Path
+	default:
+		return ""
+	}
+}
+
+// Publish will store an event the server published or received from
+// another relay and send it to the matching subscriptions of connected
+// clients. The event must have been verified already.
+func (s *Server) Publish(event *nostr.Event) error {
+	if reason := s.refuse(event); reason != "" {
+		return errors.New(reason)
+	}
+
+	stored, err := s.store.save(event)
+	if err != nil {
+		return err
+	}
+	if stored {
+		s.broadcast(event)
+	}
+
+	return nil
+}
+
+// Query returns the stored events matching the filters, newest first.
+func (s *Server) Query(filters []nostr.Filter) []*nostr.Event {
+	events, err := s.store.query(filters)
+	if err != nil {
+		log.Debugln("Unable to query the built-in Nostr relay", err)
+		return nil
+	}
+
+	return s.withoutBanned(events)
+}
+
+// HandleConnection will serve the relay to websocket clients and its NIP-11
+// information document to everyone else.
+func HandleConnection(w http.ResponseWriter, r *http.Request) {
+	s := Get()
+	if s == nil || !s.IsEnabled() {
+		http.NotFound(w, r)
+		return
+	}
+
+	if !websocket.IsWebSocketUpgrade(r) {
+		s.writeInformation(w, r)
+		return
+	}
+
+	ipAddress := utils.GetIPAddressFromRequest(r)
+	if blocked, err := authrepository.Get().IsIPAddressBanned(ipAddress); blocked {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	} else if err != nil {
+		log.Errorln("error determining if IP address is blocked: ", err)
+	}
+
+	s.lock.Lock()
+	full := len(s.clients) >= maxClients
+	s.lock.Unlock()
+	if full {
+		log.Warnln("rejecting Nostr relay connection as it exceeds the max client count of", maxClients)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debugln(err)
+		return
+	}
+
+	// Clients must authenticate for the configured URL of the relay, so
+	// events signed for another relay are not accepted here.
+	relayURLs := []string{s.URL()}
+	if relayURLs[0] == "" {
+		relayURLs = []string{nostr.RequestURL(r)}
+	}
+	c := newClient(s, conn, ipAddress, relayURLs)
+
+	s.lock.Lock()
+	s.clients[c] = struct{}{}
+	s.lock.Unlock()
+
+	go c.writePump()
+	c.readPump()
+
+	s.lock.Lock()
+	delete(s.clients, c)
+	s.lock.Unlock()
+}
+
+// accept stores an event a client published and hands it to the rest of
+// the server as if another relay had sent it.
+func (s *Server) accept(event *nostr.Event) (bool, error) {
+	stored, err := s.store.save(event)
+	if err != nil || !stored {
+		return stored, err
+	}
+
+	s.broadcast(event)
+	relay.Get().Deliver(event)
+
+	return true, nil
+}
+
+func (s *Server) broadcast(event *nostr.Event) {
+	s.lock.Lock()
+	clients := s.clientList()
+	s.lock.Unlock()
+
+	for _, c := range clients {
+		c.broadcast(event)
+	}
+}
+
+// withoutBanned drops the events written by banned pubkeys.
+func (s *Server) withoutBanned(events []*nostr.Event) []*nostr.Event {
+	serverPubkey := identity.PublicKey()
+	banned := map[string]bool{}
+
+	allowed := make([]*nostr.Event, 0, len(events))
+	for _, event := range events {
+		if event.PubKey != serverPubkey {
+			isBanned, checked := banned[event.PubKey]
+			if !checked {
+				isBanned = s.isBanned != nil && s.isBanned(event.PubKey)
+				banned[event.PubKey] = isBanned
+			}
+			if isBanned {
+				continue
+			}
+		}
+		allowed = append(allowed, event)
+	}
+
+	return allowed
+}
+
+// Must be called with the lock held.
+func (s *Server) clientList() []*client {
+	clients := make([]*client, 0, len(s.clients))
+	for c := range s.clients {
+		clients = append(clients, c)
+	}
+	return clients
+}
+
+type information struct {
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Pubkey        string      `json:"pubkey,omitempty"`
+	Software      string      `json:"software"`
+	Version       string      `json:"version"`
+	SupportedNIPs []int       `json:"supported_nips"`
+	Limitation    limitations `json:"limitation"`
+}
+
+type limitations struct {
+	MaxMessageLength int  `json:"max_message_length"`
+	MaxSubscriptions int  `json:"max_subscriptions"`
+	MaxFilters       int  `json:"max_filters"`
+	MaxLimit         int  `json:"max_limit"`
+	AuthRequired     bool `json:"auth_required"`
+	RestrictedWrites bool `json:"restricted_writes"`
+}
+
+// writeInformation writes the NIP-11 relay information document.
+func (s *Server) writeInformation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Headers", "Accept")
+	w.Header().Set("Access-Control-Allow-Methods", "GET")
+	if r.Method == http.MethodOptions {
+		return
+	}
+
+	configRepository := configrepository.Get()
+	info := information{
+		Name:          configRepository.GetServerName(),
+		Description:   "Live chat, zaps and reactions for the stream at " + configRepository.GetServerURL(),
+		Pubkey:        identity.PublicKey(),
+		Software:      "https://github.com/TekkadanPlays/oni",
+		Version:       config.VersionNumber,
+		SupportedNIPs: []int{1, 9, 11, 42},
+		Limitation: limitations{
+			MaxMessageLength: maxMessageSize,
+			MaxSubscriptions: maxSubscriptions,
+			MaxFilters:       maxFilters,
+			MaxLimit:         maxLimit,
+			RestrictedWrites: true,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/nostr+json")
+	if err := json.NewEncoder(w).Encode(info); err != nil {
+		log.Debugln(err)
+	}
+}
@@ -0,0 +1,291 @@
+package relayserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/gorilla/websocket"
+)
+
+var bannedPubkey string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-relayserver-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	config.NostrKeyFile = filepath.Join(dir, "nostr.secret")
+	if err := identity.Setup(); err != nil {
+		panic(err)
+	}
+	_ = configrepository.Get().SetServerURL("https://oni.example.com")
+	_ = configrepository.Get().SetNostrRelayEnabled(true)
+
+	Setup(func(pubkey string) bool { return pubkey == bannedPubkey })
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+type testClient struct {
+	t         *testing.T
+	conn      *websocket.Conn
+	challenge string
+}
+
+func connect(t *testing.T) *testClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(HandleConnection))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+Path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	c := &testClient{t: t, conn: conn}
+	_ = json.Unmarshal(c.read("AUTH")[1], &c.challenge)
+
+	return c
+}
+
+// read returns the next message with the label, skipping any others.
+func (c *testClient) read(label string) []json.RawMessage {
+	c.t.Helper()
+
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var message []json.RawMessage
+		if err := c.conn.ReadJSON(&message); err != nil {
+			c.t.Fatalf("waiting for %s: %v", label, err)
+		}
+		var got string
+		_ = json.Unmarshal(message[0], &got)
+		if got == label {
+			return message
+		}
+	}
+}
+
+// publish sends the event and returns if the relay accepted it and why not.
+func (c *testClient) publish(label string, event *nostr.Event) (bool, string) {
+	c.t.Helper()
+
+	if err := c.conn.WriteJSON([]interface{}{label, event}); err != nil {
+		c.t.Fatal(err)
+	}
+
+	ok := c.read("OK")
+	var accepted bool
+	var reason string
+	_ = json.Unmarshal(ok[2], &accepted)
+	_ = json.Unmarshal(ok[3], &reason)
+
+	return accepted, reason
+}
+
+func (c *testClient) authenticate(privateKey string) (bool, string) {
+	c.t.Helper()
+
+	auth := nostr.ClientAuthEvent("wss://oni.example.com/nostr", c.challenge)
+	if err := auth.Sign(privateKey); err != nil {
+		c.t.Fatal(err)
+	}
+
+	return c.publish("AUTH", auth)
+}
+
+func (c *testClient) query(filter nostr.Filter) []*nostr.Event {
+	c.t.Helper()
+
+	if err := c.conn.WriteJSON([]interface{}{"REQ", "test", filter}); err != nil {
+		c.t.Fatal(err)
+	}
+
+	events := []*nostr.Event{}
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		var message []json.RawMessage
+		if err := c.conn.ReadJSON(&message); err != nil {
+			c.t.Fatal(err)
+		}
+		var label string
+		_ = json.Unmarshal(message[0], &label)
+		switch label {
+		case "EVENT":
+			event := &nostr.Event{}
+			_ = json.Unmarshal(message[2], event)
+			events = append(events, event)
+		case "EOSE":
+			_ = c.conn.WriteJSON([]interface{}{"CLOSE", "test"})
+			return events
+		}
+	}
+}
+
+func signed(t *testing.T, privateKey string, event *nostr.Event) *nostr.Event {
+	t.Helper()
+
+	if event.CreatedAt == 0 {
+		event.CreatedAt = time.Now().Unix()
+	}
+	if err := event.Sign(privateKey); err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+func TestRelay(t *testing.T) {
+	if relays := relay.Get().Relays(); len(relays) != 1 || relays[0] != "wss://oni.example.com/nostr" {
+		t.Fatalf("expected the built-in relay to be advertised, got %v", relays)
+	}
+
+	// The server's live activity is stored when it is published.
+	activity := &nostr.LiveActivity{Identifier: "oni-test", Starts: time.Now(), Status: nostr.LiveStatusLive}
+	activityEvent := activity.Event()
+	if err := identity.Sign(activityEvent); err != nil {
+		t.Fatal(err)
+	}
+	results := relay.Get().Publish(context.Background(), activityEvent)
+	if len(results) != 1 || !results[0].Accepted {
+		t.Fatalf("expected the built-in relay to accept the live activity, got %+v", results)
+	}
+	address := activity.Address(identity.PublicKey())
+
+	bridge := relay.Get().Subscribe(nostr.Filter{Kinds: []int{nostr.KindLiveChatMessage}})
+	defer bridge.Close()
+
+	privateKey, _ := nostr.GeneratePrivateKey()
+	message := signed(t, privateKey, &nostr.Event{
+		Kind:    nostr.KindLiveChatMessage,
+		Tags:    nostr.Tags{{"a", address}},
+		Content: "hello from nostr",
+	})
+
+	c := connect(t)
+	if accepted, reason := c.publish("EVENT", message); accepted || !strings.HasPrefix(reason, "auth-required:") {
+		t.Errorf("expected writes to require auth, got %v %q", accepted, reason)
+	}
+
+	if accepted, reason := c.authenticate(privateKey); !accepted {
+		t.Fatalf("expected auth to succeed, got %q", reason)
+	}
+	if accepted, reason := c.publish("EVENT", message); !accepted {
+		t.Fatalf("expected the chat message to be accepted, got %q", reason)
+	}
+
+	select {
+	case event := <-bridge.Events:
+		if event.ID != message.ID {
+			t.Errorf("expected the chat message on the pool subscription, got %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the chat message on the pool subscription")
+	}
+
+	unrelated := signed(t, privateKey, &nostr.Event{Kind: 1, Content: "not about the stream"})
+	if accepted, reason := c.publish("EVENT", unrelated); accepted || !strings.HasPrefix(reason, "blocked:") {
+		t.Errorf("expected an unrelated event to be blocked, got %v %q", accepted, reason)
+	}
+
+	otherKey, _ := nostr.GeneratePrivateKey()
+	impersonated := signed(t, otherKey, &nostr.Event{
+		Kind:    nostr.KindLiveChatMessage,
+		Tags:    nostr.Tags{{"a", address}},
+		Content: "not authenticated as this author",
+	})
+	if accepted, _ := c.publish("EVENT", impersonated); accepted {
+		t.Error("expected events by another author to require auth")
+	}
+
+	reaction := signed(t, privateKey, &nostr.Event{
+		Kind:    nostr.KindReaction,
+		Tags:    nostr.Tags{{"e", message.ID}},
+		Content: "+",
+	})
+	if accepted, reason := c.publish("EVENT", reaction); !accepted {
+		t.Errorf("expected a reaction to a stored event to be accepted, got %q", reason)
+	}
+
+	events := c.query(nostr.Filter{Tags: map[string][]string{"a": {address}}})
+	if len(events) != 1 || events[0].ID != message.ID {
+		t.Fatalf("expected the chat message, got %+v", events)
+	}
+
+	deletion := nostr.DeletionRequest([]string{message.ID}, nostr.KindLiveChatMessage, "")
+	signed(t, privateKey, deletion)
+	if accepted, reason := c.publish("EVENT", deletion); !accepted {
+		t.Fatalf("expected the deletion request to be accepted, got %q", reason)
+	}
+	if events := c.query(nostr.Filter{Kinds: []int{nostr.KindLiveChatMessage}}); len(events) != 0 {
+		t.Errorf("expected the chat message to be deleted, got %+v", events)
+	}
+	c.publish("EVENT", message)
+	if events := c.query(nostr.Filter{IDs: []string{message.ID}}); len(events) != 0 {
+		t.Error("expected a deleted event not to be stored again")
+	}
+}
+
+func TestRelayBans(t *testing.T) {
+	privateKey, _ := nostr.GeneratePrivateKey()
+	pubkey, _ := nostr.GetPublicKey(privateKey)
+	bannedPubkey = pubkey
+	defer func() { bannedPubkey = "" }()
+
+	c := connect(t)
+	if accepted, reason := c.authenticate(privateKey); accepted || !strings.HasPrefix(reason, "blocked:") {
+		t.Errorf("expected a banned pubkey not to authenticate, got %v %q", accepted, reason)
+	}
+
+	message := signed(t, privateKey, &nostr.Event{
+		Kind:    nostr.KindLiveChatMessage,
+		Tags:    nostr.Tags{{"a", "30311:" + identity.PublicKey() + ":oni-test"}},
+		Content: "banned",
+	})
+	if err := Get().Publish(message); err == nil {
+		t.Error("expected events by a banned pubkey to be refused")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	s := Get()
+	privateKey, _ := nostr.GeneratePrivateKey()
+	message := signed(t, privateKey, &nostr.Event{
+		Kind:    nostr.KindLiveChatMessage,
+		Tags:    nostr.Tags{{"a", "30311:" + identity.PublicKey() + ":oni-old"}},
+		Content: "old news",
+	})
+	if err := s.Publish(message); err != nil {
+		t.Fatal(err)
+	}
+
+	old := time.Now().Add(-maxEventAge - time.Hour).Unix()
+	if _, err := s.store.datastore.DB.Exec(`UPDATE nostr_relay_events SET received_at = ? WHERE id = ?`, old, message.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	s.store.prune(identity.PublicKey())
+	if s.store.has([]string{message.ID}, "") {
+		t.Error("expected the old event to be pruned")
+	}
+}
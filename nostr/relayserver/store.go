@@ -0,0 +1,271 @@
+package relayserver
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/pkg/errors"
+)
+
+// store keeps the relay's events in the server database.
+type store struct {
+	datastore *data.Datastore
+}
+
+func newStore(datastore *data.Datastore) *store {
+	return &store{datastore: datastore}
+}
+
+func isReplaceable(kind int) bool {
+	return kind == nostr.KindProfileMetadata || kind == 3 || (kind >= 10000 && kind < 20000)
+}
+
+func isAddressable(kind int) bool {
+	return kind >= 30000 && kind < 40000
+}
+
+// save stores the event, replacing older versions of replaceable events and
+// applying deletion requests. It returns false if the event was already
+// stored, was deleted by its author or is older than the stored version.
+func (s *store) save(event *nostr.Event) (bool, error) {
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return false, err
+	}
+
+	s.datastore.DbLock.Lock()
+	defer s.datastore.DbLock.Unlock()
+
+	tx, err := s.datastore.DB.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	if exists(tx, `SELECT 1 FROM nostr_relay_events WHERE id = ?`, event.ID) {
+		return false, nil
+	}
+
+	if event.Kind != nostr.KindDeletion && exists(tx, `SELECT 1 FROM nostr_relay_tags t
+		INNER JOIN nostr_relay_events e ON e.id = t.event_id
+		WHERE t.name = 'e' AND t.value = ? AND e.kind = ? AND e.pubkey = ?`, event.ID, nostr.KindDeletion, event.PubKey) {
+		return false, nil
+	}
+
+	dTag := ""
+	if isAddressable(event.Kind) {
+		dTag = event.Tags.Value("d")
+	}
+	if isReplaceable(event.Kind) || isAddressable(event.Kind) {
+		if exists(tx, `SELECT 1 FROM nostr_relay_events WHERE pubkey = ? AND kind = ? AND d_tag = ?
+			AND (created_at > ? OR (created_at = ? AND id < ?))`,
+			event.PubKey, event.Kind, dTag, event.CreatedAt, event.CreatedAt, event.ID) {
+			return false, nil
+		}
+		if err := deleteEvents(tx, `pubkey = ? AND kind = ? AND d_tag = ?`, event.PubKey, event.Kind, dTag); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := tx.Exec(`INSERT INTO nostr_relay_events (id, pubkey, kind, created_at, d_tag, raw, received_at)
+		VALUES (?, ?, ?, ?, ?, ?, strftime('%s', 'now'))`,
+		event.ID, event.PubKey, event.Kind, event.CreatedAt, dTag, string(raw)); err != nil {
+		return false, err
+	}
+
+	// Only single letter tags can be queried.
+	for _, tag := range event.Tags {
+		if len(tag.Key()) != 1 || len(tag) < 2 {
+			continue
+		}
+		if _, err := tx.Exec(`INSERT INTO nostr_relay_tags (event_id, name, value) VALUES (?, ?, ?)`,
+			event.ID, tag.Key(), tag.Value()); err != nil {
+			return false, err
+		}
+	}
+
+	if event.Kind == nostr.KindDeletion {
+		if err := applyDeletion(tx, event); err != nil {
+			return false, err
+		}
+	}
+
+	return true, tx.Commit()
+}
+
+// applyDeletion removes the events a NIP-09 deletion request refers to,
+// as long as they were written by its author.
+func applyDeletion(tx *sql.Tx, deletion *nostr.Event) error {
+	if ids := nostr.DeletedEventIDs(deletion); len(ids) > 0 {
+		args := []interface{}{deletion.PubKey, nostr.KindDeletion}
+		for _, id := range ids {
+			args = append(args, id)
+		}
+		if err := deleteEvents(tx, `pubkey = ? AND kind != ? AND id IN (`+placeholders(len(ids))+`)`, args...); err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range deletion.Tags.GetAll("a") {
+		parts := strings.SplitN(tag.Value(), ":", 3)
+		if len(parts) != 3 || parts[1] != deletion.PubKey {
+			continue
+		}
+		kind, err := strconv.Atoi(parts[0])
+		if err != nil || !isAddressable(kind) {
+			continue
+		}
+		if err := deleteEvents(tx, `pubkey = ? AND kind = ? AND d_tag = ? AND created_at <= ?`,
+			deletion.PubKey, kind, parts[2], deletion.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// query returns the stored events matching any of the filters, newest
+// first and no more than maxLimit of them.
+func (s *store) query(filters []nostr.Filter) ([]*nostr.Event, error) {
+	seen := map[string]bool{}
+	events := []*nostr.Event{}
+
+	for _, filter := range filters {
+		matching, err := s.queryFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range matching {
+			if !seen[event.ID] {
+				seen[event.ID] = true
+				events = append(events, event)
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt > events[j].CreatedAt
+	})
+	if len(events) > maxLimit {
+		events = events[:maxLimit]
+	}
+
+	return events, nil
+}
+
+func (s *store) queryFilter(filter nostr.Filter) ([]*nostr.Event, error) {
+	conditions := []string{}
+	args := []interface{}{}
+
+	in := func(column string, count int, value func(i int) interface{}) {
+		conditions = append(conditions, column+` IN (`+placeholders(count)+`)`)
+		for i := 0; i < count; i++ {
+			args = append(args, value(i))
+		}
+	}
+
+	if len(filter.IDs) > 0 {
+		in("id", len(filter.IDs), func(i int) interface{} { return filter.IDs[i] })
+	}
+	if len(filter.Authors) > 0 {
+		in("pubkey", len(filter.Authors), func(i int) interface{} { return filter.Authors[i] })
+	}
+	if len(filter.Kinds) > 0 {
+		in("kind", len(filter.Kinds), func(i int) interface{} { return filter.Kinds[i] })
+	}
+	if filter.Since != 0 {
+		conditions = append(conditions, `created_at >= ?`)
+		args = append(args, filter.Since)
+	}
+	if filter.Until != 0 {
+		conditions = append(conditions, `created_at <= ?`)
+		args = append(args, filter.Until)
+	}
+	for name, values := range filter.Tags {
+		if len(values) == 0 {
+			continue
+		}
+		conditions = append(conditions, `id IN (SELECT event_id FROM nostr_relay_tags WHERE name = ? AND value IN (`+placeholders(len(values))+`))`)
+		args = append(args, name)
+		for _, value := range values {
+			args = append(args, value)
+		}
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	query := `SELECT raw FROM nostr_relay_events`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	query += ` ORDER BY created_at DESC, id ASC LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := s.datastore.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*nostr.Event{}
+	for rows.Next() {
+		var raw string
+		if err := rows.Scan(&raw); err != nil {
+			return nil, err
+		}
+		event := &nostr.Event{}
+		if err := json.Unmarshal([]byte(raw), event); err != nil {
+			return nil, errors.Wrap(err, "unable to decode stored event")
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// has returns if any of the events are stored, only counting events by
+// the pubkey unless it is empty.
+func (s *store) has(ids []string, pubkey string) bool {
+	if len(ids) == 0 {
+		return false
+	}
+
+	query := `SELECT 1 FROM nostr_relay_events WHERE id IN (` + placeholders(len(ids)) + `)`
+	args := make([]interface{}, 0, len(ids)+1)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	if pubkey != "" {
+		query += ` AND pubkey = ?`
+		args = append(args, pubkey)
+	}
+
+	var found int
+	return s.datastore.DB.QueryRow(query+` LIMIT 1`, args...).Scan(&found) == nil
+}
+
+// deleteEvents removes the events matching the condition and their tags.
+func deleteEvents(tx *sql.Tx, condition string, args ...interface{}) error {
+	if _, err := tx.Exec(`DELETE FROM nostr_relay_tags WHERE event_id IN (SELECT id FROM nostr_relay_events WHERE `+condition+`)`, args...); err != nil {
+		return err
+	}
+	_, err := tx.Exec(`DELETE FROM nostr_relay_events WHERE `+condition, args...)
+	return err
+}
+
+func exists(tx *sql.Tx, query string, args ...interface{}) bool {
+	var found int
+	return tx.QueryRow(query+` LIMIT 1`, args...).Scan(&found) == nil
+}
+
+func placeholders(count int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", count), ",")
+}
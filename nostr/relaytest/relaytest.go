@@ -0,0 +1,215 @@
+// Package relaytest provides an in-process Nostr relay for tests.
+package relaytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/gorilla/websocket"
+)
+
+// Relay is a minimal NIP-01 relay backed by memory.
+type Relay struct {
+	// Reject may return a non-empty reason to refuse a published event.
+	Reject func(event *nostr.Event) string
+
+	server   *httptest.Server
+	clients  map[*client]struct{}
+	upgrader websocket.Upgrader
+	events   []*nostr.Event
+	lock     sync.Mutex
+}
+
+type client struct {
+	conn          *websocket.Conn
+	subscriptions map[string][]nostr.Filter
+	lock          sync.Mutex
+}
+
+// NewRelay starts a new in-process relay.
+func NewRelay() *Relay {
+	r := &Relay{
+		clients: map[*client]struct{}{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	}
+	r.server = httptest.NewServer(http.HandlerFunc(r.handle))
+
+	return r
+}
+
+// URL returns the websocket URL of the relay.
+func (r *Relay) URL() string {
+	return "ws" + strings.TrimPrefix(r.server.URL, "http")
+}
+
+// Close shuts down the relay.
+func (r *Relay) Close() {
+	r.DropConnections()
+	r.server.Close()
+}
+
+// DropConnections closes every client connection, simulating a network failure.
+func (r *Relay) DropConnections() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for c := range r.clients {
+		_ = c.conn.Close()
+	}
+}
+
+// ClientCount returns the number of currently connected clients.
+func (r *Relay) ClientCount() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return len(r.clients)
+}
+
+// Events returns every event the relay has stored.
+func (r *Relay) Events() []*nostr.Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	events := make([]*nostr.Event, len(r.events))
+	copy(events, r.events)
+	return events
+}
+
+// Store saves an event and sends it to every matching subscription, as if
+// another client had published it.
+func (r *Relay) Store(event *nostr.Event) {
+	r.lock.Lock()
+	r.events = append(r.events, event)
+	clients := make([]*client, 0, len(r.clients))
+	for c := range r.clients {
+		clients = append(clients, c)
+	}
+	r.lock.Unlock()
+
+	for _, c := range clients {
+		c.broadcast(event)
+	}
+}
+
+func (r *Relay) handle(w http.ResponseWriter, req *http.Request) {
+	conn, err := r.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+
+	c := &client{conn: conn, subscriptions: map[string][]nostr.Filter{}}
+	r.lock.Lock()
+	r.clients[c] = struct{}{}
+	r.lock.Unlock()
+
+	defer func() {
+		r.lock.Lock()
+		delete(r.clients, c)
+		r.lock.Unlock()
+		_ = conn.Close()
+	}()
+
+	for {
+		var message []json.RawMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return
+		}
+		if len(message) < 2 {
+			continue
+		}
+
+		var label string
+		_ = json.Unmarshal(message[0], &label)
+
+		switch label {
+		case "EVENT":
+			r.handleEvent(c, message[1])
+		case "REQ":
+			r.handleREQ(c, message[1:])
+		case "CLOSE":
+			var subID string
+			_ = json.Unmarshal(message[1], &subID)
+			c.lock.Lock()
+			delete(c.subscriptions, subID)
+			c.lock.Unlock()
+		}
+	}
+}
+
+func (r *Relay) handleEvent(c *client, raw json.RawMessage) {
+	event := &nostr.Event{}
+	if err := json.Unmarshal(raw, event); err != nil {
+		c.send([]interface{}{"NOTICE", "invalid event"})
+		return
+	}
+
+	if err := event.Verify(); err != nil {
+		c.send([]interface{}{"OK", event.ID, false, "invalid: " + err.Error()})
+		return
+	}
+
+	if r.Reject != nil {
+		if reason := r.Reject(event); reason != "" {
+			c.send([]interface{}{"OK", event.ID, false, reason})
+			return
+		}
+	}
+
+	c.send([]interface{}{"OK", event.ID, true, ""})
+	r.Store(event)
+}
+
+func (r *Relay) handleREQ(c *client, args []json.RawMessage) {
+	var subID string
+	if err := json.Unmarshal(args[0], &subID); err != nil {
+		return
+	}
+
+	filters := make([]nostr.Filter, 0, len(args)-1)
+	for _, raw := range args[1:] {
+		var filter nostr.Filter
+		if err := json.Unmarshal(raw, &filter); err == nil {
+			filters = append(filters, filter)
+		}
+	}
+
+	c.lock.Lock()
+	c.subscriptions[subID] = filters
+	c.lock.Unlock()
+
+	for _, event := range r.Events() {
+		if nostr.MatchesAny(filters, event) {
+			c.send([]interface{}{"EVENT", subID, event})
+		}
+	}
+	c.send([]interface{}{"EOSE", subID})
+}
+
+func (c *client) broadcast(event *nostr.Event) {
+	c.lock.Lock()
+	matching := []string{}
+	for subID, filters := range c.subscriptions {
+		if nostr.MatchesAny(filters, event) {
+			matching = append(matching, subID)
+		}
+	}
+	c.lock.Unlock()
+
+	for _, subID := range matching {
+		c.send([]interface{}{"EVENT", subID, event})
+	}
+}
+
+func (c *client) send(message interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_ = c.conn.WriteJSON(message)
+}
@@ -0,0 +1,175 @@
+package zaps
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// How long the pay parameters of a lightning address are reused.
+	payParamsCacheDuration = 10 * time.Minute
+
+	// The most LNURL-pay responses read from a lightning provider.
+	maxResponseSize = 64 * 1024
+)
+
+// PayParams is an LNURL-pay (LUD-06) response including the NIP-57
+// extensions announcing support for zaps.
+type PayParams struct {
+	Callback       string `json:"callback"`
+	Metadata       string `json:"metadata"`
+	Tag            string `json:"tag"`
+	NostrPubkey    string `json:"nostrPubkey,omitempty"`
+	MinSendable    int64  `json:"minSendable"`
+	MaxSendable    int64  `json:"maxSendable"`
+	CommentAllowed int    `json:"commentAllowed,omitempty"`
+	AllowsNostr    bool   `json:"allowsNostr,omitempty"`
+}
+
+// ErrorResponse is the LNURL error response.
+type ErrorResponse struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+// InvoiceResponse is the LNURL-pay callback response.
+type InvoiceResponse struct {
+	PaymentRequest string   `json:"pr"`
+	Routes         []string `json:"routes"`
+}
+
+type cachedPayParams struct {
+	fetchedAt time.Time
+	params    *PayParams
+}
+
+var (
+	httpClient = &http.Client{Timeout: 10 * time.Second}
+
+	payParamsCache     = map[string]cachedPayParams{}
+	payParamsCacheLock sync.Mutex
+)
+
+// LightningAddressURL returns the LUD-16 LNURL-pay URL of a lightning
+// address such as name@example.com.
+func LightningAddressURL(address string) (string, error) {
+	name, domain, found := strings.Cut(strings.TrimSpace(address), "@")
+	if !found || name == "" || domain == "" || strings.ContainsAny(name+domain, "/?#@ ") {
+		return "", errors.New("lightning address must look like name@domain")
+	}
+
+	// Onion services and local test setups have no certificates.
+	scheme := "https"
+	host := domain
+	if h, _, err := net.SplitHostPort(domain); err == nil {
+		host = h
+	}
+	if strings.HasSuffix(host, ".onion") || host == "localhost" || net.ParseIP(host).IsLoopback() {
+		scheme = "http"
+	}
+
+	return scheme + "://" + domain + "/.well-known/lnurlp/" + url.PathEscape(strings.ToLower(name)), nil
+}
+
+// FetchPayParams returns the LNURL-pay parameters of a lightning address.
+func FetchPayParams(ctx context.Context, address string) (*PayParams, error) {
+	address = strings.ToLower(strings.TrimSpace(address))
+
+	payParamsCacheLock.Lock()
+	cached, ok := payParamsCache[address]
+	payParamsCacheLock.Unlock()
+	if ok && time.Since(cached.fetchedAt) < payParamsCacheDuration {
+		return cached.params, nil
+	}
+
+	endpoint, err := LightningAddressURL(address)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &PayParams{}
+	if err := getJSON(ctx, endpoint, params); err != nil {
+		return nil, errors.Wrap(err, "unable to fetch lightning address "+address)
+	}
+
+	if params.Tag != "payRequest" || params.Callback == "" {
+		return nil, errors.New(address + " is not an LNURL-pay lightning address")
+	}
+
+	payParamsCacheLock.Lock()
+	payParamsCache[address] = cachedPayParams{fetchedAt: time.Now(), params: params}
+	payParamsCacheLock.Unlock()
+
+	return params, nil
+}
+
+// RequestInvoice asks the LNURL-pay callback for an invoice. zapRequest is
+// the JSON zap request and may be empty for a plain payment.
+func (p *PayParams) RequestInvoice(ctx context.Context, amountMsat int64, zapRequest, comment string) (string, error) {
+	callback, err := url.Parse(p.Callback)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid LNURL-pay callback")
+	}
+
+	query := callback.Query()
+	query.Set("amount", strconv.FormatInt(amountMsat, 10))
+	if zapRequest != "" {
+		query.Set("nostr", zapRequest)
+	}
+	if comment != "" {
+		query.Set("comment", comment)
+	}
+	callback.RawQuery = query.Encode()
+
+	response := &InvoiceResponse{}
+	if err := getJSON(ctx, callback.String(), response); err != nil {
+		return "", err
+	}
+	if response.PaymentRequest == "" {
+		return "", errors.New("lightning provider did not return an invoice")
+	}
+
+	return response.PaymentRequest, nil
+}
+
+// getJSON decodes the JSON response of a GET request, turning LNURL error
+// responses into errors.
+func getJSON(ctx context.Context, endpoint string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	lnurlError := ErrorResponse{}
+	if json.Unmarshal(body, &lnurlError) == nil && strings.EqualFold(lnurlError.Status, "ERROR") {
+		return errors.New(lnurlError.Reason)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,355 @@
+package zaps
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/nwc"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Limits announced for invoices created by the connected wallet.
+	walletMinSendable    = 1000
+	walletMaxSendable    = 10_000_000_000
+	walletCommentAllowed = 255
+
+	// How long invoices created by the connected wallet can be paid.
+	walletInvoiceExpiry = time.Hour
+
+	// How long a balance fetched from the wallet is reused.
+	walletBalanceCacheDuration = 30 * time.Second
+
+	// The number of payments kept for the admin status.
+	maxRecentPayments = 20
+
+	// The slowest an unpaid invoice is polled.
+	maxInvoicePollInterval = 30 * time.Second
+
+	publishTimeout = 10 * time.Second
+)
+
+// How soon an unpaid invoice is first polled. Tests shorten it.
+var invoicePollInterval = 2 * time.Second
+
+// Payment is an invoice created by the connected wallet that was paid.
+type Payment struct {
+	PaidAt      time.Time `json:"paidAt"`
+	PaymentHash string    `json:"paymentHash"`
+	Sender      string    `json:"sender,omitempty"`
+	Comment     string    `json:"comment,omitempty"`
+	AmountMsat  int64     `json:"amountMsat"`
+	Zap         bool      `json:"zap"`
+}
+
+// WalletStatus describes the connected Nostr Wallet Connect wallet.
+type WalletStatus struct {
+	BalanceMsat    *int64    `json:"balanceMsat,omitempty"`
+	WalletPubkey   string    `json:"walletPubkey"`
+	Error          string    `json:"error,omitempty"`
+	Relays         []string  `json:"relays"`
+	RecentPayments []Payment `json:"recentPayments"`
+}
+
+var (
+	wallet     *nwc.Client
+	walletLock sync.Mutex
+
+	balance          int64
+	balanceError     error
+	balanceFetchedAt time.Time
+	balanceLock      sync.Mutex
+
+	recentPayments     = []Payment{}
+	recentPaymentsLock sync.Mutex
+)
+
+// SetupWallet connects to the wallet stored in the config, if any.
+func SetupWallet() error {
+	stored := configrepository.Get().GetNostrWalletConnect()
+	if stored == "" {
+		return nil
+	}
+
+	uri, err := identity.DecryptSecret(stored)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt the wallet connection")
+	}
+
+	conn, err := nostr.ParseWalletConnectURI(uri)
+	if err != nil {
+		return err
+	}
+
+	setWallet(nwc.NewClient(conn))
+	return nil
+}
+
+// SetWalletConnectURI validates, stores and connects to a
+// nostr+walletconnect:// URI. An empty URI disconnects the wallet.
+func SetWalletConnectURI(uri string) error {
+	if uri == "" {
+		if err := configrepository.Get().SetNostrWalletConnect(""); err != nil {
+			return err
+		}
+		setWallet(nil)
+		return nil
+	}
+
+	conn, err := nostr.ParseWalletConnectURI(uri)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := identity.EncryptSecret(conn.URI())
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt the wallet connection")
+	}
+	if err := configrepository.Get().SetNostrWalletConnect(encrypted); err != nil {
+		return err
+	}
+
+	setWallet(nwc.NewClient(conn))
+	return nil
+}
+
+// Wallet returns the connected wallet, or nil when none is configured.
+func Wallet() *nwc.Client {
+	walletLock.Lock()
+	defer walletLock.Unlock()
+
+	return wallet
+}
+
+// GetWalletStatus returns the balance and recent payments of the connected
+// wallet, or nil when none is configured.
+func GetWalletStatus(ctx context.Context) *WalletStatus {
+	client := Wallet()
+	if client == nil {
+		return nil
+	}
+
+	status := &WalletStatus{
+		WalletPubkey:   client.Connection().WalletPubkey,
+		Relays:         client.Connection().Relays,
+		RecentPayments: RecentPayments(),
+	}
+
+	balance, err := walletBalance(ctx, client)
+	if err != nil {
+		status.Error = err.Error()
+	} else {
+		status.BalanceMsat = &balance
+	}
+
+	return status
+}
+
+// RecentPayments returns the latest payments received by the connected
+// wallet since the server started, newest first.
+func RecentPayments() []Payment {
+	recentPaymentsLock.Lock()
+	defer recentPaymentsLock.Unlock()
+
+	return slices.Clone(recentPayments)
+}
+
+func setWallet(client *nwc.Client) {
+	walletLock.Lock()
+	previous := wallet
+	wallet = client
+	walletLock.Unlock()
+
+	if previous != nil {
+		previous.Close()
+	}
+
+	balanceLock.Lock()
+	balanceFetchedAt = time.Time{}
+	balanceLock.Unlock()
+}
+
+func walletBalance(ctx context.Context, client *nwc.Client) (int64, error) {
+	balanceLock.Lock()
+	defer balanceLock.Unlock()
+
+	if time.Since(balanceFetchedAt) < walletBalanceCacheDuration {
+		return balance, balanceError
+	}
+
+	balance, balanceError = client.GetBalance(ctx)
+	balanceFetchedAt = time.Now()
+
+	return balance, balanceError
+}
+
+// walletPayParams returns the LNURL-pay parameters for invoices created by
+// the connected wallet, with the server signing the zap receipts.
+func walletPayParams() *PayParams {
+	metadata, _ := json.Marshal([][]string{
+		{"text/plain", "Zap " + configrepository.Get().GetServerName()},
+		{"text/identifier", LightningAddress()},
+	})
+
+	return &PayParams{
+		Callback:       CallbackURL(),
+		Metadata:       string(metadata),
+		Tag:            "payRequest",
+		NostrPubkey:    identity.PublicKey(),
+		MinSendable:    walletMinSendable,
+		MaxSendable:    walletMaxSendable,
+		CommentAllowed: walletCommentAllowed,
+		AllowsNostr:    identity.PublicKey() != "",
+	}
+}
+
+// requestWalletInvoice creates an invoice with the connected wallet and
+// watches it until it is paid or expires.
+func requestWalletInvoice(ctx context.Context, client *nwc.Client, params *PayParams, amountMsat int64, zapRequest, comment string) (string, error) {
+	// The invoice commits to the zap request, or to the metadata for plain payments.
+	description := params.Metadata
+	if zapRequest != "" {
+		description = zapRequest
+	}
+	hash := sha256.Sum256([]byte(description))
+
+	transaction, err := client.MakeInvoice(ctx, nwc.MakeInvoiceParams{
+		Amount:          amountMsat,
+		DescriptionHash: hex.EncodeToString(hash[:]),
+		Expiry:          int64(walletInvoiceExpiry / time.Second),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "wallet was unable to create an invoice")
+	}
+
+	go watchInvoice(client, transaction, zapRequest, comment)
+
+	return transaction.Invoice, nil
+}
+
+// watchInvoice polls the wallet until the invoice is paid, then records the
+// payment and publishes the zap receipt for it.
+func watchInvoice(client *nwc.Client, invoice *nwc.Transaction, zapRequest, comment string) {
+	expires := time.Now().Add(walletInvoiceExpiry)
+	if invoice.ExpiresAt > 0 {
+		expires = time.Unix(invoice.ExpiresAt, 0)
+	}
+
+	interval := invoicePollInterval
+	for time.Now().Before(expires) {
+		time.Sleep(interval)
+		if interval = interval * 3 / 2; interval > maxInvoicePollInterval {
+			interval = maxInvoicePollInterval
+		}
+
+		// Stop once the wallet was disconnected or replaced.
+		if Wallet() != client {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		transaction, err := client.LookupInvoice(ctx, invoice.PaymentHash)
+		cancel()
+		if err != nil {
+			log.Debugln("Unable to look up invoice", invoice.PaymentHash, err)
+			continue
+		}
+		if !transaction.IsSettled() {
+			continue
+		}
+
+		paidAt := time.Now()
+		if transaction.SettledAt > 0 {
+			paidAt = time.Unix(transaction.SettledAt, 0)
+		}
+
+		invoicePaid(invoice, transaction.Preimage, paidAt, zapRequest, comment)
+		return
+	}
+}
+
+func invoicePaid(invoice *nwc.Transaction, preimage string, paidAt time.Time, zapRequest, comment string) {
+	payment := Payment{
+		PaidAt:      paidAt,
+		PaymentHash: invoice.PaymentHash,
+		Comment:     comment,
+		AmountMsat:  invoice.Amount,
+	}
+
+	if zapRequest != "" {
+		payment.Zap = true
+		if err := publishZapReceipt(zapRequest, invoice.Invoice, preimage, paidAt); err != nil {
+			log.Errorln("Unable to publish zap receipt", err)
+		}
+
+		request := &nostr.Event{}
+		if json.Unmarshal([]byte(zapRequest), request) == nil {
+			payment.Sender = request.PubKey
+			payment.Comment = request.Content
+		}
+	}
+
+	recentPaymentsLock.Lock()
+	recentPayments = append([]Payment{payment}, recentPayments...)
+	if len(recentPayments) > maxRecentPayments {
+		recentPayments = recentPayments[:maxRecentPayments]
+	}
+	recentPaymentsLock.Unlock()
+
+	balanceLock.Lock()
+	balanceFetchedAt = time.Time{}
+	balanceLock.Unlock()
+}
+
+// publishZapReceipt signs the receipt with the server key and sends it to
+// the server's relays and the relays the zap request asked for.
+func publishZapReceipt(zapRequest, bolt11, preimage string, paidAt time.Time) error {
+	receipt, err := nostr.ZapReceipt(zapRequest, bolt11, preimage, paidAt)
+	if err != nil {
+		return err
+	}
+	if err := identity.Sign(receipt); err != nil {
+		return err
+	}
+
+	relay.Get().PublishInBackground(receipt, publishTimeout)
+
+	request := &nostr.Event{}
+	if err := json.Unmarshal([]byte(zapRequest), request); err != nil {
+		return err
+	}
+
+	relays := request.Tags.GetFirst("relays")
+	if len(relays) < 2 {
+		return nil
+	}
+
+	extra := []string{}
+	configured := relay.Get().Relays()
+	for _, url := range relays[1:] {
+		if !slices.Contains(configured, url) && !slices.Contains(extra, url) {
+			extra = append(extra, url)
+		}
+	}
+	if len(extra) == 0 {
+		return nil
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		defer cancel()
+		relay.PublishTo(ctx, extra, receipt)
+	}()
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Package zaps serves the stream's NIP-57 lightning address and works out
+// which zap receipts can be trusted.
+//
+// The stream's lightning address either forwards to the lightning address
+// the admin configured, so invoices are created and zap receipts are
+// published by the admin's own lightning provider, or creates invoices with
+// a Nostr Wallet Connect wallet, in which case the server signs the zap
+// receipts itself.
+package zaps
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/pkg/errors"
+)
+
+// ErrNotConfigured is returned when the stream has no lightning address.
+var ErrNotConfigured = errors.New("lightning address is not configured")
+
+// Username returns the name part of the stream's lightning address.
+func Username() string {
+	return strings.ToLower(configrepository.Get().GetFederationUsername())
+}
+
+// LightningAddress returns the stream's lud16 lightning address, or an
+// empty string when zaps are not set up.
+func LightningAddress() string {
+	configRepository := configrepository.Get()
+	if configRepository.GetNostrLightningAddress() == "" && Wallet() == nil {
+		return ""
+	}
+
+	host := utils.GetHostnameFromURLString(configRepository.GetServerURL())
+	if host == "" {
+		return ""
+	}
+
+	return Username() + "@" + host
+}
+
+// CallbackURL returns the LNURL-pay callback of the stream's lightning address.
+func CallbackURL() string {
+	serverURL := strings.TrimSuffix(configrepository.Get().GetServerURL(), "/")
+	return serverURL + "/lnurlp/" + url.PathEscape(Username()) + "/callback"
+}
+
+// StreamPayParams returns the LNURL-pay parameters for the stream's lightning
+// address. They are those of the connected wallet, or those of the
+// configured lightning address with the callback pointing back at this server.
+func StreamPayParams(ctx context.Context) (*PayParams, error) {
+	if Wallet() != nil {
+		return walletPayParams(), nil
+	}
+
+	upstream, err := upstreamPayParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := *upstream
+	params.Callback = CallbackURL()
+
+	return &params, nil
+}
+
+// RequestStreamInvoice validates the zap request, if any, and returns an
+// invoice for the amount from the connected wallet or the configured
+// lightning address.
+func RequestStreamInvoice(ctx context.Context, amountMsat int64, zapRequest, comment string) (string, error) {
+	client := Wallet()
+
+	var params *PayParams
+	if client != nil {
+		params = walletPayParams()
+	} else {
+		upstream, err := upstreamPayParams(ctx)
+		if err != nil {
+			return "", err
+		}
+		params = upstream
+	}
+
+	if amountMsat < params.MinSendable || amountMsat > params.MaxSendable {
+		return "", errors.New("amount is out of range")
+	}
+
+	if utf8.RuneCountInString(comment) > params.CommentAllowed {
+		return "", errors.New("comment is too long")
+	}
+
+	if zapRequest != "" {
+		if !params.AllowsNostr {
+			return "", errors.New("zaps are not supported")
+		}
+
+		request := &nostr.Event{}
+		if err := json.Unmarshal([]byte(zapRequest), request); err != nil {
+			return "", errors.New("zap request is not valid json")
+		}
+		if err := nostr.ValidateZapRequest(request, amountMsat); err != nil {
+			return "", err
+		}
+	}
+
+	var bolt11 string
+	var err error
+	if client != nil {
+		bolt11, err = requestWalletInvoice(ctx, client, params, amountMsat, zapRequest, comment)
+	} else {
+		bolt11, err = params.RequestInvoice(ctx, amountMsat, zapRequest, comment)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	invoice, err := nostr.DecodeInvoice(bolt11)
+	if err != nil {
+		return "", errors.Wrap(err, "lightning provider returned an invalid invoice")
+	}
+	if invoice.AmountMsat != amountMsat {
+		return "", errors.New("lightning provider returned an invoice for the wrong amount")
+	}
+
+	return bolt11, nil
+}
+
+// ProviderPubkey returns the key allowed to sign zap receipts for zaps to
+// the recipient. Only zaps to the server's key or to the host of the
+// stream are accepted, and their receipts must be signed by the server's
+// own key when a wallet is connected, or by the lightning provider behind
+// the configured lightning address. Lightning addresses named by anyone
+// else are never fetched.
+func ProviderPubkey(ctx context.Context, recipient string) (string, error) {
+	configRepository := configrepository.Get()
+	host := configRepository.GetAdminNostrPubkey()
+	if recipient != identity.PublicKey() && (host == "" || !strings.EqualFold(recipient, host)) {
+		return "", errors.New("zap is not for this stream")
+	}
+
+	if Wallet() != nil {
+		return identity.PublicKey(), nil
+	}
+
+	// The pay parameters are cached, so this only reaches the provider
+	// once in a while.
+	params, err := upstreamPayParams(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.AllowsNostr || !nostr.IsValidPublicKey(params.NostrPubkey) {
+		return "", errors.New("the lightning provider does not support zaps")
+	}
+
+	return params.NostrPubkey, nil
+}
+
+func upstreamPayParams(ctx context.Context) (*PayParams, error) {
+	address := configrepository.Get().GetNostrLightningAddress()
+	if address == "" {
+		return nil, ErrNotConfigured
+	}
+
+	return FetchPayParams(ctx, address)
+}
@@ -0,0 +1,219 @@
+package zaps
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/nwctest"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/relaytest"
+	"github.com/TekkadanPlays/oni/nostr/zaptest"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-zaps-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	_ = configrepository.Get().SetServerURL("https://oni.example.com")
+
+	config.NostrKeyFile = filepath.Join(dir, "nostr.secret")
+	if err := identity.Setup(); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func TestLightningAddressURL(t *testing.T) {
+	tests := []struct {
+		address string
+		url     string
+	}{
+		{"Satoshi@example.com", "https://example.com/.well-known/lnurlp/satoshi"},
+		{"me@abcdef.onion", "http://abcdef.onion/.well-known/lnurlp/me"},
+		{"me@127.0.0.1:8080", "http://127.0.0.1:8080/.well-known/lnurlp/me"},
+		{"example.com", ""},
+		{"me@", ""},
+		{"me@example.com/path", ""},
+	}
+
+	for _, test := range tests {
+		url, err := LightningAddressURL(test.address)
+		if url != test.url || (err == nil) != (test.url != "") {
+			t.Errorf("LightningAddressURL(%q) = %q, %v", test.address, url, err)
+		}
+	}
+}
+
+func TestStreamLightningAddress(t *testing.T) {
+	ctx := context.Background()
+	configRepository := configrepository.Get()
+
+	if LightningAddress() != "" {
+		t.Error("stream has a lightning address before one was configured")
+	}
+	if _, err := StreamPayParams(ctx); err != ErrNotConfigured {
+		t.Errorf("got %v, want ErrNotConfigured", err)
+	}
+
+	provider := zaptest.NewProvider()
+	defer provider.Close()
+	if err := configRepository.SetNostrLightningAddress(provider.Address("streamer")); err != nil {
+		t.Fatal(err)
+	}
+
+	if address := LightningAddress(); address != Username()+"@oni.example.com" {
+		t.Errorf("lightning address = %q", address)
+	}
+
+	params, err := StreamPayParams(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Callback != "https://oni.example.com/lnurlp/"+Username()+"/callback" {
+		t.Errorf("callback = %q", params.Callback)
+	}
+	if !params.AllowsNostr || params.NostrPubkey != provider.Pubkey {
+		t.Error("zap support of the lightning provider was not passed on")
+	}
+
+	if _, err := RequestStreamInvoice(ctx, 1, "", ""); err == nil {
+		t.Error("amount below the minimum was accepted")
+	}
+	if _, err := RequestStreamInvoice(ctx, 21000, `{"kind":9734}`, ""); err == nil {
+		t.Error("invalid zap request was accepted")
+	}
+
+	bolt11, err := RequestStreamInvoice(ctx, 21000, "", "thanks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	invoice, err := nostr.DecodeInvoice(bolt11)
+	if err != nil || invoice.AmountMsat != 21000 {
+		t.Errorf("invoice = %+v, %v", invoice, err)
+	}
+
+	if pubkey, err := ProviderPubkey(ctx, identity.PublicKey()); err != nil || pubkey != provider.Pubkey {
+		t.Errorf("provider pubkey = %q, %v, want the configured provider", pubkey, err)
+	}
+
+	// Zaps to anybody else are not trusted, whatever provider they name.
+	stranger, _ := nostr.GeneratePrivateKey()
+	strangerPubkey, _ := nostr.GetPublicKey(stranger)
+	if _, err := ProviderPubkey(ctx, strangerPubkey); err == nil {
+		t.Error("a zap to another pubkey was trusted")
+	}
+}
+
+func TestWalletInvoices(t *testing.T) {
+	ctx := context.Background()
+	invoicePollInterval = 10 * time.Millisecond
+
+	r := relaytest.NewRelay()
+	defer r.Close()
+	relay.Get().SetRelays([]string{r.URL()})
+	defer relay.Get().SetRelays(nil)
+
+	wallet := nwctest.NewWallet(r.URL())
+	defer wallet.Close()
+	wallet.SetBalance(1000)
+
+	if err := SetWalletConnectURI("nostr+walletconnect://invalid"); err == nil {
+		t.Error("invalid wallet connect uri was accepted")
+	}
+	if err := SetWalletConnectURI(wallet.URI()); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = SetWalletConnectURI("") }()
+
+	// The uri is stored encrypted and can be loaded again.
+	if stored := configrepository.Get().GetNostrWalletConnect(); stored == "" || stored == wallet.URI() {
+		t.Errorf("stored wallet connection = %q", stored)
+	}
+	if err := SetupWallet(); err != nil {
+		t.Fatal(err)
+	}
+
+	params, err := StreamPayParams(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.NostrPubkey != identity.PublicKey() || !params.AllowsNostr {
+		t.Error("server key does not sign zap receipts for the connected wallet")
+	}
+
+	key, _ := nostr.GeneratePrivateKey()
+	address := "30311:" + identity.PublicKey() + ":oni-1"
+	request := &nostr.Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      nostr.KindZapRequest,
+		Tags:      nostr.Tags{{"p", identity.PublicKey()}, {"a", address}, {"relays", r.URL()}, {"amount", "21000"}},
+		Content:   "great stream",
+	}
+	if err := request.Sign(key); err != nil {
+		t.Fatal(err)
+	}
+
+	zapRequest, _ := json.Marshal(request)
+	bolt11, err := RequestStreamInvoice(ctx, 21000, string(zapRequest), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wallet.Settle(bolt11); err != nil {
+		t.Fatal(err)
+	}
+
+	receipt := waitForReceipt(t, r)
+	providerPubkey, err := ProviderPubkey(ctx, identity.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	zap, err := nostr.ParseZapReceipt(receipt, providerPubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zap.AmountMsat != 21000 || zap.Address != address || zap.Comment != "great stream" {
+		t.Errorf("unexpected zap %+v", zap)
+	}
+
+	status := GetWalletStatus(ctx)
+	if status == nil || status.BalanceMsat == nil || *status.BalanceMsat != 22000 {
+		t.Errorf("unexpected wallet status %+v", status)
+	}
+	if len(status.RecentPayments) != 1 || !status.RecentPayments[0].Zap || status.RecentPayments[0].AmountMsat != 21000 {
+		t.Errorf("unexpected recent payments %+v", status.RecentPayments)
+	}
+}
+
+func waitForReceipt(t *testing.T, r *relaytest.Relay) *nostr.Event {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, event := range r.Events() {
+			if event.Kind == nostr.KindZapReceipt {
+				return event
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatal("zap receipt was not published")
+	return nil
+}
@@ -0,0 +1,134 @@
+// Package zaptest provides an in-process LNURL-pay lightning provider that
+// supports zaps, for tests.
+package zaptest
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/pkg/errors"
+)
+
+const metadata = `[["text/plain","Test payment"]]`
+
+// Provider is a lightning provider that hands out unpayable invoices and
+// signs zap receipts for them when told they were paid.
+type Provider struct {
+	invoices   map[string]string
+	server     *httptest.Server
+	PrivateKey string
+	Pubkey     string
+	lock       sync.Mutex
+}
+
+// NewProvider starts a new lightning provider.
+func NewProvider() *Provider {
+	privateKey, err := nostr.GeneratePrivateKey()
+	if err != nil {
+		panic(err)
+	}
+	pubkey, err := nostr.GetPublicKey(privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	p := &Provider{
+		invoices:   map[string]string{},
+		PrivateKey: privateKey,
+		Pubkey:     pubkey,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/lnurlp/", p.handlePayParams)
+	mux.HandleFunc("/callback", p.handleCallback)
+	p.server = httptest.NewServer(mux)
+
+	return p
+}
+
+// Address returns a lightning address with the given name at the provider.
+func (p *Provider) Address(name string) string {
+	return name + "@" + strings.TrimPrefix(p.server.URL, "http://")
+}
+
+// Close shuts down the provider.
+func (p *Provider) Close() {
+	p.server.Close()
+}
+
+// Pay returns the signed zap receipt for a paid zap invoice.
+func (p *Provider) Pay(bolt11 string) (*nostr.Event, error) {
+	p.lock.Lock()
+	description, ok := p.invoices[bolt11]
+	p.lock.Unlock()
+	if !ok {
+		return nil, errors.New("unknown invoice")
+	}
+
+	receipt, err := nostr.ZapReceipt(description, bolt11, "", time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := receipt.Sign(p.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+func (p *Provider) handlePayParams(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]interface{}{
+		"callback":       p.server.URL + "/callback",
+		"metadata":       metadata,
+		"tag":            "payRequest",
+		"minSendable":    1000,
+		"maxSendable":    100_000_000_000,
+		"commentAllowed": 255,
+		"allowsNostr":    true,
+		"nostrPubkey":    p.Pubkey,
+	})
+}
+
+func (p *Provider) handleCallback(w http.ResponseWriter, r *http.Request) {
+	amount, err := strconv.ParseInt(r.URL.Query().Get("amount"), 10, 64)
+	if err != nil {
+		writeJSON(w, map[string]string{"status": "ERROR", "reason": "invalid amount"})
+		return
+	}
+
+	description := metadata
+	if zapRequest := r.URL.Query().Get("nostr"); zapRequest != "" {
+		description = zapRequest
+	}
+
+	descriptionHash := sha256.Sum256([]byte(description))
+	paymentHash := make([]byte, 32)
+	_, _ = rand.Read(paymentHash)
+
+	bolt11 := nostr.Invoice{
+		CreatedAt:       time.Now(),
+		AmountMsat:      amount,
+		PaymentHash:     hex.EncodeToString(paymentHash),
+		DescriptionHash: hex.EncodeToString(descriptionHash[:]),
+	}.Encode()
+
+	p.lock.Lock()
+	p.invoices[bolt11] = description
+	p.lock.Unlock()
+
+	writeJSON(w, map[string]interface{}{"pr": bolt11, "routes": []string{}})
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -3,4 +3,6 @@ package notifications
 const (
 	// BrowserPushNotification represents a push notification for a browser.
 	BrowserPushNotification = "BROWSER_PUSH_NOTIFICATION"
+	// NostrNotification represents a direct message to a Nostr pubkey.
+	NostrNotification = "NOSTR_DIRECT_MESSAGE"
 )
@@ -0,0 +1,196 @@
+// Package nostrdm sends go-live notifications as Nostr direct messages
+// signed by the server key.
+package nostrdm
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// How long to wait for relays when looking up inboxes and sending each message.
+var sendTimeout = 15 * time.Second
+
+// How many direct messages are sent at the same time, so a slow recipient
+// does not hold up the others.
+const maxConcurrentSends = 10
+
+// allowInboxRelay returns if the server may connect to an inbox relay.
+// Recipients choose their inbox relays, so only public ones are used.
+var allowInboxRelay = isPublicRelay
+
+// The shared address space carriers use behind NAT, not reachable from the
+// internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// NostrDM is an instance of the Nostr direct message service.
+type NostrDM struct {
+	pool       *relay.Pool
+	privateKey string
+}
+
+// New will create a new instance of the Nostr direct message service that
+// sends messages signed by the private key, using the pool to look up
+// inbox relays and as the fallback for recipients without one.
+func New(privateKey string, pool *relay.Pool) (*NostrDM, error) {
+	if !nostr.IsValidPrivateKey(privateKey) {
+		return nil, errors.New("a server Nostr key is required to send direct messages")
+	}
+
+	return &NostrDM{
+		pool:       pool,
+		privateKey: privateKey,
+	}, nil
+}
+
+// Send will send the message to each of the recipients. Recipients with a
+// NIP-17 inbox relay list get a gift wrapped private message on those
+// relays, and everyone else a NIP-04 direct message on the server's relays.
+func (n *NostrDM) Send(recipients []string, message string) error {
+	inboxes := n.inboxRelays(recipients)
+
+	queue := make(chan string)
+	var failed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < min(maxConcurrentSends, len(recipients)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for recipient := range queue {
+				ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+				err := n.send(ctx, recipient, inboxes[recipient], message)
+				cancel()
+				if err != nil {
+					log.Debugln("Unable to send direct message to", recipient, err)
+					failed.Add(1)
+				}
+			}
+		}()
+	}
+
+	for _, recipient := range recipients {
+		queue <- recipient
+	}
+	close(queue)
+	wg.Wait()
+
+	if failed := failed.Load(); failed > 0 {
+		return errors.Errorf("unable to send %d of %d direct messages", failed, len(recipients))
+	}
+
+	return nil
+}
+
+func (n *NostrDM) send(ctx context.Context, recipient string, inbox []string, message string) error {
+	if len(inbox) > 0 {
+		wrap, err := nostr.GiftWrapDirectMessage(n.privateKey, recipient, message)
+		if err != nil {
+			return err
+		}
+
+		if accepted(relay.PublishTo(ctx, inbox, wrap)) {
+			return nil
+		}
+		log.Debugln("No inbox relay of", recipient, "accepted the direct message, falling back to NIP-04")
+	}
+
+	event, err := nostr.EncryptedDirectMessage(n.privateKey, recipient, message)
+	if err != nil {
+		return err
+	}
+
+	if !accepted(n.pool.Publish(ctx, event)) {
+		return errors.New("no relay accepted the direct message")
+	}
+
+	return nil
+}
+
+// inboxRelays returns the NIP-17 relay list of each recipient that has one,
+// leaving out the relays the server may not connect to.
+func (n *NostrDM) inboxRelays(recipients []string) map[string][]string {
+	inboxes := map[string][]string{}
+	if len(recipients) == 0 {
+		return inboxes
+	}
+
+	queryCtx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	newest := map[string]*nostr.Event{}
+	for _, event := range n.pool.Query(queryCtx, nostr.Filter{Authors: recipients, Kinds: []int{nostr.KindDMRelayList}}) {
+		if event.Kind != nostr.KindDMRelayList || event.Verify() != nil {
+			continue
+		}
+		if previous, ok := newest[event.PubKey]; !ok || event.CreatedAt > previous.CreatedAt {
+			newest[event.PubKey] = event
+		}
+	}
+
+	for pubkey, event := range newest {
+		relays := []string{}
+		for _, tag := range event.Tags.GetAll("relay") {
+			relayURL := tag.Value()
+			if relayURL == "" {
+				continue
+			}
+			if !allowInboxRelay(queryCtx, relayURL) {
+				log.Debugln("Ignoring the inbox relay", relayURL, "of", pubkey)
+				continue
+			}
+			relays = append(relays, relayURL)
+		}
+		if len(relays) > 0 {
+			inboxes[pubkey] = relays
+		}
+	}
+
+	return inboxes
+}
+
+// isPublicRelay returns if the relay URL uses TLS and its host resolves
+// only to public addresses, so recipients can not have the server connect
+// to its own network.
+func isPublicRelay(ctx context.Context, relayURL string) bool {
+	u, err := url.Parse(relayURL)
+	if err != nil || u.Scheme != "wss" || u.Hostname() == "" {
+		return false
+	}
+
+	addresses, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil || len(addresses) == 0 {
+		return false
+	}
+
+	for _, address := range addresses {
+		if !isPublicAddress(address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPublicAddress(address netip.Addr) bool {
+	address = address.Unmap()
+	return address.IsGlobalUnicast() && !address.IsPrivate() && !sharedAddressSpace.Contains(address)
+}
+
+func accepted(results []relay.PublishResult) bool {
+	for _, result := range results {
+		if result.Accepted {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+package nostrdm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/relaytest"
+)
+
+func TestSend(t *testing.T) {
+	serverRelay := relaytest.NewRelay()
+	defer serverRelay.Close()
+	inboxRelay := relaytest.NewRelay()
+	defer inboxRelay.Close()
+
+	// The fake relays run on the local network.
+	allowInboxRelay = func(ctx context.Context, relayURL string) bool { return relayURL == inboxRelay.URL() }
+	defer func() { allowInboxRelay = isPublicRelay }()
+
+	serverKey, _ := nostr.GeneratePrivateKey()
+	serverPubkey, _ := nostr.GetPublicKey(serverKey)
+
+	// One recipient publishes a NIP-17 inbox, the other does not.
+	withInbox, _ := nostr.GeneratePrivateKey()
+	withInboxPubkey, _ := nostr.GetPublicKey(withInbox)
+	withoutInbox, _ := nostr.GeneratePrivateKey()
+	withoutInboxPubkey, _ := nostr.GetPublicKey(withoutInbox)
+
+	relayList := &nostr.Event{
+		CreatedAt: time.Now().Unix(),
+		Kind:      nostr.KindDMRelayList,
+		Tags:      nostr.Tags{{"relay", inboxRelay.URL()}},
+	}
+	if err := relayList.Sign(withInbox); err != nil {
+		t.Fatal(err)
+	}
+	serverRelay.Store(relayList)
+
+	pool := relay.NewPool()
+	defer pool.Close()
+	pool.SetRelays([]string{serverRelay.URL()})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := pool.WaitForConnection(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	dm, err := New(serverKey, pool)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.Send([]string{withInboxPubkey, withoutInboxPubkey}, "We are live!"); err != nil {
+		t.Fatal(err)
+	}
+
+	inboxEvents := inboxRelay.Events()
+	if len(inboxEvents) != 1 {
+		t.Fatalf("expected 1 gift wrap on the inbox relay, got %d", len(inboxEvents))
+	}
+	rumor, err := nostr.UnwrapGiftWrap(withInbox, inboxEvents[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rumor.PubKey != serverPubkey || rumor.Content != "We are live!" {
+		t.Errorf("unexpected private message %+v", rumor)
+	}
+
+	var fallback *nostr.Event
+	for _, event := range serverRelay.Events() {
+		if event.Kind == nostr.KindEncryptedDirectMessage {
+			if fallback != nil {
+				t.Fatal("expected a single NIP-04 direct message")
+			}
+			fallback = event
+		}
+	}
+	if fallback == nil {
+		t.Fatal("expected a NIP-04 direct message on the server relay")
+	}
+	if fallback.PubKey != serverPubkey || fallback.Tags.Value("p") != withoutInboxPubkey {
+		t.Errorf("unexpected direct message %+v", fallback)
+	}
+	message, err := nostr.DecryptDirectMessage(withoutInbox, serverPubkey, fallback.Content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message != "We are live!" {
+		t.Errorf("message = %q", message)
+	}
+}
+
+func TestNewRequiresKey(t *testing.T) {
+	if _, err := New("", relay.NewPool()); err == nil {
+		t.Error("expected an error without a server key")
+	}
+}
+
+func TestIsPublicRelay(t *testing.T) {
+	ctx := context.Background()
+	for _, relayURL := range []string{
+		"ws://relay.example.com",
+		"wss://127.0.0.1",
+		"wss://localhost:7777",
+		"wss://10.0.0.1",
+		"wss://192.168.1.10",
+		"wss://169.254.169.254",
+		"wss://100.64.0.1",
+		"wss://[::1]",
+		"wss://[fe80::1]",
+		"wss://[fd00::1]",
+		"not a url",
+	} {
+		if isPublicRelay(ctx, relayURL) {
+			t.Errorf("expected %s to be refused", relayURL)
+		}
+	}
+
+	if !isPublicRelay(ctx, "wss://1.1.1.1") {
+		t.Error("expected a public address to be allowed")
+	}
+}
@@ -8,11 +8,14 @@ import (
 	"github.com/TekkadanPlays/oni/core/data"
 	"github.com/TekkadanPlays/oni/db"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/relay"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/persistence/tables"
 
 	"github.com/TekkadanPlays/oni/notifications/browser"
 	"github.com/TekkadanPlays/oni/notifications/discord"
+	"github.com/TekkadanPlays/oni/notifications/nostrdm"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
@@ -22,6 +25,7 @@ type Notifier struct {
 	datastore        *data.Datastore
 	browser          *browser.Browser
 	discord          *discord.Discord
+	nostr            *nostrdm.NostrDM
 	configRepository configrepository.ConfigRepository
 }
 
@@ -73,6 +77,9 @@ func New(datastore *data.Datastore) (*Notifier, error) {
 	if err := notifier.setupDiscord(); err != nil {
 		log.Error(err)
 	}
+	if err := notifier.setupNostr(); err != nil {
+		log.Error(err)
+	}
 
 	return &notifier, nil
 }
@@ -149,6 +156,41 @@ func (n *Notifier) notifyDiscord() {
 	}
 }
 
+func (n *Notifier) setupNostr() error {
+	if n.configRepository.GetNostrNotificationConfig().Enabled {
+		nostrNotifier, err := nostrdm.New(identity.PrivateKey(), relay.Get())
+		if err != nil {
+			return errors.Wrap(err, "error creating nostr notifier")
+		}
+		n.nostr = nostrNotifier
+	}
+	return nil
+}
+
+func (n *Notifier) notifyNostr() {
+	destinations, err := GetNotificationDestinationsForChannel(NostrNotification)
+	if err != nil {
+		log.Errorln("error getting nostr notification destinations", err)
+		return
+	}
+	if len(destinations) == 0 {
+		return
+	}
+
+	goLiveMessage := n.configRepository.GetNostrNotificationConfig().GoLiveMessage
+	if goLiveMessage == "" {
+		goLiveMessage = n.configRepository.GetServerName() + " is live!"
+	}
+	if streamTitle := n.configRepository.GetStreamTitle(); streamTitle != "" {
+		goLiveMessage += "\n" + streamTitle
+	}
+	message := fmt.Sprintf("%s\n\n%s", goLiveMessage, n.configRepository.GetServerURL())
+
+	if err := n.nostr.Send(destinations, message); err != nil {
+		log.Errorln("error sending nostr direct messages", err)
+	}
+}
+
 // Notify will fire the different notification channels.
 func (n *Notifier) Notify() {
 	if n.browser != nil {
@@ -158,6 +200,10 @@ func (n *Notifier) Notify() {
 	if n.discord != nil {
 		n.notifyDiscord()
 	}
+
+	if n.nostr != nil {
+		n.notifyNostr()
+	}
 }
 
 // RemoveNotificationForChannel removes a notification destination.
@@ -0,0 +1,168 @@
+package adminrepository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// How many audit entries are kept.
+const maxAuditEntries = 10000
+
+type AdminRepository interface {
+	GetAdminPubkeys() ([]*models.AdminPubkey, error)
+	GetAdminPubkey(pubkey string) *models.AdminPubkey
+	SetAdminPubkey(admin *models.AdminPubkey) error
+	RemoveAdminPubkey(pubkey string) error
+	AddAuditEntry(entry *models.AdminAuditEntry) error
+	GetAuditEntries(limit, offset int) ([]*models.AdminAuditEntry, int, error)
+}
+
+type SqlAdminRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance AdminRepository
+
+// Get will return the admin repository.
+func Get() AdminRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the AdminRepository.
+func New(datastore *data.Datastore) AdminRepository {
+	r := SqlAdminRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// GetAdminPubkeys will return the pubkeys given a role on the admin API,
+// oldest first.
+func (r *SqlAdminRepository) GetAdminPubkeys() ([]*models.AdminPubkey, error) {
+	rows, err := r.datastore.DB.Query("SELECT pubkey, role, label, added_by, created_at FROM admin_pubkeys ORDER BY created_at")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	admins := []*models.AdminPubkey{}
+	for rows.Next() {
+		admin, err := scanAdminPubkey(rows)
+		if err != nil {
+			return nil, err
+		}
+		admins = append(admins, admin)
+	}
+
+	return admins, rows.Err()
+}
+
+// GetAdminPubkey will return the role given to the pubkey, or nil if it
+// has none.
+func (r *SqlAdminRepository) GetAdminPubkey(pubkey string) *models.AdminPubkey {
+	row := r.datastore.DB.QueryRow("SELECT pubkey, role, label, added_by, created_at FROM admin_pubkeys WHERE pubkey = ?", pubkey)
+
+	admin, err := scanAdminPubkey(row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Errorln("error fetching admin pubkey", err)
+		}
+		return nil
+	}
+
+	return admin
+}
+
+// SetAdminPubkey will give the pubkey a role, replacing any it had.
+func (r *SqlAdminRepository) SetAdminPubkey(admin *models.AdminPubkey) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec(`INSERT INTO admin_pubkeys (pubkey, role, label, added_by, created_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(pubkey) DO UPDATE SET role = excluded.role, label = excluded.label`,
+		admin.Pubkey, string(admin.Role), admin.Label, admin.AddedBy, admin.CreatedAt.Unix())
+
+	return err
+}
+
+// RemoveAdminPubkey will remove the role given to the pubkey.
+func (r *SqlAdminRepository) RemoveAdminPubkey(pubkey string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM admin_pubkeys WHERE pubkey = ?", pubkey)
+	return err
+}
+
+// AddAuditEntry will record a change made through the admin API, dropping
+// the oldest entries past the limit.
+func (r *SqlAdminRepository) AddAuditEntry(entry *models.AdminAuditEntry) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	result, err := r.datastore.DB.Exec("INSERT INTO admin_audit_log (timestamp, pubkey, role, method, path, status) VALUES (?, ?, ?, ?, ?, ?)",
+		entry.Timestamp.Unix(), entry.Pubkey, string(entry.Role), entry.Method, entry.Path, entry.Status)
+	if err != nil {
+		return err
+	}
+
+	if entry.ID, err = result.LastInsertId(); err != nil {
+		return err
+	}
+
+	_, err = r.datastore.DB.Exec("DELETE FROM admin_audit_log WHERE id <= ?", entry.ID-maxAuditEntries)
+	return err
+}
+
+// GetAuditEntries will return a page of the changes made through the admin
+// API, newest first, and the total number of entries.
+func (r *SqlAdminRepository) GetAuditEntries(limit, offset int) ([]*models.AdminAuditEntry, int, error) {
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM admin_audit_log").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT id, timestamp, pubkey, role, method, path, status FROM admin_audit_log ORDER BY id DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := []*models.AdminAuditEntry{}
+	for rows.Next() {
+		entry := &models.AdminAuditEntry{}
+		var timestamp int64
+		var role string
+		if err := rows.Scan(&entry.ID, &timestamp, &entry.Pubkey, &role, &entry.Method, &entry.Path, &entry.Status); err != nil {
+			return nil, 0, err
+		}
+		entry.Timestamp = time.Unix(timestamp, 0)
+		entry.Role = models.AdminRole(role)
+		entries = append(entries, entry)
+	}
+
+	return entries, total, rows.Err()
+}
+
+func scanAdminPubkey(row interface{ Scan(...interface{}) error }) (*models.AdminPubkey, error) {
+	admin := &models.AdminPubkey{}
+	var role string
+	var createdAt int64
+	if err := row.Scan(&admin.Pubkey, &role, &admin.Label, &admin.AddedBy, &createdAt); err != nil {
+		return nil, err
+	}
+	admin.Role = models.AdminRole(role)
+	admin.CreatedAt = time.Unix(createdAt, 0)
+
+	return admin, nil
+}
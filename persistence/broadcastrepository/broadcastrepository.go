@@ -0,0 +1,93 @@
+package broadcastrepository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+)
+
+type BroadcastRepository interface {
+	AddBroadcast(entry *models.BroadcastHistoryEntry) error
+	EndBroadcast(id string, endedAt time.Time) error
+	GetBroadcasts(limit, offset int) ([]*models.BroadcastHistoryEntry, int, error)
+}
+
+type SqlBroadcastRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance BroadcastRepository
+
+// Get will return the broadcast repository.
+func Get() BroadcastRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the BroadcastRepository.
+func New(datastore *data.Datastore) BroadcastRepository {
+	r := SqlBroadcastRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// AddBroadcast will record the start of a broadcast.
+func (r *SqlBroadcastRepository) AddBroadcast(entry *models.BroadcastHistoryEntry) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("INSERT INTO broadcast_history (id, stream_key_label, stream_key_hint, protocol, remote_address, started_at) VALUES (?, ?, ?, ?, ?, ?)",
+		entry.ID, entry.StreamKeyLabel, entry.StreamKeyHint, entry.Protocol, entry.RemoteAddr, entry.StartedAt.Unix())
+
+	return err
+}
+
+// EndBroadcast will record when the broadcast ended.
+func (r *SqlBroadcastRepository) EndBroadcast(id string, endedAt time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE broadcast_history SET ended_at = ? WHERE id = ?", endedAt.Unix(), id)
+	return err
+}
+
+// GetBroadcasts will return a page of the broadcasts, newest first, and
+// the total number of them.
+func (r *SqlBroadcastRepository) GetBroadcasts(limit, offset int) ([]*models.BroadcastHistoryEntry, int, error) {
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM broadcast_history").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT id, stream_key_label, stream_key_hint, protocol, remote_address, started_at, ended_at FROM broadcast_history ORDER BY started_at DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := []*models.BroadcastHistoryEntry{}
+	for rows.Next() {
+		entry := &models.BroadcastHistoryEntry{}
+		var startedAt int64
+		var endedAt sql.NullInt64
+		if err := rows.Scan(&entry.ID, &entry.StreamKeyLabel, &entry.StreamKeyHint, &entry.Protocol, &entry.RemoteAddr, &startedAt, &endedAt); err != nil {
+			return nil, 0, err
+		}
+		entry.StartedAt = time.Unix(startedAt, 0)
+		if endedAt.Valid {
+			t := time.Unix(endedAt.Int64, 0)
+			entry.EndedAt = &t
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, total, rows.Err()
+}
@@ -26,6 +26,11 @@ type ChatMessageRepository interface {
 	GetMessageIdsForUserID(userID string) ([]string, error)
 	SetMessageVisibilityForMessageIDs(messageIDs []string, visible bool) error
 	GetMessagesCount() int64
+	SaveNostrMessage(event events.NostrMessageEvent)
+	SetNostrEventID(messageID, eventID string) error
+	GetNostrEventIDsForMessageIDs(messageIDs []string) (map[string]string, error)
+	GetMessageIDForNostrEvent(eventID, pubkey string) string
+	GetMessageIDsForNostrPubkey(pubkey string) ([]string, error)
 }
 
 type SqlChatMessageRepository struct {
@@ -136,6 +141,15 @@ func makeUserMessageEventFromRowData(row rowData) events.UserMessageEvent {
 		Scopes:          scopeSlice,
 		IsBot:           isBot,
 	}
+	if row.userAvatar != nil {
+		u.Avatar = *row.userAvatar
+	}
+	if row.userNIP05 != nil {
+		u.NIP05 = *row.userNIP05
+	}
+	if row.userNIP05Verified != nil {
+		u.NIP05Verified = *row.userNIP05Verified
+	}
 
 	message := events.UserMessageEvent{
 		Event: events.Event{
@@ -223,6 +237,9 @@ type rowData struct {
 	userDisabledAt      *time.Time
 	userAuthenticatedAt *time.Time
 	userNameChangedAt   *time.Time
+	userAvatar          *string
+	userNIP05           *string
+	userNIP05Verified   *bool
 	body                string
 	eventType           models.EventType
 	id                  string
@@ -255,6 +272,9 @@ func getChat(rows *sql.Rows) ([]interface{}, error) {
 			&row.userAuthenticatedAt,
 			&row.userScopes,
 			&row.userType,
+			&row.userAvatar,
+			&row.userNIP05,
+			&row.userNIP05Verified,
 		); err != nil {
 			return nil, err
 		}
@@ -299,7 +319,7 @@ func (r *SqlChatMessageRepository) GetChatModerationHistory() []interface{} {
 	defer tx.Rollback() // nolint
 
 	// Get all messages regardless of visibility
-	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at, previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id ORDER BY timestamp DESC"
+	query := "SELECT messages.id, messages.user_id, body, title, subtitle, image, link, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at, previous_names, namechanged_at, authenticated_at, scopes, type, nostr_profiles.picture, nostr_profiles.nip05, nostr_profiles.nip05_verified FROM messages INNER JOIN users ON messages.user_id = users.id LEFT JOIN nostr_profiles ON nostr_profiles.user_id = users.id ORDER BY timestamp DESC"
 	stmt, err := tx.Prepare(query)
 	if err != nil {
 		log.Errorln("error fetching chat moderation history", err)
@@ -343,7 +363,7 @@ func (r *SqlChatMessageRepository) GetChatHistory() []interface{} {
 	defer tx.Rollback() // nolint
 
 	// Get all visible messages
-	query := "SELECT messages.id, messages.user_id, messages.body, messages.title, messages.subtitle, messages.image, messages.link, messages.eventType, messages.hidden_at, messages.timestamp, users.display_name, users.display_color, users.created_at, users.disabled_at, users.previous_names, users.namechanged_at, users.authenticated_at, users.scopes, users.type FROM users JOIN messages ON users.id = messages.user_id WHERE hidden_at IS NULL AND disabled_at IS NULL ORDER BY timestamp DESC LIMIT ?"
+	query := "SELECT messages.id, messages.user_id, messages.body, messages.title, messages.subtitle, messages.image, messages.link, messages.eventType, messages.hidden_at, messages.timestamp, users.display_name, users.display_color, users.created_at, users.disabled_at, users.previous_names, users.namechanged_at, users.authenticated_at, users.scopes, users.type, nostr_profiles.picture, nostr_profiles.nip05, nostr_profiles.nip05_verified FROM users JOIN messages ON users.id = messages.user_id LEFT JOIN nostr_profiles ON nostr_profiles.user_id = users.id WHERE hidden_at IS NULL AND disabled_at IS NULL ORDER BY timestamp DESC LIMIT ?"
 
 	stmt, err := tx.Prepare(query)
 	if err != nil {
@@ -415,7 +435,7 @@ func (r *SqlChatMessageRepository) GetMessageIdsForUserID(userID string) ([]stri
 	}
 
 	defer tx.Rollback() // nolint
-	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at,  previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id WHERE user_id IS ?"
+	query := "SELECT messages.id, messages.user_id, body, title, subtitle, image, link, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at,  previous_names, namechanged_at, authenticated_at, scopes, type, nostr_profiles.picture, nostr_profiles.nip05, nostr_profiles.nip05_verified FROM messages INNER JOIN users ON messages.user_id = users.id LEFT JOIN nostr_profiles ON nostr_profiles.user_id = users.id WHERE messages.user_id IS ?"
 
 	stmt, err := tx.Prepare(query)
 	if err != nil {
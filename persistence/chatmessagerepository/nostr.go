@@ -0,0 +1,90 @@
+package chatmessagerepository
+
+import (
+	"strings"
+
+	"github.com/TekkadanPlays/oni/core/chat/events"
+)
+
+// Messages bridged to or from Nostr keep the Nostr event ID in the link
+// column. Messages received from Nostr keep the author's pubkey in the
+// subtitle column and their profile name in the title column.
+
+// SaveNostrMessage will save a chat message received from a Nostr client.
+func (r *SqlChatMessageRepository) SaveNostrMessage(event events.NostrMessageEvent) {
+	r.SaveEvent(event.ID, nil, event.Body, events.NostrMessageSent, nil, event.Timestamp, event.Image, &event.NostrEventID, &event.DisplayName, &event.Pubkey)
+}
+
+// SetNostrEventID will record the Nostr event a chat message was published as.
+func (r *SqlChatMessageRepository) SetNostrEventID(messageID, eventID string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE messages SET link = ? WHERE id = ?", eventID, messageID)
+	return err
+}
+
+// GetNostrEventIDsForMessageIDs will return the Nostr events the given chat
+// messages were published as, keyed by message ID. Only messages sent by
+// local users are included as those are the only ones the server signed.
+func (r *SqlChatMessageRepository) GetNostrEventIDsForMessageIDs(messageIDs []string) (map[string]string, error) {
+	result := map[string]string{}
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	args := make([]interface{}, len(messageIDs)+1)
+	args[0] = events.MessageSent
+	for i, id := range messageIDs {
+		args[i+1] = id
+	}
+
+	// nolint:gosec
+	rows, err := r.datastore.DB.Query("SELECT id, link FROM messages WHERE eventType = ? AND link IS NOT NULL AND id IN (?"+strings.Repeat(",?", len(messageIDs)-1)+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, link string
+		if err := rows.Scan(&id, &link); err != nil {
+			return nil, err
+		}
+		result[id] = link
+	}
+
+	return result, rows.Err()
+}
+
+// GetMessageIDForNostrEvent will return the chat message a Nostr event by
+// the given author was received as, or an empty string if there is none.
+func (r *SqlChatMessageRepository) GetMessageIDForNostrEvent(eventID, pubkey string) string {
+	var id string
+	row := r.datastore.DB.QueryRow("SELECT id FROM messages WHERE eventType = ? AND link = ? AND subtitle = ?", events.NostrMessageSent, eventID, pubkey)
+	if err := row.Scan(&id); err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// GetMessageIDsForNostrPubkey will return the chat messages received from a Nostr pubkey.
+func (r *SqlChatMessageRepository) GetMessageIDsForNostrPubkey(pubkey string) ([]string, error) {
+	rows, err := r.datastore.DB.Query("SELECT id FROM messages WHERE eventType = ? AND subtitle = ?", events.NostrMessageSent, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -14,6 +14,13 @@ const (
 	httpListenAddressKey            = "http_listen_address"
 	websocketHostOverrideKey        = "websocket_host_override"
 	rtmpPortNumberKey               = "rtmp_port_number"
+	srtPortNumberKey                = "srt_port_number"
+	webRTCPortNumberKey             = "webrtc_port_number"
+	rtmpsConfigKey                  = "rtmps_configuration"
+	rtmpTakeoverConfigKey           = "rtmp_takeover_configuration"
+	restreamDestinationsKey         = "restream_destinations"
+	publishCallbackConfigKey        = "publish_callback_configuration"
+	reconnectGracePeriodKey         = "reconnect_grace_period"
 	serverMetadataTagsKey           = "server_metadata_tags"
 	directoryEnabledKey             = "directory_enabled"
 	directoryRegistrationKeyKey     = "directory_registration_key"
@@ -49,6 +56,7 @@ const (
 	notificationsEnabledKey         = "notifications_enabled"
 	discordConfigurationKey         = "discord_configuration"
 	browserPushConfigurationKey     = "browser_push_configuration"
+	nostrNotificationConfigKey      = "nostr_notification_configuration"
 	browserPushPublicKeyKey         = "browser_push_public_key"
 	// nolint:gosec
 	browserPushPrivateKeyKey             = "browser_push_private_key"
@@ -60,4 +68,14 @@ const (
 	disableSearchIndexingKey             = "disable_search_indexing"
 	videoServingEndpointKey              = "video_serving_endpoint"
 	adminNostrPubkeyKey                  = "admin_nostr_pubkey"
+	// nolint:gosec
+	nostrPrivateKeyKey        = "nostr_private_key"
+	nostrBannedPubkeysKey     = "nostr_banned_pubkeys"
+	nostrRelaysKey            = "nostr_relays"
+	nostrLightningAddressKey  = "nostr_lightning_address"
+	nostrWalletConnectKey     = "nostr_wallet_connect"
+	nostrNIP05NamesKey        = "nostr_nip05_names"
+	nostrMuteListKey          = "nostr_mute_list"
+	nostrLegacyVideoEventsKey = "nostr_legacy_video_events"
+	nostrRelayEnabledKey      = "nostr_relay_enabled"
 )
@@ -35,6 +35,20 @@ type ConfigRepository interface {
 	SetHTTPListenAddress(address string) error
 	GetRTMPPortNumber() int
 	SetRTMPPortNumber(port float64) error
+	GetRTMPSConfig() models.RTMPSConfiguration
+	SetRTMPSConfig(config models.RTMPSConfiguration) error
+	GetSRTPortNumber() int
+	SetSRTPortNumber(port float64) error
+	GetWebRTCPortNumber() int
+	SetWebRTCPortNumber(port float64) error
+	GetRTMPTakeoverConfig() models.RTMPTakeoverConfiguration
+	SetRTMPTakeoverConfig(config models.RTMPTakeoverConfiguration) error
+	GetRestreamDestinations() []models.RestreamDestination
+	SetRestreamDestinations(destinations []models.RestreamDestination) error
+	GetPublishCallbackConfig() models.PublishCallbackConfiguration
+	SetPublishCallbackConfig(config models.PublishCallbackConfiguration) error
+	GetReconnectGracePeriod() time.Duration
+	SetReconnectGracePeriod(seconds float64) error
 	GetServerMetadataTags() []string
 	SetServerMetadataTags(tags []string) error
 	GetDirectoryEnabled() bool
@@ -101,6 +115,8 @@ type ConfigRepository interface {
 	GetNotificationsEnabled() bool
 	GetDiscordConfig() models.DiscordConfiguration
 	SetDiscordConfig(config models.DiscordConfiguration) error
+	GetNostrNotificationConfig() models.NostrNotificationConfiguration
+	SetNostrNotificationConfig(config models.NostrNotificationConfiguration) error
 	GetBrowserPushConfig() models.BrowserNotificationConfiguration
 	SetBrowserPushConfig(config models.BrowserNotificationConfiguration) error
 	SetBrowserPushPublicKey(key string) error
@@ -129,4 +145,22 @@ type ConfigRepository interface {
 	SetPrivateKey(key string) error
 	GetAdminNostrPubkey() string
 	SetAdminNostrPubkey(pubkey string) error
+	GetNostrPrivateKey() string
+	SetNostrPrivateKey(key string) error
+	GetNostrRelays() []string
+	SetNostrRelays(relays []string) error
+	GetNostrBannedPubkeys() []string
+	SetNostrBannedPubkeys(pubkeys []string) error
+	GetNostrLightningAddress() string
+	SetNostrLightningAddress(address string) error
+	GetNostrWalletConnect() string
+	SetNostrWalletConnect(uri string) error
+	GetNostrNIP05Names() map[string]string
+	SetNostrNIP05Names(names map[string]string) error
+	GetNostrMuteList() models.NostrMuteList
+	SetNostrMuteList(list models.NostrMuteList) error
+	GetNostrLegacyVideoEvents() bool
+	SetNostrLegacyVideoEvents(enabled bool) error
+	GetNostrRelayEnabled() bool
+	SetNostrRelayEnabled(enabled bool) error
 }
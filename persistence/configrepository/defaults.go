@@ -18,6 +18,8 @@ func (r *SqlConfigRepository) PopulateDefaults() {
 	_ = r.SetStreamKeys(defaults.StreamKeys)
 	_ = r.SetHTTPPortNumber(float64(defaults.WebServerPort))
 	_ = r.SetRTMPPortNumber(float64(defaults.RTMPServerPort))
+	_ = r.SetSRTPortNumber(float64(defaults.SRTServerPort))
+	_ = r.SetWebRTCPortNumber(float64(defaults.WebRTCServerPort))
 	_ = r.SetLogoPath(defaults.Logo)
 	_ = r.SetServerMetadataTags([]string{"owncast", "streaming"})
 	_ = r.SetServerSummary(defaults.Summary)
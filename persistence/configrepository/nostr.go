@@ -0,0 +1,136 @@
+package configrepository
+
+import "github.com/TekkadanPlays/oni/models"
+
+// GetNostrPrivateKey will return the encrypted private key the server signs Nostr events with.
+func (r *SqlConfigRepository) GetNostrPrivateKey() string {
+	value, _ := r.datastore.GetString(nostrPrivateKeyKey)
+	return value
+}
+
+// SetNostrPrivateKey will save the encrypted private key the server signs Nostr events with.
+func (r *SqlConfigRepository) SetNostrPrivateKey(key string) error {
+	return r.datastore.SetString(nostrPrivateKeyKey, key)
+}
+
+// GetNostrRelays will return the relay URLs the server publishes Nostr events to.
+func (r *SqlConfigRepository) GetNostrRelays() []string {
+	relays, err := r.datastore.GetStringSlice(nostrRelaysKey)
+	if err != nil {
+		return []string{}
+	}
+
+	return relays
+}
+
+// SetNostrRelays will save the relay URLs the server publishes Nostr events to.
+func (r *SqlConfigRepository) SetNostrRelays(relays []string) error {
+	return r.datastore.SetStringSlice(nostrRelaysKey, relays)
+}
+
+// GetNostrBannedPubkeys will return the Nostr pubkeys banned from chat.
+func (r *SqlConfigRepository) GetNostrBannedPubkeys() []string {
+	pubkeys, err := r.datastore.GetStringSlice(nostrBannedPubkeysKey)
+	if err != nil {
+		return []string{}
+	}
+
+	return pubkeys
+}
+
+// SetNostrBannedPubkeys will save the Nostr pubkeys banned from chat.
+func (r *SqlConfigRepository) SetNostrBannedPubkeys(pubkeys []string) error {
+	return r.datastore.SetStringSlice(nostrBannedPubkeysKey, pubkeys)
+}
+
+// GetNostrLightningAddress will return the lightning address zaps to the stream are forwarded to.
+func (r *SqlConfigRepository) GetNostrLightningAddress() string {
+	value, _ := r.datastore.GetString(nostrLightningAddressKey)
+	return value
+}
+
+// SetNostrLightningAddress will save the lightning address zaps to the stream are forwarded to.
+func (r *SqlConfigRepository) SetNostrLightningAddress(address string) error {
+	return r.datastore.SetString(nostrLightningAddressKey, address)
+}
+
+// GetNostrWalletConnect will return the encrypted Nostr Wallet Connect URI of the streamer's wallet.
+func (r *SqlConfigRepository) GetNostrWalletConnect() string {
+	value, _ := r.datastore.GetString(nostrWalletConnectKey)
+	return value
+}
+
+// SetNostrWalletConnect will save the encrypted Nostr Wallet Connect URI of the streamer's wallet.
+func (r *SqlConfigRepository) SetNostrWalletConnect(uri string) error {
+	return r.datastore.SetString(nostrWalletConnectKey, uri)
+}
+
+// GetNostrNIP05Names will return the extra NIP-05 names served for the server, mapped to hex pubkeys.
+func (r *SqlConfigRepository) GetNostrNIP05Names() map[string]string {
+	names, err := r.datastore.GetStringMap(nostrNIP05NamesKey)
+	if err != nil {
+		return map[string]string{}
+	}
+
+	return names
+}
+
+// SetNostrNIP05Names will save the extra NIP-05 names served for the server.
+func (r *SqlConfigRepository) SetNostrNIP05Names(names map[string]string) error {
+	return r.datastore.SetStringMap(nostrNIP05NamesKey, names)
+}
+
+// GetNostrMuteList will return the admin's Nostr mute list last applied to chat.
+func (r *SqlConfigRepository) GetNostrMuteList() models.NostrMuteList {
+	configEntry, err := r.datastore.Get(nostrMuteListKey)
+	if err != nil {
+		return models.NostrMuteList{}
+	}
+
+	var list models.NostrMuteList
+	if err := configEntry.GetObject(&list); err != nil {
+		return models.NostrMuteList{}
+	}
+
+	return list
+}
+
+// SetNostrMuteList will save the admin's Nostr mute list applied to chat.
+func (r *SqlConfigRepository) SetNostrMuteList(list models.NostrMuteList) error {
+	configEntry := models.ConfigEntry{Key: nostrMuteListKey, Value: list}
+	return r.datastore.Save(configEntry)
+}
+
+// GetNostrLegacyVideoEvents will return if recordings are also announced
+// with kind 34235 video events for older Nostr clients.
+func (r *SqlConfigRepository) GetNostrLegacyVideoEvents() bool {
+	enabled, err := r.datastore.GetBool(nostrLegacyVideoEventsKey)
+	if err != nil {
+		return false
+	}
+
+	return enabled
+}
+
+// SetNostrLegacyVideoEvents will set if recordings are also announced with
+// kind 34235 video events for older Nostr clients.
+func (r *SqlConfigRepository) SetNostrLegacyVideoEvents(enabled bool) error {
+	return r.datastore.SetBool(nostrLegacyVideoEventsKey, enabled)
+}
+
+// GetNostrRelayEnabled will return if the server runs its own Nostr relay
+// for the stream.
+func (r *SqlConfigRepository) GetNostrRelayEnabled() bool {
+	enabled, err := r.datastore.GetBool(nostrRelayEnabledKey)
+	if err != nil {
+		return false
+	}
+
+	return enabled
+}
+
+// SetNostrRelayEnabled will set if the server runs its own Nostr relay for
+// the stream.
+func (r *SqlConfigRepository) SetNostrRelayEnabled(enabled bool) error {
+	return r.datastore.SetBool(nostrRelayEnabledKey, enabled)
+}
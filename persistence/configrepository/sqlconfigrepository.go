@@ -264,6 +264,164 @@ func (r *SqlConfigRepository) SetRTMPPortNumber(port float64) error {
 	return r.datastore.SetNumber(rtmpPortNumberKey, port)
 }
 
+// GetRTMPSConfig will return the RTMPS listener configuration.
+func (r *SqlConfigRepository) GetRTMPSConfig() models.RTMPSConfiguration {
+	defaultConfig := models.RTMPSConfiguration{Port: config.GetDefaults().RTMPSServerPort}
+
+	configEntry, err := r.datastore.Get(rtmpsConfigKey)
+	if err != nil {
+		return defaultConfig
+	}
+
+	var rtmpsConfig models.RTMPSConfiguration
+	if err := configEntry.GetObject(&rtmpsConfig); err != nil {
+		return defaultConfig
+	}
+
+	if rtmpsConfig.Port == 0 {
+		rtmpsConfig.Port = defaultConfig.Port
+	}
+
+	return rtmpsConfig
+}
+
+// SetRTMPSConfig will set the RTMPS listener configuration.
+func (r *SqlConfigRepository) SetRTMPSConfig(config models.RTMPSConfiguration) error {
+	configEntry := models.ConfigEntry{Key: rtmpsConfigKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetSRTPortNumber will return the server SRT port.
+func (r *SqlConfigRepository) GetSRTPortNumber() int {
+	port, err := r.datastore.GetNumber(srtPortNumberKey)
+	if err != nil {
+		log.Traceln(srtPortNumberKey, err)
+		return config.GetDefaults().SRTServerPort
+	}
+
+	if port == 0 {
+		return config.GetDefaults().SRTServerPort
+	}
+
+	return int(port)
+}
+
+// SetSRTPortNumber will set the server SRT port.
+func (r *SqlConfigRepository) SetSRTPortNumber(port float64) error {
+	return r.datastore.SetNumber(srtPortNumberKey, port)
+}
+
+// GetWebRTCPortNumber will return the UDP port WHIP broadcasters send
+// their WebRTC media to.
+func (r *SqlConfigRepository) GetWebRTCPortNumber() int {
+	port, err := r.datastore.GetNumber(webRTCPortNumberKey)
+	if err != nil {
+		log.Traceln(webRTCPortNumberKey, err)
+		return config.GetDefaults().WebRTCServerPort
+	}
+
+	if port == 0 {
+		return config.GetDefaults().WebRTCServerPort
+	}
+
+	return int(port)
+}
+
+// SetWebRTCPortNumber will set the WebRTC UDP port.
+func (r *SqlConfigRepository) SetWebRTCPortNumber(port float64) error {
+	return r.datastore.SetNumber(webRTCPortNumberKey, port)
+}
+
+// GetRTMPTakeoverConfig will return how a second inbound RTMP connection
+// is handled while a stream is running.
+func (r *SqlConfigRepository) GetRTMPTakeoverConfig() models.RTMPTakeoverConfiguration {
+	defaultConfig := models.RTMPTakeoverConfiguration{Policy: models.RTMPTakeoverReject}
+
+	configEntry, err := r.datastore.Get(rtmpTakeoverConfigKey)
+	if err != nil {
+		return defaultConfig
+	}
+
+	var config models.RTMPTakeoverConfiguration
+	if err := configEntry.GetObject(&config); err != nil || !config.IsValid() {
+		return defaultConfig
+	}
+
+	return config
+}
+
+// SetRTMPTakeoverConfig will set how a second inbound RTMP connection is
+// handled while a stream is running.
+func (r *SqlConfigRepository) SetRTMPTakeoverConfig(config models.RTMPTakeoverConfiguration) error {
+	configEntry := models.ConfigEntry{Key: rtmpTakeoverConfigKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetRestreamDestinations will return the external services the stream is
+// forwarded to.
+func (r *SqlConfigRepository) GetRestreamDestinations() []models.RestreamDestination {
+	configEntry, err := r.datastore.Get(restreamDestinationsKey)
+	if err != nil {
+		return []models.RestreamDestination{}
+	}
+
+	var destinations []models.RestreamDestination
+	if err := configEntry.GetObject(&destinations); err != nil {
+		return []models.RestreamDestination{}
+	}
+
+	return destinations
+}
+
+// SetRestreamDestinations will set the external services the stream is
+// forwarded to.
+func (r *SqlConfigRepository) SetRestreamDestinations(destinations []models.RestreamDestination) error {
+	configEntry := models.ConfigEntry{Key: restreamDestinationsKey, Value: destinations}
+	return r.datastore.Save(configEntry)
+}
+
+// GetPublishCallbackConfig will return the HTTP callback that decides if a
+// broadcaster may stream.
+func (r *SqlConfigRepository) GetPublishCallbackConfig() models.PublishCallbackConfiguration {
+	defaultConfig := models.PublishCallbackConfiguration{CacheSeconds: config.GetDefaults().PublishCallbackCacheSeconds}
+
+	configEntry, err := r.datastore.Get(publishCallbackConfigKey)
+	if err != nil {
+		return defaultConfig
+	}
+
+	var callbackConfig models.PublishCallbackConfiguration
+	if err := configEntry.GetObject(&callbackConfig); err != nil {
+		return defaultConfig
+	}
+
+	return callbackConfig
+}
+
+// SetPublishCallbackConfig will set the HTTP callback that decides if a
+// broadcaster may stream.
+func (r *SqlConfigRepository) SetPublishCallbackConfig(config models.PublishCallbackConfiguration) error {
+	configEntry := models.ConfigEntry{Key: publishCallbackConfigKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetReconnectGracePeriod will return how long a broadcast waits for the
+// broadcaster to reconnect before going offline. Zero disables waiting.
+func (r *SqlConfigRepository) GetReconnectGracePeriod() time.Duration {
+	seconds, err := r.datastore.GetNumber(reconnectGracePeriodKey)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// SetReconnectGracePeriod will set how many seconds a broadcast waits for
+// the broadcaster to reconnect before going offline.
+func (r *SqlConfigRepository) SetReconnectGracePeriod(seconds float64) error {
+	return r.datastore.SetNumber(reconnectGracePeriodKey, seconds)
+}
+
 // GetServerMetadataTags will return the metadata tags.
 func (r *SqlConfigRepository) GetServerMetadataTags() []string {
 	tagsString, err := r.datastore.GetString(serverMetadataTagsKey)
@@ -872,6 +1030,29 @@ func (r *SqlConfigRepository) SetDiscordConfig(config models.DiscordConfiguratio
 	return r.datastore.Save(configEntry)
 }
 
+// GetNostrNotificationConfig will return the Nostr direct message
+// notification configuration.
+func (r *SqlConfigRepository) GetNostrNotificationConfig() models.NostrNotificationConfiguration {
+	configEntry, err := r.datastore.Get(nostrNotificationConfigKey)
+	if err != nil {
+		return models.NostrNotificationConfiguration{Enabled: false}
+	}
+
+	var config models.NostrNotificationConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.NostrNotificationConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetNostrNotificationConfig will set the Nostr direct message
+// notification configuration.
+func (r *SqlConfigRepository) SetNostrNotificationConfig(config models.NostrNotificationConfiguration) error {
+	configEntry := models.ConfigEntry{Key: nostrNotificationConfigKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
 // GetBrowserPushConfig will return the browser push configuration.
 func (r *SqlConfigRepository) GetBrowserPushConfig() models.BrowserNotificationConfiguration {
 	configEntry, err := r.datastore.Get(browserPushConfigurationKey)
@@ -0,0 +1,125 @@
+package nip05repository
+
+import (
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/pkg/errors"
+)
+
+// ErrNameTaken is returned when another user already has the name.
+var ErrNameTaken = errors.New("name is already taken")
+
+type NIP05Repository interface {
+	SetUserName(userID, name, pubkey string) error
+	RemoveUser(userID string) error
+	GetNameForUser(userID string) string
+	GetPubkey(name string) string
+	GetNames() (map[string]string, error)
+}
+
+type SqlNIP05Repository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance NIP05Repository
+
+// Get will return the NIP-05 name repository.
+func Get() NIP05Repository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the NIP05Repository.
+func New(datastore *data.Datastore) NIP05Repository {
+	r := SqlNIP05Repository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// SetUserName will give the chat user the name, replacing any name they had.
+func (r *SqlNIP05Repository) SetUserName(userID, name, pubkey string) error {
+	var owner string
+	if err := r.datastore.DB.QueryRow("SELECT user_id FROM nip05_names WHERE name = ?", name).Scan(&owner); err == nil && owner != userID {
+		return ErrNameTaken
+	}
+
+	tx, err := r.datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // nolint
+
+	if _, err := tx.Exec("DELETE FROM nip05_names WHERE user_id = ?", userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO nip05_names(name, user_id, pubkey, created_at) values(?, ?, ?, ?)",
+		name, userID, pubkey, time.Now().Unix(),
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RemoveUser will remove the name of the chat user.
+func (r *SqlNIP05Repository) RemoveUser(userID string) error {
+	_, err := r.datastore.DB.Exec("DELETE FROM nip05_names WHERE user_id = ?", userID)
+	return err
+}
+
+// GetNameForUser will return the name of the chat user, if they opted in.
+func (r *SqlNIP05Repository) GetNameForUser(userID string) string {
+	var name string
+	if err := r.datastore.DB.QueryRow("SELECT name FROM nip05_names WHERE user_id = ?", userID).Scan(&name); err != nil {
+		return ""
+	}
+
+	return name
+}
+
+// GetPubkey will return the pubkey of an enabled chat user with the name.
+func (r *SqlNIP05Repository) GetPubkey(name string) string {
+	var pubkey string
+	err := r.datastore.DB.QueryRow(
+		`SELECT n.pubkey FROM nip05_names n JOIN users u ON u.id = n.user_id
+		WHERE n.name = ? AND u.disabled_at IS NULL`,
+		name,
+	).Scan(&pubkey)
+	if err != nil {
+		return ""
+	}
+
+	return pubkey
+}
+
+// GetNames will return the pubkey of every enabled chat user by name.
+func (r *SqlNIP05Repository) GetNames() (map[string]string, error) {
+	rows, err := r.datastore.DB.Query(
+		`SELECT n.name, n.pubkey FROM nip05_names n JOIN users u ON u.id = n.user_id
+		WHERE u.disabled_at IS NULL`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := map[string]string{}
+	for rows.Next() {
+		var name, pubkey string
+		if err := rows.Scan(&name, &pubkey); err != nil {
+			return nil, err
+		}
+		names[name] = pubkey
+	}
+
+	return names, rows.Err()
+}
@@ -0,0 +1,133 @@
+package recordingrepository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+	log "github.com/sirupsen/logrus"
+)
+
+type RecordingRepository interface {
+	GetRecordings(limit, offset int) ([]*models.Recording, int, error)
+	GetRecording(id string) *models.Recording
+	SetRecording(recording *models.Recording) error
+	RemoveRecording(id string) error
+}
+
+type SqlRecordingRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance RecordingRepository
+
+// Get will return the recording repository.
+func Get() RecordingRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the RecordingRepository.
+func New(datastore *data.Datastore) RecordingRepository {
+	r := SqlRecordingRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+const recordingColumns = "id, live_activity, title, summary, playlist_url, video_url, thumbnail_url, width, height, event_id, legacy_event_id, event_kind, started_at, ended_at, published_at"
+
+// GetRecordings will return a page of the past broadcasts, newest first,
+// and the total number of them.
+func (r *SqlRecordingRepository) GetRecordings(limit, offset int) ([]*models.Recording, int, error) {
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM recordings").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT "+recordingColumns+" FROM recordings ORDER BY started_at DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	recordings := []*models.Recording{}
+	for rows.Next() {
+		recording, err := scanRecording(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		recordings = append(recordings, recording)
+	}
+
+	return recordings, total, rows.Err()
+}
+
+// GetRecording will return the recording of the broadcast, or nil if there
+// is none.
+func (r *SqlRecordingRepository) GetRecording(id string) *models.Recording {
+	row := r.datastore.DB.QueryRow("SELECT "+recordingColumns+" FROM recordings WHERE id = ?", id)
+
+	recording, err := scanRecording(row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Errorln("error fetching recording", err)
+		}
+		return nil
+	}
+
+	return recording
+}
+
+// SetRecording will save the recording, replacing the one of the same
+// broadcast if it exists.
+func (r *SqlRecordingRepository) SetRecording(recording *models.Recording) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	var publishedAt sql.NullInt64
+	if recording.PublishedAt != nil {
+		publishedAt = sql.NullInt64{Int64: recording.PublishedAt.Unix(), Valid: true}
+	}
+
+	_, err := r.datastore.DB.Exec("INSERT OR REPLACE INTO recordings ("+recordingColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		recording.ID, recording.LiveActivity, recording.Title, recording.Summary, recording.PlaylistURL, recording.VideoURL, recording.ThumbnailURL,
+		recording.Width, recording.Height, recording.EventID, recording.LegacyEventID, recording.EventKind,
+		recording.StartedAt.Unix(), recording.EndedAt.Unix(), publishedAt)
+
+	return err
+}
+
+// RemoveRecording will remove the recording of the broadcast.
+func (r *SqlRecordingRepository) RemoveRecording(id string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM recordings WHERE id = ?", id)
+	return err
+}
+
+func scanRecording(row interface{ Scan(...interface{}) error }) (*models.Recording, error) {
+	recording := &models.Recording{}
+	var startedAt, endedAt int64
+	var publishedAt sql.NullInt64
+	if err := row.Scan(&recording.ID, &recording.LiveActivity, &recording.Title, &recording.Summary, &recording.PlaylistURL, &recording.VideoURL, &recording.ThumbnailURL,
+		&recording.Width, &recording.Height, &recording.EventID, &recording.LegacyEventID, &recording.EventKind,
+		&startedAt, &endedAt, &publishedAt); err != nil {
+		return nil, err
+	}
+	recording.StartedAt = time.Unix(startedAt, 0)
+	recording.EndedAt = time.Unix(endedAt, 0)
+	if publishedAt.Valid {
+		t := time.Unix(publishedAt.Int64, 0)
+		recording.PublishedAt = &t
+	}
+
+	return recording, nil
+}
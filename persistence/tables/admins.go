@@ -0,0 +1,43 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateAdminPubkeysTable will create the table of Nostr pubkeys given a
+// role on the admin API.
+func CreateAdminPubkeysTable(db *sql.DB) {
+	log.Traceln("Creating admin pubkeys table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS admin_pubkeys (
+		"pubkey" TEXT NOT NULL PRIMARY KEY,
+		"role" TEXT NOT NULL,
+		"label" TEXT NOT NULL DEFAULT '',
+		"added_by" TEXT NOT NULL DEFAULT '',
+		"created_at" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+}
+
+// CreateAdminAuditLogTable will create the table of changes made through
+// the admin API.
+func CreateAdminAuditLogTable(db *sql.DB) {
+	log.Traceln("Creating admin audit log table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS admin_audit_log (
+		"id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+		"timestamp" INTEGER NOT NULL,
+		"pubkey" TEXT NOT NULL DEFAULT '',
+		"role" TEXT NOT NULL,
+		"method" TEXT NOT NULL,
+		"path" TEXT NOT NULL,
+		"status" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_admin_audit_log_timestamp ON admin_audit_log (timestamp);`, db)
+}
@@ -0,0 +1,27 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateBroadcastHistoryTable will create the table of which stream key
+// started each broadcast.
+func CreateBroadcastHistoryTable(db *sql.DB) {
+	log.Traceln("Creating broadcast history table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS broadcast_history (
+		"id" TEXT NOT NULL PRIMARY KEY,
+		"stream_key_label" TEXT NOT NULL DEFAULT '',
+		"stream_key_hint" TEXT NOT NULL DEFAULT '',
+		"protocol" TEXT NOT NULL DEFAULT '',
+		"remote_address" TEXT NOT NULL DEFAULT '',
+		"started_at" INTEGER NOT NULL,
+		"ended_at" INTEGER
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_broadcast_history_started_at ON broadcast_history (started_at);`, db)
+}
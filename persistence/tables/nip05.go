@@ -0,0 +1,22 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateNIP05NamesTable will create the table of NIP-05 names chat users opted in to.
+func CreateNIP05NamesTable(db *sql.DB) {
+	log.Traceln("Creating nip05 names table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS nip05_names (
+		"name" TEXT NOT NULL PRIMARY KEY,
+		"user_id" TEXT NOT NULL UNIQUE,
+		"pubkey" TEXT NOT NULL,
+		"created_at" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+}
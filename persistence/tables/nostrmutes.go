@@ -0,0 +1,24 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateNostrMutesTable will create the table of chat users disabled
+// because of the admin's Nostr mute list.
+func CreateNostrMutesTable(db *sql.DB) {
+	log.Traceln("Creating nostr mutes table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS nostr_mutes (
+		"user_id" TEXT NOT NULL PRIMARY KEY,
+		"type" TEXT NOT NULL,
+		"value" TEXT NOT NULL,
+		"ip_addresses" TEXT NOT NULL DEFAULT '',
+		"created_at" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+}
@@ -0,0 +1,28 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateNostrProfilesTable will create the table of Nostr profiles synced
+// for chat users with a linked pubkey.
+func CreateNostrProfilesTable(db *sql.DB) {
+	log.Traceln("Creating nostr profiles table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS nostr_profiles (
+		"user_id" TEXT NOT NULL PRIMARY KEY,
+		"pubkey" TEXT NOT NULL,
+		"name" TEXT NOT NULL DEFAULT '',
+		"picture" TEXT NOT NULL DEFAULT '',
+		"nip05" TEXT NOT NULL DEFAULT '',
+		"nip05_verified" INTEGER NOT NULL DEFAULT 0,
+		"profile_created_at" INTEGER NOT NULL DEFAULT 0,
+		"fetched_at" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_nostr_profiles_pubkey ON nostr_profiles (pubkey);`, db)
+}
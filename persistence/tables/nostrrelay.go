@@ -0,0 +1,39 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateNostrRelayTables will create the tables the built-in Nostr relay
+// stores its events and their single letter tags in.
+func CreateNostrRelayTables(db *sql.DB) {
+	log.Traceln("Creating nostr relay tables...")
+
+	createEventsTableSQL := `CREATE TABLE IF NOT EXISTS nostr_relay_events (
+		"id" TEXT NOT NULL PRIMARY KEY,
+		"pubkey" TEXT NOT NULL,
+		"kind" INTEGER NOT NULL,
+		"created_at" INTEGER NOT NULL,
+		"d_tag" TEXT NOT NULL DEFAULT '',
+		"raw" TEXT NOT NULL,
+		"received_at" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createEventsTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_nostr_relay_events_created_at ON nostr_relay_events (created_at);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_nostr_relay_events_pubkey_kind ON nostr_relay_events (pubkey, kind, d_tag);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_nostr_relay_events_received_at ON nostr_relay_events (received_at);`, db)
+
+	createTagsTableSQL := `CREATE TABLE IF NOT EXISTS nostr_relay_tags (
+		"event_id" TEXT NOT NULL,
+		"name" TEXT NOT NULL,
+		"value" TEXT NOT NULL
+	);`
+
+	utils.MustExec(createTagsTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_nostr_relay_tags_name_value ON nostr_relay_tags (name, value);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_nostr_relay_tags_event_id ON nostr_relay_tags (event_id);`, db)
+}
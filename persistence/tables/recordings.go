@@ -0,0 +1,35 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateRecordingsTable will create the table of past broadcasts and the
+// recordings they can be replayed from.
+func CreateRecordingsTable(db *sql.DB) {
+	log.Traceln("Creating recordings table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS recordings (
+		"id" TEXT NOT NULL PRIMARY KEY,
+		"live_activity" TEXT NOT NULL DEFAULT '',
+		"title" TEXT NOT NULL DEFAULT '',
+		"summary" TEXT NOT NULL DEFAULT '',
+		"playlist_url" TEXT NOT NULL DEFAULT '',
+		"video_url" TEXT NOT NULL DEFAULT '',
+		"thumbnail_url" TEXT NOT NULL DEFAULT '',
+		"width" INTEGER NOT NULL DEFAULT 0,
+		"height" INTEGER NOT NULL DEFAULT 0,
+		"event_id" TEXT NOT NULL DEFAULT '',
+		"legacy_event_id" TEXT NOT NULL DEFAULT '',
+		"event_kind" INTEGER NOT NULL DEFAULT 0,
+		"started_at" INTEGER NOT NULL,
+		"ended_at" INTEGER NOT NULL,
+		"published_at" INTEGER
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_recordings_started_at ON recordings (started_at);`, db)
+}
@@ -0,0 +1,26 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/TekkadanPlays/oni/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateZapsTable will create the Nostr zaps table if needed.
+func CreateZapsTable(db *sql.DB) {
+	log.Traceln("Creating zaps table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS zaps (
+		"id" TEXT NOT NULL PRIMARY KEY,
+		"stream_id" TEXT NOT NULL,
+		"sender" TEXT NOT NULL,
+		"recipient" TEXT NOT NULL,
+		"comment" TEXT,
+		"amount_msat" INTEGER NOT NULL,
+		"paid_at" INTEGER NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_zaps_stream_id ON zaps (stream_id);`, db)
+}
@@ -0,0 +1,226 @@
+package userrepository
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetNostrProfile will save the Nostr profile synced for a user.
+func (r *SqlUserRepository) SetNostrProfile(profile *models.NostrProfile) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec(`INSERT INTO nostr_profiles (user_id, pubkey, name, picture, nip05, nip05_verified, profile_created_at, fetched_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET pubkey = excluded.pubkey, name = excluded.name, picture = excluded.picture,
+			nip05 = excluded.nip05, nip05_verified = excluded.nip05_verified,
+			profile_created_at = excluded.profile_created_at, fetched_at = excluded.fetched_at`,
+		profile.UserID, profile.Pubkey, profile.Name, profile.Picture, profile.NIP05, profile.NIP05Verified, profile.CreatedAt, profile.FetchedAt.Unix())
+
+	return err
+}
+
+// GetNostrProfile will return the Nostr profile synced for a user, or nil
+// if there is none.
+func (r *SqlUserRepository) GetNostrProfile(userID string) *models.NostrProfile {
+	row := r.datastore.DB.QueryRow("SELECT user_id, pubkey, name, picture, nip05, nip05_verified, profile_created_at, fetched_at FROM nostr_profiles WHERE user_id = ?", userID)
+
+	profile, err := scanNostrProfile(row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Errorln("error fetching nostr profile", err)
+		}
+		return nil
+	}
+
+	return profile
+}
+
+// GetNostrProfilesToRefresh will return the users with a linked Nostr
+// pubkey whose profile was never synced or was last synced before the
+// given time, oldest first. Only the user ID and pubkey are set.
+func (r *SqlUserRepository) GetNostrProfilesToRefresh(before time.Time, limit int) ([]*models.NostrProfile, error) {
+	rows, err := r.datastore.DB.Query(`SELECT auth.user_id, auth.token FROM auth
+		LEFT JOIN nostr_profiles ON nostr_profiles.user_id = auth.user_id
+		WHERE auth.type = ? AND (nostr_profiles.fetched_at IS NULL OR nostr_profiles.fetched_at < ? OR nostr_profiles.pubkey != auth.token)
+		ORDER BY COALESCE(nostr_profiles.fetched_at, 0) LIMIT ?`, string(models.Nostr), before.Unix(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	profiles := []*models.NostrProfile{}
+	for rows.Next() {
+		profile := &models.NostrProfile{}
+		if err := rows.Scan(&profile.UserID, &profile.Pubkey); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+
+	return profiles, rows.Err()
+}
+
+// GetRecentNostrProfilePubkeys will return the linked pubkeys of the users
+// who most recently used chat.
+func (r *SqlUserRepository) GetRecentNostrProfilePubkeys(limit int) ([]string, error) {
+	rows, err := r.datastore.DB.Query(`SELECT DISTINCT nostr_profiles.pubkey FROM nostr_profiles
+		INNER JOIN users ON users.id = nostr_profiles.user_id
+		WHERE users.disabled_at IS NULL
+		ORDER BY users.last_used DESC LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pubkeys := []string{}
+	for rows.Next() {
+		var pubkey string
+		if err := rows.Scan(&pubkey); err != nil {
+			return nil, err
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	return pubkeys, rows.Err()
+}
+
+// addNostrProfile sets the avatar and NIP-05 identifier of the user from
+// their synced Nostr profile.
+func (r *SqlUserRepository) addNostrProfile(user *models.User) {
+	if user == nil {
+		return
+	}
+
+	row := r.datastore.DB.QueryRow("SELECT picture, nip05, nip05_verified FROM nostr_profiles WHERE user_id = ?", user.ID)
+	if err := row.Scan(&user.Avatar, &user.NIP05, &user.NIP05Verified); err != nil && err != sql.ErrNoRows {
+		log.Errorln("error fetching nostr profile", err)
+	}
+}
+
+// SetNostrMute will record that a user was disabled because of the admin's
+// Nostr mute list.
+func (r *SqlUserRepository) SetNostrMute(mute *models.NostrMute) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec(`INSERT INTO nostr_mutes (user_id, type, value, ip_addresses, created_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET type = excluded.type, value = excluded.value, ip_addresses = excluded.ip_addresses, created_at = excluded.created_at`,
+		mute.UserID, mute.Type, mute.Value, strings.Join(mute.IPAddresses, ","), mute.CreatedAt.Unix())
+
+	return err
+}
+
+// RemoveNostrMute will forget that a user was disabled because of the
+// admin's Nostr mute list.
+func (r *SqlUserRepository) RemoveNostrMute(userID string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM nostr_mutes WHERE user_id = ?", userID)
+	return err
+}
+
+// GetNostrMutes will return all the users disabled because of the admin's
+// Nostr mute list.
+func (r *SqlUserRepository) GetNostrMutes() ([]*models.NostrMute, error) {
+	rows, err := r.datastore.DB.Query("SELECT user_id, type, value, ip_addresses, created_at FROM nostr_mutes")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mutes := []*models.NostrMute{}
+	for rows.Next() {
+		mute, err := scanNostrMute(rows)
+		if err != nil {
+			return nil, err
+		}
+		mutes = append(mutes, mute)
+	}
+
+	return mutes, rows.Err()
+}
+
+// GetUserIDsForNostrPubkeys will return the IDs of the users linked to any
+// of the pubkeys, mapped to their pubkey.
+func (r *SqlUserRepository) GetUserIDsForNostrPubkeys(pubkeys []string) (map[string]string, error) {
+	users := map[string]string{}
+	if len(pubkeys) == 0 {
+		return users, nil
+	}
+
+	args := []interface{}{string(models.Nostr)}
+	for _, pubkey := range pubkeys {
+		args = append(args, pubkey)
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT user_id, token FROM auth WHERE type = ? AND token IN (?"+strings.Repeat(", ?", len(pubkeys)-1)+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID, pubkey string
+		if err := rows.Scan(&userID, &pubkey); err != nil {
+			return nil, err
+		}
+		users[userID] = pubkey
+	}
+
+	return users, rows.Err()
+}
+
+// GetNostrMuteForUser will return the Nostr mute list entry the user was
+// disabled for, or nil if the list did not disable them.
+func (r *SqlUserRepository) GetNostrMuteForUser(userID string) *models.NostrMute {
+	row := r.datastore.DB.QueryRow("SELECT user_id, type, value, ip_addresses, created_at FROM nostr_mutes WHERE user_id = ?", userID)
+
+	mute, err := scanNostrMute(row)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Errorln("error fetching nostr mute", err)
+		}
+		return nil
+	}
+
+	return mute
+}
+
+// addNostrMute sets the Nostr mute list entry the user was disabled for.
+func (r *SqlUserRepository) addNostrMute(user *models.User) {
+	if user != nil {
+		user.NostrMute = r.GetNostrMuteForUser(user.ID)
+	}
+}
+
+func scanNostrMute(row interface{ Scan(...interface{}) error }) (*models.NostrMute, error) {
+	mute := &models.NostrMute{}
+	var ipAddresses string
+	var createdAt int64
+	if err := row.Scan(&mute.UserID, &mute.Type, &mute.Value, &ipAddresses, &createdAt); err != nil {
+		return nil, err
+	}
+	if ipAddresses != "" {
+		mute.IPAddresses = strings.Split(ipAddresses, ",")
+	}
+	mute.CreatedAt = time.Unix(createdAt, 0)
+
+	return mute, nil
+}
+
+func scanNostrProfile(row *sql.Row) (*models.NostrProfile, error) {
+	profile := &models.NostrProfile{}
+	var fetchedAt int64
+	if err := row.Scan(&profile.UserID, &profile.Pubkey, &profile.Name, &profile.Picture, &profile.NIP05, &profile.NIP05Verified, &profile.CreatedAt, &fetchedAt); err != nil {
+		return nil, err
+	}
+	profile.FetchedAt = time.Unix(fetchedAt, 0)
+
+	return profile, nil
+}
@@ -39,9 +39,19 @@ type UserRepository interface {
 	SetUserAsAuthenticated(userID string) error
 	HasValidScopes(scopes []string) bool
 	GetUserByAuth(authToken string, authType models.AuthType) *models.User
+	GetAuthForUser(userID string, authType models.AuthType) string
 	AddAuth(userID, authToken string, authType models.AuthType) error
 	SetExternalAPIUserAccessTokenAsUsed(token string) error
 	GetUsersCount() int
+	SetNostrProfile(profile *models.NostrProfile) error
+	GetNostrProfile(userID string) *models.NostrProfile
+	GetNostrProfilesToRefresh(before time.Time, limit int) ([]*models.NostrProfile, error)
+	GetRecentNostrProfilePubkeys(limit int) ([]string, error)
+	SetNostrMute(mute *models.NostrMute) error
+	RemoveNostrMute(userID string) error
+	GetNostrMutes() ([]*models.NostrMute, error)
+	GetNostrMuteForUser(userID string) *models.NostrMute
+	GetUserIDsForNostrPubkeys(pubkeys []string) (map[string]string, error)
 }
 
 type SqlUserRepository struct {
@@ -241,7 +251,7 @@ func (r *SqlUserRepository) GetUserByToken(token string) *models.User {
 		authenticatedAt = &u.AuthenticatedAt.Time
 	}
 
-	return &models.User{
+	user := &models.User{
 		ID:              u.ID,
 		DisplayName:     u.DisplayName,
 		DisplayColor:    int(u.DisplayColor),
@@ -253,6 +263,9 @@ func (r *SqlUserRepository) GetUserByToken(token string) *models.User {
 		Authenticated:   authenticatedAt != nil,
 		Scopes:          scopes,
 	}
+	r.addNostrProfile(user)
+
+	return user
 }
 
 // SetAccessTokenToOwner will reassign an access token to be owned by a
@@ -295,17 +308,34 @@ func (r *SqlUserRepository) GetUserByAuth(authToken string, authType models.Auth
 		scopes = strings.Split(u.Scopes.String, ",")
 	}
 
-	return &models.User{
+	user := &models.User{
 		ID:              u.ID,
 		DisplayName:     u.DisplayName,
 		DisplayColor:    int(u.DisplayColor),
 		CreatedAt:       u.CreatedAt.Time,
-		DisabledAt:      &u.DisabledAt.Time,
 		PreviousNames:   strings.Split(u.PreviousNames.String, ","),
 		NameChangedAt:   &u.NamechangedAt.Time,
 		AuthenticatedAt: &u.AuthenticatedAt.Time,
 		Scopes:          scopes,
 	}
+	if u.DisabledAt.Valid {
+		user.DisabledAt = &u.DisabledAt.Time
+	}
+	r.addNostrProfile(user)
+
+	return user
+}
+
+// GetAuthForUser will return the external authentication token of the given
+// type a user has authenticated with, or an empty string if there is none.
+func (r *SqlUserRepository) GetAuthForUser(userID string, authType models.AuthType) string {
+	var token string
+	row := r.datastore.DB.QueryRow("SELECT token FROM auth WHERE user_id = ? AND type = ? ORDER BY timestamp DESC LIMIT 1", userID, string(authType))
+	if err := row.Scan(&token); err != nil {
+		return ""
+	}
+
+	return token
 }
 
 // SetModerator will add or remove moderator status for a single user by ID.
@@ -387,7 +417,11 @@ func (r *SqlUserRepository) GetUserByID(id string) *models.User {
 		log.Errorln(row)
 		return nil
 	}
-	return r.getUserFromRow(row)
+
+	user := r.getUserFromRow(row)
+	r.addNostrProfile(user)
+
+	return user
 }
 
 // GetDisabledUsers will return back all the currently disabled users that are not API users.
@@ -399,9 +433,14 @@ func (r *SqlUserRepository) GetDisabledUsers() []*models.User {
 		log.Errorln(err)
 		return nil
 	}
-	defer rows.Close()
-
 	users := r.getUsersFromRows(rows)
+	rows.Close()
+
+	// Only one connection is open at a time, so look up profiles once the rows are closed.
+	for _, user := range users {
+		r.addNostrProfile(user)
+		r.addNostrMute(user)
+	}
 
 	sort.Slice(users, func(i, j int) bool {
 		return users[i].DisabledAt.Before(*users[j].DisabledAt)
@@ -432,9 +471,13 @@ func (r *SqlUserRepository) GetModeratorUsers() []*models.User {
 		log.Errorln(err)
 		return nil
 	}
-	defer rows.Close()
-
 	users := r.getUsersFromRows(rows)
+	rows.Close()
+
+	// Only one connection is open at a time, so look up profiles once the rows are closed.
+	for _, user := range users {
+		r.addNostrProfile(user)
+	}
 
 	return users
 }
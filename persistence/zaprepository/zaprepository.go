@@ -0,0 +1,128 @@
+package zaprepository
+
+import (
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+)
+
+type ZapRepository interface {
+	SaveZap(zap models.Zap) (bool, error)
+	GetZapsForStream(streamID string) ([]models.Zap, error)
+	GetZapTotalForStream(streamID string) (models.ZapTotal, error)
+	GetZapTotals() ([]models.ZapTotal, error)
+}
+
+type SqlZapRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance ZapRepository
+
+// Get will return the zap repository.
+func Get() ZapRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the ZapRepository.
+func New(datastore *data.Datastore) ZapRepository {
+	r := SqlZapRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// SaveZap will record a zap, returning false if it was already recorded.
+func (r *SqlZapRepository) SaveZap(zap models.Zap) (bool, error) {
+	result, err := r.datastore.DB.Exec(
+		"INSERT OR IGNORE INTO zaps(id, stream_id, sender, recipient, comment, amount_msat, paid_at) values(?, ?, ?, ?, ?, ?, ?)",
+		zap.ID, zap.StreamID, zap.Sender, zap.Recipient, zap.Comment, zap.AmountMsat, zap.Timestamp.Unix(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted > 0, nil
+}
+
+// GetZapsForStream will return every zap sent to a stream, newest first.
+func (r *SqlZapRepository) GetZapsForStream(streamID string) ([]models.Zap, error) {
+	rows, err := r.datastore.DB.Query(
+		"SELECT id, stream_id, sender, recipient, comment, amount_msat, paid_at FROM zaps WHERE stream_id = ? ORDER BY paid_at DESC",
+		streamID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	zaps := []models.Zap{}
+	for rows.Next() {
+		var zap models.Zap
+		var comment *string
+		var paidAt int64
+		if err := rows.Scan(&zap.ID, &zap.StreamID, &zap.Sender, &zap.Recipient, &comment, &zap.AmountMsat, &paidAt); err != nil {
+			return nil, err
+		}
+		if comment != nil {
+			zap.Comment = *comment
+		}
+		zap.Timestamp = time.Unix(paidAt, 0)
+		zaps = append(zaps, zap)
+	}
+
+	return zaps, rows.Err()
+}
+
+// GetZapTotalForStream will return the sum of the zaps sent to a stream.
+func (r *SqlZapRepository) GetZapTotalForStream(streamID string) (models.ZapTotal, error) {
+	totals, err := r.getTotals("WHERE stream_id = ?", streamID)
+	if err != nil || len(totals) == 0 {
+		return models.ZapTotal{StreamID: streamID}, err
+	}
+
+	return totals[0], nil
+}
+
+// GetZapTotals will return the sum of the zaps sent to each stream, most
+// recent stream first.
+func (r *SqlZapRepository) GetZapTotals() ([]models.ZapTotal, error) {
+	return r.getTotals("")
+}
+
+func (r *SqlZapRepository) getTotals(where string, args ...interface{}) ([]models.ZapTotal, error) {
+	rows, err := r.datastore.DB.Query(
+		"SELECT stream_id, COUNT(*), SUM(amount_msat), MIN(paid_at), MAX(paid_at) FROM zaps "+where+" GROUP BY stream_id ORDER BY MAX(paid_at) DESC",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := []models.ZapTotal{}
+	for rows.Next() {
+		var total models.ZapTotal
+		var first, last int64
+		if err := rows.Scan(&total.StreamID, &total.Count, &total.AmountMsat, &first, &last); err != nil {
+			return nil, err
+		}
+		total.FirstZapAt = time.Unix(first, 0)
+		total.LastZapAt = time.Unix(last, 0)
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
@@ -59,6 +59,13 @@ func GetOfflineSegment() []byte {
 	return getFileSystemStaticFileOrDefault("offline-v2.ts", offlineVideoSegment)
 }
 
+// GetReconnectingSegment will return the video segment looped while
+// waiting for the broadcaster to reconnect. Without a reconnecting.ts
+// the offline segment is used.
+func GetReconnectingSegment() []byte {
+	return getFileSystemStaticFileOrDefault("reconnecting.ts", offlineVideoSegment)
+}
+
 //go:embed img/logo.png
 var logo []byte
 
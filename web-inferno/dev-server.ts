@@ -131,6 +131,12 @@ function handleApi(url: URL): Response | null {
         ffmpegPath: "/usr/bin/ffmpeg",
         webServerPort: 8080,
         rtmpServerPort: 1935,
+        rtmps: { enabled: false, port: 1936, certificatePath: "", keyPath: "" },
+        restreamDestinations: [],
+        publishCallback: { enabled: false, url: '', cacheSeconds: 10, failOpen: false },
+        broadcasterTransport: "",
+        srtServerPort: 9000,
+        webRTCServerPort: 8189,
         s3: {},
         videoSettings: {
           videoQualityVariants: [
@@ -170,6 +176,7 @@ function handleApi(url: URL): Response | null {
         } : null,
         currentBroadcast: null,
         sessionPeakViewerCount: 0,
+        restreams: [],
       });
     }
     if (p === "/api/admin/hardwareinfo") {
@@ -179,6 +186,35 @@ function handleApi(url: URL): Response | null {
         disk: { total: 107374182400, used: 53687091200, percent: 50 },
       });
     }
+    if (p === "/api/admin/metrics/inbound") {
+      return Response.json({
+        current: mockStatus.online ? {
+          startedAt: new Date(Date.now() - 600000).toISOString(),
+          bitrateKbps: 4620,
+          keyframeIntervalSeconds: 2,
+          avDriftMilliseconds: 12,
+          timestampJumps: 0,
+          droppedFrames: 0,
+          latePackets: 0,
+        } : null,
+        bitrate: [], keyframeInterval: [], avDrift: [], timestampJumps: [], droppedFrames: [], latePackets: [],
+      });
+    }
+    if (p === "/api/admin/broadcasts") {
+      return Response.json({
+        total: 1,
+        results: [
+          {
+            id: "dev-broadcast",
+            streamKeyLabel: "Dev key",
+            streamKeyHint: "-key",
+            protocol: "rtmp",
+            remoteAddr: "127.0.0.1",
+            startedAt: new Date(Date.now() - 600000).toISOString(),
+          },
+        ],
+      });
+    }
     if (p === "/api/admin/logs") {
       return Response.json([
         { message: "Dev server started", level: "info", time: new Date().toISOString() },
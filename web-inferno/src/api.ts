@@ -1,4 +1,6 @@
-import type { ServerStatus, ClientConfig, ChatMessage, UserRegistrationResponse } from './types';
+import type { ServerStatus, ClientConfig, ChatMessage, UserRegistrationResponse, AdminAccess, AdminPubkey, AdminRole, AdminAuditEntry, Recording, RecordingUpdate, RTMPSConfig, RTMPTakeoverConfig, RestreamDestination, PublishCallbackConfig, StreamKey, BroadcastHistoryEntry, InboundStreamHealth } from './types';
+import { adminAuthHeader } from './nostr/nip98';
+import type { NostrEvent } from './nostr/event';
 
 const API_BASE = '/api';
 
@@ -24,31 +26,55 @@ async function authedGet<T>(path: string, token: string): Promise<T> {
   return res.json();
 }
 
+async function authedPost<T>(path: string, token: string, body?: unknown): Promise<T> {
+  const res = await fetch(`${API_BASE}${path}?accessToken=${token}`, {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: body ? JSON.stringify(body) : undefined,
+  });
+  if (!res.ok) throw new Error(`POST ${path} failed: ${res.status}`);
+  return res.json();
+}
+
+async function authedDelete<T>(path: string, token: string, body?: unknown): Promise<T> {
+  const res = await fetch(`${API_BASE}${path}?accessToken=${token}`, {
+    method: 'DELETE',
+    headers: { 'Content-Type': 'application/json' },
+    body: body ? JSON.stringify(body) : undefined,
+  });
+  if (!res.ok) throw new Error(`DELETE ${path} failed: ${res.status}`);
+  return res.json();
+}
+
 async function adminGet<T>(path: string, token: string): Promise<T> {
-  const res = await fetch(`${API_BASE}${path}`, {
-    headers: { Authorization: `Bearer ${token}` },
+  const url = `${API_BASE}${path}`;
+  const res = await fetch(url, {
+    headers: { Authorization: await adminAuthHeader(token, url, 'GET') },
   });
   if (!res.ok) throw new Error(`GET ${path} failed: ${res.status}`);
   return res.json();
 }
 
 async function adminPost<T>(path: string, token: string, body?: unknown): Promise<T> {
-  const res = await fetch(`${API_BASE}${path}`, {
+  const url = `${API_BASE}${path}`;
+  const payload = body ? JSON.stringify(body) : undefined;
+  const res = await fetch(url, {
     method: 'POST',
     headers: {
       'Content-Type': 'application/json',
-      Authorization: `Bearer ${token}`,
+      Authorization: await adminAuthHeader(token, url, 'POST', payload),
     },
-    body: body ? JSON.stringify(body) : undefined,
+    body: payload,
   });
   if (!res.ok) throw new Error(`POST ${path} failed: ${res.status}`);
   return res.json();
 }
 
 async function adminDelete<T>(path: string, token: string): Promise<T> {
-  const res = await fetch(`${API_BASE}${path}`, {
+  const url = `${API_BASE}${path}`;
+  const res = await fetch(url, {
     method: 'DELETE',
-    headers: { Authorization: `Bearer ${token}` },
+    headers: { Authorization: await adminAuthHeader(token, url, 'DELETE') },
   });
   if (!res.ok) throw new Error(`DELETE ${path} failed: ${res.status}`);
   return res.json();
@@ -65,6 +91,21 @@ export const api = {
   registerChatUser: (displayName: string) =>
     post<UserRegistrationResponse>('/chat/register', { displayName }),
 
+  // Nostr chat login (NIP-42 style challenge)
+  startNostrAuth: (token: string) => authedPost<{ challenge: string; relay?: string }>('/auth/nostr', token),
+  verifyNostrAuth: (token: string, event: NostrEvent) =>
+    authedPost<{ success: boolean; message: string }>('/auth/nostr/verify', token, { event }),
+  setNostrNIP05: (token: string, enabled: boolean, name?: string) =>
+    authedPost<{ identifier?: string; enabled: boolean; success?: boolean; message?: string }>(
+      '/auth/nostr/nip05', token, { enabled, name },
+    ),
+
+  // Go-live notifications
+  registerNotification: (token: string, channel: string, destination: string) =>
+    authedPost<{ success?: boolean; message?: string } | null>('/notifications/register', token, { channel, destination }),
+  unregisterNotification: (token: string, channel: string, destination: string) =>
+    authedDelete<{ success?: boolean; message?: string }>('/notifications/register', token, { channel, destination }),
+
   // Video
   getVideoVariants: () => get<{ name: string }[]>('/video/variants'),
 
@@ -76,6 +117,8 @@ export const api = {
     getLogs: (token: string) => adminGet<unknown>('/admin/logs', token),
     getViewers: (token: string) => adminGet<unknown>('/admin/viewers', token),
     getHardware: (token: string) => adminGet<unknown>('/admin/hardwareinfo', token),
+    getInboundStreamMetrics: (token: string) =>
+      adminGet<{ current: InboundStreamHealth | null }>('/admin/metrics/inbound', token),
     getChatMessages: (token: string) => adminGet<unknown>('/admin/chat/messages', token),
     getWebhooks: (token: string) => adminGet<unknown>('/admin/webhooks', token),
     getAccessTokens: (token: string) => adminGet<unknown>('/admin/accesstokens', token),
@@ -106,8 +149,10 @@ export const api = {
       adminPost<unknown>('/admin/config/hideviewercount', token, { value: hide }),
 
     // Stream keys (array of {key, comment})
-    setStreamKeys: (token: string, keys: { key: string; comment: string }[]) =>
+    setStreamKeys: (token: string, keys: StreamKey[]) =>
       adminPost<unknown>('/admin/config/streamkeys', token, { value: keys }),
+    getBroadcastHistory: (token: string, page = 0, limit = 20) =>
+      adminGet<{ total: number; results: BroadcastHistoryEntry[] }>(`/admin/broadcasts?offset=${page}&limit=${limit}`, token),
 
     // Instance details
     setServerName: (token: string, value: string) =>
@@ -148,6 +193,20 @@ export const api = {
       adminPost<unknown>('/admin/config/ffmpegpath', token, { value }),
     setRTMPPort: (token: string, value: number) =>
       adminPost<unknown>('/admin/config/rtmpserverport', token, { value }),
+    setSRTPort: (token: string, value: number) =>
+      adminPost<unknown>('/admin/config/srtserverport', token, { value }),
+    setWebRTCPort: (token: string, value: number) =>
+      adminPost<unknown>('/admin/config/webrtcport', token, { value }),
+    setReconnectGracePeriod: (token: string, value: number) =>
+      adminPost<unknown>('/admin/config/reconnectgraceperiod', token, { value }),
+    setRTMPSConfig: (token: string, value: RTMPSConfig) =>
+      adminPost<unknown>('/admin/config/rtmps', token, { value }),
+    setRTMPTakeover: (token: string, value: RTMPTakeoverConfig) =>
+      adminPost<unknown>('/admin/config/rtmptakeover', token, { value }),
+    setRestreamDestinations: (token: string, value: RestreamDestination[]) =>
+      adminPost<unknown>('/admin/config/restream', token, { value }),
+    setPublishCallback: (token: string, value: PublishCallbackConfig) =>
+      adminPost<unknown>('/admin/config/publishcallback', token, { value }),
     setWebServerPort: (token: string, value: number) =>
       adminPost<unknown>('/admin/config/webserverport', token, { value }),
     setVideoServingEndpoint: (token: string, value: string) =>
@@ -178,6 +237,8 @@ export const api = {
       adminPost<unknown>('/admin/config/notifications/discord', token, { value: config }),
     setBrowserPushConfig: (token: string, config: unknown) =>
       adminPost<unknown>('/admin/config/notifications/browser', token, { value: config }),
+    setNostrNotificationConfig: (token: string, config: unknown) =>
+      adminPost<unknown>('/admin/config/notifications/nostr', token, { value: config }),
 
     // External actions
     setExternalActions: (token: string, actions: unknown[]) =>
@@ -195,6 +256,29 @@ export const api = {
     deleteAccessToken: (token: string, tokenToDelete: string) =>
       adminPost<unknown>('/admin/accesstokens/delete', token, { token: tokenToDelete }),
 
+    // Admin pubkeys and audit log
+    getAdminAccess: (token: string) => adminGet<AdminAccess>('/admin/nostr/access', token),
+    getAdminPubkeys: (token: string) => adminGet<AdminPubkey[]>('/admin/nostr/admins', token),
+    setAdminPubkeyRole: (token: string, body: { pubkey: string; role: AdminRole; label?: string }) =>
+      adminPost<unknown>('/admin/nostr/admins/set', token, body),
+    removeAdminPubkey: (token: string, pubkey: string) =>
+      adminPost<unknown>('/admin/nostr/admins/remove', token, { pubkey }),
+    // The offset is the page number, as with the other paginated admin endpoints.
+    getAdminAuditLog: (token: string, page = 0, limit = 50) =>
+      adminGet<{ total: number; results: AdminAuditEntry[] }>(`/admin/audit?offset=${page}&limit=${limit}`, token),
+
+    // Stream recordings announced as NIP-71 video events
+    getRecordings: (token: string, page = 0, limit = 20) =>
+      adminGet<{ total: number; results: Recording[] }>(`/admin/nostr/recordings?offset=${page}&limit=${limit}`, token),
+    updateRecording: (token: string, update: RecordingUpdate) =>
+      adminPost<Recording>('/admin/nostr/recordings/update', token, update),
+    deleteRecordingAnnouncement: (token: string, id: string) =>
+      adminPost<unknown>('/admin/nostr/recordings/delete', token, { id }),
+    setNostrLegacyVideoEvents: (token: string, value: boolean) =>
+      adminPost<unknown>('/admin/config/nostr/legacyvideoevents', token, { value }),
+    setNostrRelayEnabled: (token: string, value: boolean) =>
+      adminPost<unknown>('/admin/config/nostr/relayenabled', token, { value }),
+
     // Directory
     setDirectoryEnabled: (token: string, value: boolean) =>
       adminPost<unknown>('/admin/config/directoryenabled', token, { value }),
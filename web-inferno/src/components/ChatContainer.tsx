@@ -128,6 +128,21 @@ export class ChatContainer extends Component<{}, ChatContainerState> {
           </div>
         )}
 
+        {/* Offer the name from the linked Nostr profile */}
+        {currentUser?.suggestedDisplayName && (
+          <div class="flex items-center gap-2 px-4 py-2 border-t border-border/50 bg-primary/5">
+            <span class="flex-1 min-w-0 truncate text-[11px] text-muted-foreground">
+              Use <span class="font-semibold text-foreground">{currentUser.suggestedDisplayName}</span> from your Nostr profile?
+            </span>
+            <Button size="sm" variant="ghost" className="h-6 px-2 text-[11px]" onClick={() => store.dismissSuggestedDisplayName()}>
+              No
+            </Button>
+            <Button size="sm" className="h-6 px-2 text-[11px]" onClick={() => store.acceptSuggestedDisplayName()}>
+              Use name
+            </Button>
+          </div>
+        )}
+
         {/* Input area */}
         {currentUser ? (
           <ChatInput onSend={this.handleSend} />
@@ -92,15 +92,48 @@ export class ChatMessageItem extends Component<Props> {
       );
     }
 
+    // Nostr zap
+    if (type === MessageType.ZAP) {
+      return (
+        <div class="px-3 py-1.5 bg-warning/10 mx-2 my-1 rounded-md">
+          <div class="flex items-center gap-1.5">
+            <svg class="w-3 h-3 text-warning shrink-0" fill="currentColor" viewBox="0 0 24 24">
+              <path d="M13 2L3 14h7l-1 8 10-12h-7l1-8z" />
+            </svg>
+            <Tooltip content={message.npub || 'Zapped from Nostr'} side="top">
+              <span class="text-[11px] font-semibold text-warning">{message.title}</span>
+            </Tooltip>
+            <span class="text-[11px] text-warning/80">zapped {(message.amount || 0).toLocaleString()} sats</span>
+          </div>
+          {message.body && (
+            <p class="text-[13px] text-foreground/90 leading-relaxed break-words mt-0.5">{message.body}</p>
+          )}
+        </div>
+      );
+    }
+
     // Regular chat message
     const userColor = getUserColor(message.user?.displayColor || 0);
     const isMod = message.user?.isModerator;
     const isBot = message.user?.isBot;
     const isAuth = message.user?.authenticated;
+    const isNostr = type === MessageType.NOSTR_CHAT;
+    const avatar = message.user?.avatar;
+    const nip05 = message.user?.nip05Verified ? message.user?.nip05 : undefined;
 
     return (
       <div class="group px-4 py-2 hover:bg-accent/50 transition-colors duration-150 animate-msg-in">
         <div class="flex items-baseline gap-1.5 flex-wrap">
+          {avatar && (
+            <img
+              src={avatar}
+              alt=""
+              loading="lazy"
+              referrerpolicy="no-referrer"
+              class="size-4 rounded-full object-cover self-center shrink-0"
+              onError={(e: Event) => { (e.target as HTMLImageElement).style.display = 'none'; }}
+            />
+          )}
           <span
             class="text-[12px] font-bold leading-tight"
             style={{ color: userColor }}
@@ -115,6 +148,11 @@ export class ChatMessageItem extends Component<Props> {
                 <Badge variant="secondary" className="mr-1 align-middle text-[8px] py-0 px-1.5 uppercase tracking-wider">Bot</Badge>
               </Tooltip>
             )}
+            {isNostr && (
+              <Tooltip content={message.user?.npub || 'Sent from Nostr'} side="top">
+                <Badge variant="outline" className="mr-1 align-middle text-[8px] py-0 px-1.5 uppercase tracking-wider">Nostr</Badge>
+              </Tooltip>
+            )}
             {isAuth && (
               <Tooltip content="Verified" side="top">
                 <span class="inline-flex items-center justify-center size-3.5 text-success mr-0.5 align-middle">
@@ -122,6 +160,13 @@ export class ChatMessageItem extends Component<Props> {
                 </span>
               </Tooltip>
             )}
+            {nip05 && (
+              <Tooltip content={`Verified as ${nip05}`} side="top">
+                <span class="inline-flex items-center justify-center size-3.5 text-primary mr-0.5 align-middle">
+                  <svg class="size-3" fill="currentColor" viewBox="0 0 20 20"><path fill-rule="evenodd" d="M6.267 3.455a3.066 3.066 0 001.745-.723 3.066 3.066 0 013.976 0 3.066 3.066 0 001.745.723 3.066 3.066 0 012.812 2.812c.051.643.304 1.254.723 1.745a3.066 3.066 0 010 3.976 3.066 3.066 0 00-.723 1.745 3.066 3.066 0 01-2.812 2.812 3.066 3.066 0 00-1.745.723 3.066 3.066 0 01-3.976 0 3.066 3.066 0 00-1.745-.723 3.066 3.066 0 01-2.812-2.812 3.066 3.066 0 00-.723-1.745 3.066 3.066 0 010-3.976 3.066 3.066 0 00.723-1.745 3.066 3.066 0 012.812-2.812zm7.44 5.252a1 1 0 00-1.414-1.414L9 10.586 7.707 9.293a1 1 0 00-1.414 1.414l2 2a1 1 0 001.414 0l4-4z" clip-rule="evenodd" /></svg>
+                </span>
+              </Tooltip>
+            )}
             {message.user?.displayName}
           </span>
           <span class="text-[10px] text-muted-foreground/40 leading-tight tabular-nums">{time}</span>
@@ -19,6 +19,9 @@ interface HeaderState {
   nostrLoading: boolean;
   userMenuOpen: boolean;
   profileVersion: number;
+  nip05Identifier: string | null;
+  nip05Error: string | null;
+  liveDMs: boolean;
 }
 
 export class Header extends Component<{}, HeaderState> {
@@ -36,6 +39,9 @@ export class Header extends Component<{}, HeaderState> {
     nostrLoading: getAuthState().isLoading,
     userMenuOpen: false,
     profileVersion: 0,
+    nip05Identifier: null,
+    nip05Error: null,
+    liveDMs: !!getAuthState().pubkey && localStorage.getItem(`liveDMs:${getAuthState().pubkey}`) === 'true',
   };
 
   private handleOutsideClick = (e: MouseEvent) => {
@@ -59,6 +65,7 @@ export class Header extends Component<{}, HeaderState> {
       this.setState({
         nostrPubkey: auth.pubkey,
         nostrLoading: auth.isLoading,
+        liveDMs: !!auth.pubkey && localStorage.getItem(`liveDMs:${auth.pubkey}`) === 'true',
       });
       if (auth.pubkey) fetchProfile(auth.pubkey);
     });
@@ -85,7 +92,35 @@ export class Header extends Component<{}, HeaderState> {
     document.removeEventListener('mousedown', this.handleOutsideClick);
   }
 
-  private handleLogin = () => { login(); };
+  private handleLogin = async () => {
+    await login();
+    const { pubkey } = getAuthState();
+    if (pubkey && store.getState().accessToken) {
+      store.linkNostrIdentity(pubkey).catch((err) => console.error('[Header] Nostr chat login failed:', err));
+    }
+  };
+
+  private handleClaimNIP05 = async () => {
+    try {
+      const identifier = await store.setNIP05(true);
+      this.setState({ nip05Identifier: identifier, nip05Error: null });
+    } catch (err) {
+      this.setState({ nip05Error: err instanceof Error ? err.message : String(err) });
+    }
+  };
+
+  private handleToggleLiveDMs = async () => {
+    const { nostrPubkey, liveDMs } = this.state;
+    if (!nostrPubkey) return;
+
+    try {
+      await store.setNostrNotifications(nostrPubkey, !liveDMs);
+      localStorage.setItem(`liveDMs:${nostrPubkey}`, String(!liveDMs));
+      this.setState({ liveDMs: !liveDMs });
+    } catch (err) {
+      console.error('[Header] Unable to update go-live DMs:', err);
+    }
+  };
 
   private handleLogout = () => {
     logout();
@@ -210,6 +245,45 @@ export class Header extends Component<{}, HeaderState> {
                       </p>
                     </div>
                     <div class="border-t border-border" />
+                    {/* NIP-05 identifier on this server */}
+                    <div class="py-1">
+                      {this.state.nip05Identifier ? (
+                        <p class="px-4 py-2 text-xs text-muted-foreground truncate">
+                          Verified as <span class="font-mono text-foreground">{this.state.nip05Identifier}</span>
+                        </p>
+                      ) : (
+                        <button
+                          onClick={this.handleClaimNIP05}
+                          class="flex items-center gap-2.5 px-4 py-2 w-full text-sm text-muted-foreground hover:text-foreground hover:bg-accent transition-colors cursor-pointer"
+                        >
+                          <svg class="size-4" fill="none" viewBox="0 0 24 24" stroke="currentColor" stroke-width="1.5">
+                            <path stroke-linecap="round" stroke-linejoin="round" d="M9 12.75L11.25 15 15 9.75M21 12a9 9 0 11-18 0 9 9 0 0118 0z" />
+                          </svg>
+                          Get a NIP-05 identifier
+                        </button>
+                      )}
+                      {this.state.nip05Error && (
+                        <p class="px-4 pb-2 text-[10px] text-destructive">{this.state.nip05Error}</p>
+                      )}
+                    </div>
+                    {config?.notifications?.nostr?.enabled && (
+                      <>
+                        <div class="border-t border-border" />
+                        {/* Go-live direct messages */}
+                        <div class="py-1">
+                          <button
+                            onClick={this.handleToggleLiveDMs}
+                            class="flex items-center gap-2.5 px-4 py-2 w-full text-sm text-muted-foreground hover:text-foreground hover:bg-accent transition-colors cursor-pointer"
+                          >
+                            <svg class="size-4" fill="none" viewBox="0 0 24 24" stroke="currentColor" stroke-width="1.5">
+                              <path stroke-linecap="round" stroke-linejoin="round" d="M14.857 17.082a23.848 23.848 0 005.454-1.31A8.967 8.967 0 0118 9.75V9A6 6 0 006 9v.75a8.967 8.967 0 01-2.312 6.022c1.733.64 3.56 1.085 5.455 1.31m5.714 0a24.255 24.255 0 01-5.714 0m5.714 0a3 3 0 11-5.714 0" />
+                            </svg>
+                            {this.state.liveDMs ? 'Stop go-live DMs' : 'DM me when live'}
+                          </button>
+                        </div>
+                      </>
+                    )}
+                    <div class="border-t border-border" />
                     {/* Admin link */}
                     <div class="py-1">
                       <a
@@ -1,7 +1,7 @@
 import { Component } from 'inferno';
 import { createElement } from 'inferno-create-element';
 import { Button, Input, Label, Card, CardHeader, CardTitle, CardDescription, CardContent, CardFooter, Alert, AlertDescription, Spinner } from 'blazecn';
-import { AdminShell, AdminTab } from './AdminShell';
+import { AdminShell, AdminTab, canAccessTab } from './AdminShell';
 import { OverviewTab } from './OverviewTab';
 import { GeneralConfigTab } from './GeneralConfigTab';
 import { VideoConfigTab } from './VideoConfigTab';
@@ -12,11 +12,16 @@ import { AccessTokensTab } from './AccessTokensTab';
 import { RelayManagerTab } from './RelayManagerTab';
 import { NostrSettingsTab } from './NostrSettingsTab';
 import { NostrLiveTab } from './NostrLiveTab';
+import { RecordingsTab } from './RecordingsTab';
+import { AdminsTab } from './AdminsTab';
 import { getAuthState, subscribeAuth, login, restoreSession } from '../../nostr/stores/auth';
+import { createNip98Header } from '../../nostr/nip98';
+import type { AdminRole } from '../../types';
 
 interface AdminPageState {
   activeTab: AdminTab;
   token: string;
+  role: AdminRole;
   authenticated: boolean;
   authChecking: boolean;
   authError: string | null;
@@ -36,6 +41,7 @@ export class AdminPage extends Component<{}, AdminPageState> {
       return 'overview';
     })()) as AdminTab,
     token: '',
+    role: 'owner',
     authenticated: false,
     authChecking: true,
     authError: null,
@@ -55,20 +61,24 @@ export class AdminPage extends Component<{}, AdminPageState> {
   private async onAuthChange() {
     const auth = getAuthState();
     if (auth.pubkey) {
-      await this.verifyBearerToken(auth.pubkey);
+      await this.verifyNostrAuth(auth.pubkey);
     } else if (!auth.isLoading) {
       this.setState({ authChecking: false });
     }
   }
 
-  private async verifyBearerToken(pubkey: string) {
+  private async verifyNostrAuth(pubkey: string) {
     this.setState({ authChecking: true, authError: null });
     try {
-      const res = await fetch('/api/admin/serverconfig', {
-        headers: { Authorization: `Bearer ${pubkey}` },
+      const res = await fetch('/api/admin/nostr/access', {
+        headers: { Authorization: await createNip98Header(pubkey, '/api/admin/nostr/access', 'GET') },
       });
       if (!res.ok) throw new Error('Not authorized');
-      this.setState({ authenticated: true, token: pubkey, authChecking: false, authError: null });
+      const { role } = await res.json() as { role: AdminRole };
+      // Integrations only use the API, there is nothing for them here.
+      if (role === 'integration') throw new Error('Not authorized');
+      const activeTab = canAccessTab(role, this.state.activeTab) ? this.state.activeTab : 'overview';
+      this.setState({ authenticated: true, token: pubkey, role, activeTab, authChecking: false, authError: null });
     } catch {
       this.setState({ authenticated: false, authChecking: false, authError: 'Your Nostr identity is not authorized as admin.' });
     }
@@ -84,7 +94,7 @@ export class AdminPage extends Component<{}, AdminPageState> {
         headers: { Authorization: 'Basic ' + btoa('admin:' + key) },
       });
       if (!res.ok) throw new Error('Invalid stream key');
-      this.setState({ authenticated: true, token: key, authChecking: false, authError: null });
+      this.setState({ authenticated: true, token: key, role: 'owner', authChecking: false, authError: null });
     } catch {
       this.setState({ authenticated: false, authChecking: false, authError: 'Invalid stream key.' });
     }
@@ -95,19 +105,12 @@ export class AdminPage extends Component<{}, AdminPageState> {
   };
 
   private handleTabChange = (tab: AdminTab) => {
+    if (!canAccessTab(this.state.role, tab)) return;
     this.setState({ activeTab: tab });
   };
 
-  private getAuthHeaders(): Record<string, string> {
-    const { token } = this.state;
-    if (token.length === 64) {
-      return { Authorization: `Bearer ${token}` };
-    }
-    return { Authorization: 'Basic ' + btoa('admin:' + token) };
-  }
-
   private renderTab() {
-    const { activeTab, token } = this.state;
+    const { activeTab, token, role } = this.state;
 
     switch (activeTab) {
       case 'overview':
@@ -125,11 +128,15 @@ export class AdminPage extends Component<{}, AdminPageState> {
       case 'tokens':
         return <AccessTokensTab token={token} />;
       case 'nostr':
-        return <NostrSettingsTab />;
+        return <NostrSettingsTab token={token} />;
       case 'relays':
         return <RelayManagerTab />;
       case 'nostr-live':
         return <NostrLiveTab />;
+      case 'recordings':
+        return <RecordingsTab token={token} />;
+      case 'admins':
+        return <AdminsTab token={token} role={role} />;
       default:
         return null;
     }
@@ -221,7 +228,7 @@ export class AdminPage extends Component<{}, AdminPageState> {
     }
 
     return (
-      <AdminShell activeTab={this.state.activeTab} onTabChange={this.handleTabChange}>
+      <AdminShell activeTab={this.state.activeTab} role={this.state.role} onTabChange={this.handleTabChange}>
         {this.renderTab()}
       </AdminShell>
     );
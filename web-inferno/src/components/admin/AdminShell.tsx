@@ -4,11 +4,13 @@ import { Badge, Separator, Toaster } from 'blazecn';
 import { cn } from 'blazecn';
 import { store } from '../../store';
 import { ThemeSelector } from '../ThemeSelector';
+import type { AdminRole } from '../../types';
 
-export type AdminTab = 'overview' | 'general' | 'video' | 'chat' | 'logs' | 'viewers' | 'tokens' | 'nostr' | 'relays' | 'nostr-live';
+export type AdminTab = 'overview' | 'general' | 'video' | 'chat' | 'logs' | 'viewers' | 'tokens' | 'nostr' | 'relays' | 'nostr-live' | 'recordings' | 'admins';
 
 interface AdminShellProps {
   activeTab: AdminTab;
+  role: AdminRole;
   onTabChange: (tab: AdminTab) => void;
   children: any;
 }
@@ -84,6 +86,14 @@ type MenuSection = {
   items: { id: AdminTab; label: string; icon: () => any }[];
 };
 
+// Tabs a moderator can use. Everything else needs the admin role.
+const MODERATOR_TABS: AdminTab[] = ['overview', 'viewers'];
+
+export function canAccessTab(role: AdminRole, tab: AdminTab): boolean {
+  if (role === 'owner' || role === 'admin') return true;
+  return role === 'moderator' && MODERATOR_TABS.includes(tab);
+}
+
 const MENU: MenuSection[] = [
   {
     heading: '',
@@ -106,6 +116,7 @@ const MENU: MenuSection[] = [
       { id: 'nostr', label: 'Identity', icon: IconZap },
       { id: 'relays', label: 'Relays', icon: IconRadio },
       { id: 'nostr-live', label: 'Nostr Live', icon: IconLive },
+      { id: 'recordings', label: 'Recordings', icon: IconVideo },
     ],
   },
   {
@@ -113,6 +124,7 @@ const MENU: MenuSection[] = [
     items: [
       { id: 'logs', label: 'Logs', icon: IconFileText },
       { id: 'tokens', label: 'Access Tokens', icon: IconKey },
+      { id: 'admins', label: 'Admins', icon: IconUsers },
     ],
   },
 ];
@@ -145,7 +157,10 @@ export class AdminShell extends Component<AdminShellProps, AdminShellState> {
   }
 
   render() {
-    const { activeTab, onTabChange, children } = this.props;
+    const { activeTab, role, onTabChange, children } = this.props;
+    const menu = MENU
+      .map((group) => ({ ...group, items: group.items.filter((item) => canAccessTab(role, item.id)) }))
+      .filter((group) => group.items.length > 0);
     const { online, name, viewerCount } = this.state;
 
     return (
@@ -206,7 +221,7 @@ export class AdminShell extends Component<AdminShellProps, AdminShellState> {
           {/* ── Sidebar — matches mycelium.social sidebar pattern ── */}
           <aside class="hidden md:block w-52 shrink-0 border-r border-border">
             <div class="sticky top-[57px] py-4 px-3 space-y-4 h-[calc(100vh-57px)] overflow-y-auto">
-              {MENU.map((group) => (
+              {menu.map((group) => (
                 <div key={group.heading}>
                   {group.heading && (
                     <p class="px-3 mb-1.5 text-[11px] font-semibold tracking-wider uppercase text-muted-foreground/50">
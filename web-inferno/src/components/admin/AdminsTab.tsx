@@ -0,0 +1,288 @@
+import { Component } from 'inferno';
+import { createElement } from 'inferno-create-element';
+import {
+  Button, Input, Label, Card, CardHeader, CardTitle, CardDescription, CardContent,
+  Alert, AlertDescription, Badge, Skeleton, toast,
+} from 'blazecn';
+import { api } from '../../api';
+import { formatRelativeTime } from '../../utils';
+import { shortenHex, npubEncode } from '../../nostr/utils';
+import type { AdminAuditEntry, AdminPubkey, AdminRole } from '../../types';
+
+const ROLES: { id: AdminRole; label: string; description: string }[] = [
+  { id: 'owner', label: 'Owner', description: 'Everything, including admins, stream keys and the server identity' },
+  { id: 'admin', label: 'Admin', description: 'All configuration except admins and stream keys' },
+  { id: 'moderator', label: 'Moderator', description: 'Chat moderation and viewer details' },
+  { id: 'integration', label: 'Integration', description: 'Chat messages through the integrations API' },
+];
+
+const AUDIT_PAGE_SIZE = 25;
+
+function displayPubkey(pubkey: string): string {
+  try {
+    return shortenHex(npubEncode(pubkey));
+  } catch {
+    return shortenHex(pubkey);
+  }
+}
+
+interface AdminsTabProps {
+  token: string;
+  role: AdminRole;
+}
+
+interface AdminsTabState {
+  loading: boolean;
+  error: string | null;
+  admins: AdminPubkey[];
+  newPubkey: string;
+  newRole: AdminRole;
+  newLabel: string;
+  saving: boolean;
+  confirmRemove: string | null;
+  audit: AdminAuditEntry[];
+  auditTotal: number;
+  auditPage: number;
+}
+
+export class AdminsTab extends Component<AdminsTabProps, AdminsTabState> {
+  state: AdminsTabState = {
+    loading: true,
+    error: null,
+    admins: [],
+    newPubkey: '',
+    newRole: 'moderator',
+    newLabel: '',
+    saving: false,
+    confirmRemove: null,
+    audit: [],
+    auditTotal: 0,
+    auditPage: 0,
+  };
+
+  componentDidMount() {
+    this.loadAdmins();
+    this.loadAudit(0);
+  }
+
+  private async loadAdmins() {
+    try {
+      const admins = await api.admin.getAdminPubkeys(this.props.token);
+      this.setState({ loading: false, admins: Array.isArray(admins) ? admins : [] });
+    } catch (err) {
+      this.setState({
+        loading: false,
+        error: err instanceof Error ? err.message : 'Failed to load admins',
+      });
+    }
+  }
+
+  private async loadAudit(page: number) {
+    try {
+      const log = await api.admin.getAdminAuditLog(this.props.token, page, AUDIT_PAGE_SIZE);
+      this.setState({ audit: log.results || [], auditTotal: log.total || 0, auditPage: page });
+    } catch {
+      toast.error('Failed to load the audit log');
+    }
+  }
+
+  private handleSave = async (pubkey: string, role: AdminRole, label: string) => {
+    if (!pubkey.trim()) return;
+    this.setState({ saving: true });
+    try {
+      await api.admin.setAdminPubkeyRole(this.props.token, { pubkey: pubkey.trim(), role, label: label.trim() });
+      this.setState({ saving: false, newPubkey: '', newLabel: '' });
+      this.loadAdmins();
+      this.loadAudit(0);
+      toast.success('Admin saved');
+    } catch {
+      this.setState({ saving: false });
+      toast.error('Failed to save admin');
+    }
+  };
+
+  private handleRemove = async (pubkey: string) => {
+    try {
+      await api.admin.removeAdminPubkey(this.props.token, pubkey);
+      this.setState({ confirmRemove: null });
+      this.loadAdmins();
+      this.loadAudit(0);
+      toast.success('Admin removed');
+    } catch {
+      toast.error('Failed to remove admin');
+    }
+  };
+
+  private renderRoleSelect(value: AdminRole, onChange: (role: AdminRole) => void, disabled: boolean) {
+    return (
+      <select
+        class="h-9 rounded-md border border-input bg-background px-2 text-sm"
+        value={value}
+        disabled={disabled}
+        onChange={(e: Event) => onChange((e.target as HTMLSelectElement).value as AdminRole)}
+      >
+        {ROLES.map((role) => (
+          <option key={role.id} value={role.id}>{role.label}</option>
+        ))}
+      </select>
+    );
+  }
+
+  private renderAdmins() {
+    const { admins, confirmRemove } = this.state;
+    const isOwner = this.props.role === 'owner';
+
+    return (
+      <div class="space-y-3">
+        {admins.map((admin) => (
+          <Card key={admin.pubkey}>
+            <CardContent className="p-4">
+              <div class="flex items-center justify-between gap-3">
+                <div class="min-w-0 flex-1">
+                  <div class="flex items-center gap-2 mb-1">
+                    <p class="text-sm font-medium text-foreground">{admin.label || displayPubkey(admin.pubkey)}</p>
+                    {admin.primary && <Badge variant="secondary" className="text-[9px] px-1.5 py-0">Primary</Badge>}
+                  </div>
+                  <code class="text-[11px] font-mono text-muted-foreground">{displayPubkey(admin.pubkey)}</code>
+                  {admin.addedBy && (
+                    <p class="text-[10px] text-muted-foreground/50 mt-1">
+                      Added by {displayPubkey(admin.addedBy)} {formatRelativeTime(admin.createdAt)}
+                    </p>
+                  )}
+                </div>
+                <div class="flex items-center gap-2 shrink-0">
+                  {this.renderRoleSelect(
+                    admin.role,
+                    (role) => this.handleSave(admin.pubkey, role, admin.label || ''),
+                    !isOwner || !!admin.primary,
+                  )}
+                  {isOwner && !admin.primary && (confirmRemove === admin.pubkey ? (
+                    <div class="flex items-center gap-1.5">
+                      <Button variant="destructive" size="xs" onClick={() => this.handleRemove(admin.pubkey)}>Confirm</Button>
+                      <Button variant="ghost" size="xs" onClick={() => this.setState({ confirmRemove: null })}>Cancel</Button>
+                    </div>
+                  ) : (
+                    <Button variant="ghost" size="xs" onClick={() => this.setState({ confirmRemove: admin.pubkey })}>Remove</Button>
+                  ))}
+                </div>
+              </div>
+            </CardContent>
+          </Card>
+        ))}
+      </div>
+    );
+  }
+
+  private renderAddForm() {
+    const { newPubkey, newRole, newLabel, saving } = this.state;
+
+    return (
+      <Card className="mb-4">
+        <CardHeader>
+          <CardTitle>Add an admin</CardTitle>
+          <CardDescription>Nostr pubkeys sign in to the admin with NIP-98 and get the access of their role.</CardDescription>
+        </CardHeader>
+        <CardContent>
+          <div class="space-y-3">
+            <div class="space-y-1.5">
+              <Label>Pubkey</Label>
+              <Input
+                type="text"
+                placeholder="npub1… or hex"
+                value={newPubkey}
+                onInput={(e: Event) => this.setState({ newPubkey: (e.target as HTMLInputElement).value })}
+              />
+            </div>
+            <div class="space-y-1.5">
+              <Label>Label</Label>
+              <Input
+                type="text"
+                placeholder="e.g. Chat moderator, Stream bot"
+                value={newLabel}
+                onInput={(e: Event) => this.setState({ newLabel: (e.target as HTMLInputElement).value })}
+              />
+            </div>
+            <div class="space-y-1.5">
+              <Label>Role</Label>
+              <div>{this.renderRoleSelect(newRole, (role) => this.setState({ newRole: role }), false)}</div>
+              <p class="text-xs text-muted-foreground">{ROLES.find((r) => r.id === newRole)?.description}</p>
+            </div>
+            <Button onClick={() => this.handleSave(newPubkey, newRole, newLabel)} disabled={!newPubkey.trim() || saving}>
+              {saving ? 'Saving...' : 'Add Admin'}
+            </Button>
+          </div>
+        </CardContent>
+      </Card>
+    );
+  }
+
+  private renderAudit() {
+    const { audit, auditTotal, auditPage } = this.state;
+    const pages = Math.ceil(auditTotal / AUDIT_PAGE_SIZE);
+
+    return (
+      <Card>
+        <CardHeader>
+          <CardTitle>Audit log</CardTitle>
+          <CardDescription>Changes made through the admin API and who made them.</CardDescription>
+        </CardHeader>
+        <CardContent>
+          {audit.length === 0 ? (
+            <p class="text-sm text-muted-foreground">No changes recorded yet.</p>
+          ) : (
+            <div class="space-y-1.5">
+              {audit.map((entry) => (
+                <div key={entry.id} class="flex items-center gap-3 text-xs">
+                  <span class="text-muted-foreground/60 w-24 shrink-0">{formatRelativeTime(entry.timestamp)}</span>
+                  <span class="w-32 shrink-0 truncate">{entry.pubkey ? displayPubkey(entry.pubkey) : 'Admin password'}</span>
+                  <Badge variant="outline" className="text-[9px] px-1.5 py-0">{entry.role}</Badge>
+                  <code class="font-mono text-muted-foreground flex-1 truncate">{entry.method} {entry.path}</code>
+                  <span class={entry.status >= 400 ? 'text-destructive' : 'text-muted-foreground/60'}>{entry.status}</span>
+                </div>
+              ))}
+            </div>
+          )}
+          {pages > 1 && (
+            <div class="flex items-center justify-end gap-2 mt-4">
+              <Button variant="ghost" size="xs" disabled={auditPage === 0} onClick={() => this.loadAudit(auditPage - 1)}>Newer</Button>
+              <span class="text-xs text-muted-foreground">{auditPage + 1} / {pages}</span>
+              <Button variant="ghost" size="xs" disabled={auditPage + 1 >= pages} onClick={() => this.loadAudit(auditPage + 1)}>Older</Button>
+            </div>
+          )}
+        </CardContent>
+      </Card>
+    );
+  }
+
+  render() {
+    const { loading, error } = this.state;
+
+    if (loading) {
+      return (
+        <div class="space-y-4">
+          <Skeleton className="h-8 w-48" />
+          <Skeleton className="h-32 rounded-xl" />
+        </div>
+      );
+    }
+
+    return (
+      <div class="max-w-3xl space-y-6">
+        <div>
+          <h1 class="text-2xl font-bold text-foreground tracking-tight">Admins</h1>
+          <p class="text-sm text-muted-foreground mt-1">Nostr pubkeys with access to the admin and what they changed.</p>
+        </div>
+
+        {error && (
+          <Alert variant="destructive">
+            <AlertDescription>{error}</AlertDescription>
+          </Alert>
+        )}
+
+        {this.props.role === 'owner' && this.renderAddForm()}
+        {this.renderAdmins()}
+        {this.renderAudit()}
+      </div>
+    );
+  }
+}
@@ -1,6 +1,6 @@
 import { Component } from 'inferno';
 import { createElement } from 'inferno-create-element';
-import { Button, Badge, Avatar, AvatarImage, AvatarFallback, Switch, Input, Card, CardHeader, CardTitle, CardDescription, CardContent, Separator, Alert, AlertDescription, Skeleton, Spinner } from 'blazecn';
+import { Button, Badge, Avatar, AvatarImage, AvatarFallback, Switch, Input, Card, CardHeader, CardTitle, CardDescription, CardContent, Separator, Alert, AlertDescription, Skeleton, Spinner, toast } from 'blazecn';
 import { cn } from 'blazecn';
 import { getAuthState, subscribeAuth, login, logout } from '../../nostr/stores/auth';
 import { getBootstrapState, subscribeBootstrap, type BootstrapProfile, type BootstrapPhase, type RelayListEntry } from '../../nostr/stores/bootstrap';
@@ -16,6 +16,7 @@ import {
   type RelayManagerState,
 } from '../../nostr/stores/relaymanager';
 import { shortenHex, npubEncode } from '../../nostr/utils';
+import { api } from '../../api';
 
 interface NostrSettingsTabState {
   pubkey: string | null;
@@ -35,6 +36,7 @@ interface NostrSettingsTabState {
   selectedOutbox: Set<string>;
   newCustomRelayUrl: string;
   newProfileName: string;
+  builtinRelayEnabled: boolean;
 }
 
 const OUTBOX_STORAGE_KEY = 'oni_outbox_relays_selected';
@@ -51,7 +53,7 @@ function persistSelectedOutbox(urls: Set<string>) {
   localStorage.setItem(OUTBOX_STORAGE_KEY, JSON.stringify([...urls]));
 }
 
-export class NostrSettingsTab extends Component<{}, NostrSettingsTabState> {
+export class NostrSettingsTab extends Component<{ token: string }, NostrSettingsTabState> {
   private unsubAuth: (() => void) | null = null;
   private unsubBootstrap: (() => void) | null = null;
   private unsubLive: (() => void) | null = null;
@@ -75,9 +77,12 @@ export class NostrSettingsTab extends Component<{}, NostrSettingsTabState> {
     selectedOutbox: loadSelectedOutbox(),
     newCustomRelayUrl: '',
     newProfileName: '',
+    builtinRelayEnabled: false,
   };
 
   componentDidMount() {
+    this.loadServerConfig();
+
     this.unsubAuth = subscribeAuth(() => {
       const auth = getAuthState();
       this.setState({
@@ -150,6 +155,24 @@ export class NostrSettingsTab extends Component<{}, NostrSettingsTabState> {
     this.setState({ newProfileName: '' });
   };
 
+  private async loadServerConfig() {
+    try {
+      const config = await api.admin.getConfig(this.props.token) as any;
+      this.setState({ builtinRelayEnabled: !!config?.nostr?.relayEnabled });
+    } catch { /* the rest of the tab still works */ }
+  }
+
+  private toggleBuiltinRelay = async (value: boolean) => {
+    this.setState({ builtinRelayEnabled: value });
+    try {
+      await api.admin.setNostrRelayEnabled(this.props.token, value);
+      toast.success('Saved');
+    } catch {
+      this.setState({ builtinRelayEnabled: !value });
+      toast.error('Failed to save');
+    }
+  };
+
   render() {
     const {
       pubkey, authLoading, authError, profile, bootstrapPhase,
@@ -237,6 +260,24 @@ export class NostrSettingsTab extends Component<{}, NostrSettingsTabState> {
           </CardContent>
         </Card>
 
+        {/* ── Built-in relay ── */}
+        <Card>
+          <CardHeader>
+            <div class="flex items-center justify-between">
+              <div>
+                <CardTitle>Built-in Relay</CardTitle>
+                <CardDescription>Serve the stream's live activity, chat, zaps and reactions from a relay at <code>/nostr</code> on this server.</CardDescription>
+              </div>
+              <Switch checked={this.state.builtinRelayEnabled} onChange={this.toggleBuiltinRelay} />
+            </div>
+          </CardHeader>
+          <CardContent>
+            <p class="text-xs text-muted-foreground">
+              Anyone can read from it. Publishing requires NIP-42 authentication and banned or muted pubkeys are refused. Events from others are kept for a week.
+            </p>
+          </CardContent>
+        </Card>
+
         {/* ── NIP-53 Live Events Toggle ── */}
         <Card>
           <CardHeader>
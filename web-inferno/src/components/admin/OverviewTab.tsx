@@ -5,6 +5,7 @@ import { cn } from 'blazecn';
 import { Chart, LineController, LineElement, PointElement, LinearScale, CategoryScale, Filler, DoughnutController, ArcElement, Tooltip as ChartTooltip, Legend } from 'chart.js';
 import { api } from '../../api';
 import { formatRelativeTime } from '../../utils';
+import type { RestreamStatus, InboundStreamHealth } from '../../types';
 
 Chart.register(LineController, LineElement, PointElement, LinearScale, CategoryScale, Filler, DoughnutController, ArcElement, ChartTooltip, Legend);
 
@@ -76,6 +77,7 @@ const IconPlay = () => (
 interface OverviewState {
   status: any;
   hardware: any;
+  inbound: InboundStreamHealth | null;
   loading: boolean;
   error: string | null;
   viewerHistory: number[];
@@ -101,6 +103,7 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
   state: OverviewState = {
     status: null,
     hardware: null,
+    inbound: null,
     loading: true,
     error: null,
     viewerHistory: [],
@@ -130,9 +133,10 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
 
   private async loadData() {
     try {
-      const [status, hardware] = await Promise.all([
+      const [status, hardware, inboundMetrics] = await Promise.all([
         api.admin.getStatus(this.props.token),
         api.admin.getHardware(this.props.token).catch(() => null),
+        api.admin.getInboundStreamMetrics(this.props.token).catch(() => null),
       ]);
 
       const s = status as any;
@@ -149,7 +153,7 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
       const labels = [...this.state.labels, now].slice(-MAX_HISTORY);
 
       this.setState({
-        status, hardware, loading: false, error: null,
+        status, hardware, inbound: inboundMetrics?.current || null, loading: false, error: null,
         viewerHistory, cpuHistory, memHistory, diskHistory, labels,
       }, () => {
         this.updateCharts();
@@ -373,9 +377,17 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
     const lastConnect = s?.lastConnectTime;
     const broadcaster = s?.broadcaster;
     const sd = broadcaster?.streamDetails;
+    const restreams: RestreamStatus[] = s?.restreams || [];
+    const { inbound } = this.state;
 
     return (
       <div class="space-y-6">
+        {s?.health?.message && (
+          <Alert>
+            <AlertDescription>{s.health.message}</AlertDescription>
+          </Alert>
+        )}
+
         {/* Stat cards */}
         <div class="grid grid-cols-1 sm:grid-cols-3 gap-4">
           <Card>
@@ -438,6 +450,12 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
                     <span class="font-mono text-foreground">{broadcaster.remoteAddr}</span>
                   </div>
                 )}
+                {broadcaster?.protocol && (
+                  <div class="flex justify-between text-sm">
+                    <span class="text-muted-foreground">Transport</span>
+                    <span class="text-foreground">{broadcaster.protocol.toUpperCase()}</span>
+                  </div>
+                )}
                 {sd && (
                   <>
                     <Separator />
@@ -455,6 +473,27 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
                     </div>
                   </>
                 )}
+                {inbound && (
+                  <>
+                    <Separator />
+                    <div class="flex justify-between text-sm">
+                      <span class="text-muted-foreground">Measured bitrate</span>
+                      <span class="text-foreground tabular-nums">{inbound.bitrateKbps} kbps</span>
+                    </div>
+                    <div class="flex justify-between text-sm">
+                      <span class="text-muted-foreground">Keyframe interval</span>
+                      <span class="text-foreground tabular-nums">{inbound.keyframeIntervalSeconds ? `${inbound.keyframeIntervalSeconds.toFixed(1)}s` : '—'}</span>
+                    </div>
+                    <div class="flex justify-between text-sm">
+                      <span class="text-muted-foreground">A/V drift</span>
+                      <span class="text-foreground tabular-nums">{inbound.avDriftMilliseconds} ms</span>
+                    </div>
+                    <div class="flex justify-between text-sm">
+                      <span class="text-muted-foreground">Dropped · late · jumps</span>
+                      <span class="text-foreground tabular-nums">{inbound.droppedFrames} · {inbound.latePackets} · {inbound.timestampJumps}</span>
+                    </div>
+                  </>
+                )}
                 {!sd && !broadcaster?.remoteAddr && (
                   <p class="text-sm text-muted-foreground italic">No stream details available</p>
                 )}
@@ -466,6 +505,43 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
           {this.renderHardwareGauges()}
         </div>
 
+        {/* Restream destinations */}
+        {restreams.length > 0 && (
+          <Card>
+            <CardHeader>
+              <CardTitle>Restreaming</CardTitle>
+              <CardDescription>Destinations the stream is forwarded to</CardDescription>
+            </CardHeader>
+            <CardContent>
+              <div class="space-y-2">
+                {restreams.map((restream) => (
+                  <div key={restream.id} class="flex items-center justify-between gap-3 text-sm">
+                    <div class="min-w-0">
+                      <p class="text-foreground truncate">{restream.name}</p>
+                      {restream.lastError && restream.state !== 'connected' && (
+                        <p class="text-xs text-destructive truncate" title={restream.lastError}>{restream.lastError}</p>
+                      )}
+                    </div>
+                    <div class="flex items-center gap-2 shrink-0">
+                      {restream.state === 'connected' && (
+                        <span class="text-xs text-muted-foreground tabular-nums">
+                          {restream.bitrate} kbps{restream.droppedPackets > 0 && ` · ${restream.droppedPackets} dropped`}
+                        </span>
+                      )}
+                      <Badge
+                        variant={restream.state === 'connected' ? 'default' : restream.state === 'reconnecting' ? 'destructive' : 'secondary'}
+                        className="capitalize"
+                      >
+                        {restream.state}
+                      </Badge>
+                    </div>
+                  </div>
+                ))}
+              </div>
+            </CardContent>
+          </Card>
+        )}
+
         {/* Resource history chart */}
         {this.state.hardware && (
           <Card>
@@ -648,7 +724,9 @@ export class OverviewTab extends Component<{ token: string }, OverviewState> {
         <div class="mb-6">
           <h1 class="text-2xl font-bold tracking-tight">Dashboard</h1>
           <p class="text-sm text-muted-foreground mt-1">
-            {online ? 'Your stream is live.' : 'Server overview and quick actions.'}
+            {online
+              ? (s?.reconnecting ? 'Waiting for your broadcasting software to reconnect.' : 'Your stream is live.')
+              : 'Server overview and quick actions.'}
           </p>
         </div>
         {online ? this.renderOnlineState(s) : this.renderOfflineState(s)}
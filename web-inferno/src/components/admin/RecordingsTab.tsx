@@ -0,0 +1,299 @@
+import { Component } from 'inferno';
+import { createElement } from 'inferno-create-element';
+import {
+  Button, Input, Textarea, Label, Switch, Card, CardHeader, CardTitle, CardDescription, CardContent,
+  Alert, AlertDescription, Badge, Skeleton, toast,
+} from 'blazecn';
+import { api } from '../../api';
+import { formatRelativeTime } from '../../utils';
+import type { Recording } from '../../types';
+
+const PAGE_SIZE = 20;
+
+function formatDuration(recording: Recording): string {
+  const seconds = Math.max(0, (new Date(recording.endedAt).getTime() - new Date(recording.startedAt).getTime()) / 1000);
+  const hours = Math.floor(seconds / 3600);
+  const minutes = Math.floor((seconds % 3600) / 60);
+  return hours > 0 ? `${hours}h ${minutes}m` : `${minutes}m`;
+}
+
+interface RecordingDraft {
+  title: string;
+  summary: string;
+  playlistUrl: string;
+  videoUrl: string;
+  thumbnailUrl: string;
+  width: string;
+  height: string;
+}
+
+function draftFor(recording: Recording): RecordingDraft {
+  return {
+    title: recording.title || '',
+    summary: recording.summary || '',
+    playlistUrl: recording.playlistUrl || '',
+    videoUrl: recording.videoUrl || '',
+    thumbnailUrl: recording.thumbnailUrl || '',
+    width: recording.width ? String(recording.width) : '',
+    height: recording.height ? String(recording.height) : '',
+  };
+}
+
+interface RecordingsTabState {
+  loading: boolean;
+  error: string | null;
+  recordings: Recording[];
+  total: number;
+  page: number;
+  legacyVideoEvents: boolean;
+  editing: string | null;
+  draft: RecordingDraft | null;
+  saving: boolean;
+  confirmDelete: string | null;
+}
+
+export class RecordingsTab extends Component<{ token: string }, RecordingsTabState> {
+  state: RecordingsTabState = {
+    loading: true,
+    error: null,
+    recordings: [],
+    total: 0,
+    page: 0,
+    legacyVideoEvents: false,
+    editing: null,
+    draft: null,
+    saving: false,
+    confirmDelete: null,
+  };
+
+  componentDidMount() {
+    this.loadRecordings(0);
+    this.loadConfig();
+  }
+
+  private async loadConfig() {
+    try {
+      const config = await api.admin.getConfig(this.props.token) as any;
+      this.setState({ legacyVideoEvents: !!config?.nostr?.legacyVideoEvents });
+    } catch { /* the recordings still load */ }
+  }
+
+  private async loadRecordings(page: number) {
+    try {
+      const result = await api.admin.getRecordings(this.props.token, page, PAGE_SIZE);
+      this.setState({ loading: false, recordings: result.results || [], total: result.total || 0, page });
+    } catch (err) {
+      this.setState({
+        loading: false,
+        error: err instanceof Error ? err.message : 'Failed to load recordings',
+      });
+    }
+  }
+
+  private toggleLegacy = async (value: boolean) => {
+    this.setState({ legacyVideoEvents: value });
+    try {
+      await api.admin.setNostrLegacyVideoEvents(this.props.token, value);
+      toast.success('Saved');
+    } catch {
+      this.setState({ legacyVideoEvents: !value });
+      toast.error('Failed to save');
+    }
+  };
+
+  private startEditing(recording: Recording) {
+    this.setState({ editing: recording.id, draft: draftFor(recording) });
+  }
+
+  private updateDraft(field: keyof RecordingDraft) {
+    return (e: Event) => {
+      const draft = { ...this.state.draft!, [field]: (e.target as HTMLInputElement).value };
+      this.setState({ draft });
+    };
+  }
+
+  private handleSave = async () => {
+    const { editing, draft } = this.state;
+    if (!editing || !draft) return;
+
+    this.setState({ saving: true });
+    try {
+      await api.admin.updateRecording(this.props.token, {
+        id: editing,
+        title: draft.title,
+        summary: draft.summary,
+        playlistUrl: draft.playlistUrl,
+        videoUrl: draft.videoUrl,
+        thumbnailUrl: draft.thumbnailUrl,
+        width: draft.width ? parseInt(draft.width, 10) : 0,
+        height: draft.height ? parseInt(draft.height, 10) : 0,
+      });
+      this.setState({ saving: false, editing: null, draft: null });
+      this.loadRecordings(this.state.page);
+      toast.success(draft.playlistUrl || draft.videoUrl ? 'Recording announced on Nostr' : 'Recording saved');
+    } catch {
+      this.setState({ saving: false });
+      toast.error('Failed to save the recording');
+    }
+  };
+
+  private handleDelete = async (id: string) => {
+    try {
+      await api.admin.deleteRecordingAnnouncement(this.props.token, id);
+      this.setState({ confirmDelete: null });
+      this.loadRecordings(this.state.page);
+      toast.success('Deletion requested');
+    } catch {
+      toast.error('Failed to delete the announcement');
+    }
+  };
+
+  private renderEditor() {
+    const { draft, saving } = this.state;
+    if (!draft) return null;
+
+    return (
+      <div class="space-y-3 mt-4">
+        <div class="space-y-1.5">
+          <Label>Title</Label>
+          <Input value={draft.title} onInput={this.updateDraft('title')} />
+        </div>
+        <div class="space-y-1.5">
+          <Label>Summary</Label>
+          <Textarea value={draft.summary} onInput={this.updateDraft('summary')} />
+        </div>
+        <div class="space-y-1.5">
+          <Label>HLS playlist URL</Label>
+          <Input placeholder="https://…/recording.m3u8" value={draft.playlistUrl} onInput={this.updateDraft('playlistUrl')} />
+        </div>
+        <div class="space-y-1.5">
+          <Label>Video file URL</Label>
+          <Input placeholder="https://…/recording.mp4" value={draft.videoUrl} onInput={this.updateDraft('videoUrl')} />
+        </div>
+        <div class="space-y-1.5">
+          <Label>Thumbnail URL</Label>
+          <Input placeholder="https://…/thumbnail.jpg" value={draft.thumbnailUrl} onInput={this.updateDraft('thumbnailUrl')} />
+        </div>
+        <div class="flex gap-3">
+          <div class="space-y-1.5 flex-1">
+            <Label>Width</Label>
+            <Input type="number" value={draft.width} onInput={this.updateDraft('width')} />
+          </div>
+          <div class="space-y-1.5 flex-1">
+            <Label>Height</Label>
+            <Input type="number" value={draft.height} onInput={this.updateDraft('height')} />
+          </div>
+        </div>
+        <p class="text-xs text-muted-foreground">
+          Once there is a playlist or video URL the recording is announced as a NIP-71 video event linked to the live activity. Saving again replaces the announcement.
+        </p>
+        <div class="flex gap-2">
+          <Button onClick={this.handleSave} disabled={saving}>{saving ? 'Saving...' : 'Save'}</Button>
+          <Button variant="ghost" onClick={() => this.setState({ editing: null, draft: null })}>Cancel</Button>
+        </div>
+      </div>
+    );
+  }
+
+  private renderRecording(recording: Recording) {
+    const { editing, confirmDelete } = this.state;
+    const published = !!recording.eventId;
+
+    return (
+      <Card key={recording.id}>
+        <CardContent className="p-4">
+          <div class="flex items-start justify-between gap-3">
+            <div class="min-w-0 flex-1">
+              <div class="flex items-center gap-2 mb-1">
+                <p class="text-sm font-medium text-foreground truncate">{recording.title || 'Untitled broadcast'}</p>
+                {published
+                  ? <Badge variant="secondary" className="text-[9px] px-1.5 py-0">Announced</Badge>
+                  : <Badge variant="outline" className="text-[9px] px-1.5 py-0">{recording.playlistUrl || recording.videoUrl ? 'Not announced' : 'No video'}</Badge>}
+              </div>
+              <p class="text-[11px] text-muted-foreground">
+                {formatRelativeTime(recording.startedAt)} · {formatDuration(recording)}
+                {recording.publishedAt && <span> · announced {formatRelativeTime(recording.publishedAt)}</span>}
+              </p>
+            </div>
+            {editing !== recording.id && (
+              <div class="flex items-center gap-1.5 shrink-0">
+                <Button variant="secondary" size="xs" onClick={() => this.startEditing(recording)}>Edit</Button>
+                {published && (confirmDelete === recording.id ? (
+                  <div class="flex items-center gap-1.5">
+                    <Button variant="destructive" size="xs" onClick={() => this.handleDelete(recording.id)}>Confirm</Button>
+                    <Button variant="ghost" size="xs" onClick={() => this.setState({ confirmDelete: null })}>Cancel</Button>
+                  </div>
+                ) : (
+                  <Button variant="ghost" size="xs" onClick={() => this.setState({ confirmDelete: recording.id })}>Delete announcement</Button>
+                ))}
+              </div>
+            )}
+          </div>
+          {editing === recording.id && this.renderEditor()}
+        </CardContent>
+      </Card>
+    );
+  }
+
+  render() {
+    const { loading, error, recordings, total, page, legacyVideoEvents } = this.state;
+    const pages = Math.ceil(total / PAGE_SIZE);
+
+    if (loading) {
+      return (
+        <div class="space-y-4">
+          <Skeleton className="h-8 w-48" />
+          <Skeleton className="h-32 rounded-xl" />
+        </div>
+      );
+    }
+
+    return (
+      <div class="max-w-3xl space-y-6">
+        <div>
+          <h1 class="text-2xl font-bold text-foreground tracking-tight">Recordings</h1>
+          <p class="text-sm text-muted-foreground mt-1">Announce replays of past broadcasts on Nostr as NIP-71 video events.</p>
+        </div>
+
+        {error && (
+          <Alert variant="destructive">
+            <AlertDescription>{error}</AlertDescription>
+          </Alert>
+        )}
+
+        <Card>
+          <CardHeader>
+            <CardTitle>Older clients</CardTitle>
+            <CardDescription>Also publish kind 34235 video events for clients that do not support kind 21 and 22 yet.</CardDescription>
+          </CardHeader>
+          <CardContent>
+            <div class="flex items-center justify-between">
+              <p class="text-sm text-foreground">Publish kind 34235 video events</p>
+              <Switch checked={legacyVideoEvents} onChange={this.toggleLegacy} />
+            </div>
+          </CardContent>
+        </Card>
+
+        {recordings.length === 0 ? (
+          <Card>
+            <CardContent className="py-12">
+              <p class="text-sm text-muted-foreground text-center">Broadcasts show up here once they end.</p>
+            </CardContent>
+          </Card>
+        ) : (
+          <div class="space-y-3">
+            {recordings.map((recording) => this.renderRecording(recording))}
+          </div>
+        )}
+
+        {pages > 1 && (
+          <div class="flex items-center justify-end gap-2">
+            <Button variant="ghost" size="xs" disabled={page === 0} onClick={() => this.loadRecordings(page - 1)}>Newer</Button>
+            <span class="text-xs text-muted-foreground">{page + 1} / {pages}</span>
+            <Button variant="ghost" size="xs" disabled={page + 1 >= pages} onClick={() => this.loadRecordings(page + 1)}>Older</Button>
+          </div>
+        )}
+      </div>
+    );
+  }
+}
@@ -7,6 +7,7 @@ import {
 } from 'blazecn';
 import { cn } from 'blazecn';
 import { api } from '../../api';
+import type { RTMPSConfig, RTMPTakeoverConfig, RTMPTakeoverPolicy, RestreamDestination, PublishCallbackConfig, StreamKey, BroadcastHistoryEntry } from '../../types';
 
 const LATENCY_LEVELS = [
   { value: 1, label: 'Low Latency', description: '~4s delay. Best for interactive streams.' },
@@ -15,6 +16,12 @@ const LATENCY_LEVELS = [
   { value: 4, label: 'Offline', description: 'Optimized for VOD-style playback.' },
 ];
 
+const TAKEOVER_POLICIES: { value: RTMPTakeoverPolicy; label: string; description: string }[] = [
+  { value: 'reject', label: 'Reject', description: 'Keep the current connection and refuse new ones.' },
+  { value: 'samekey', label: 'Same Stream Key', description: 'Replace the current connection with one using the same key.' },
+  { value: 'idle', label: 'After Silence', description: 'Replace the current connection once it stops sending data.' },
+];
+
 const CPU_USAGE_LEVELS = [
   { value: 1, label: 'Fastest', description: 'Lowest quality, minimal CPU' },
   { value: 2, label: 'Faster', description: 'Low quality, low CPU' },
@@ -42,22 +49,25 @@ interface VideoVariant {
   cpuUsageLevel: number;
 }
 
-interface StreamKeyEntry {
-  key: string;
-  comment: string;
-}
-
 interface VideoConfigState {
   loading: boolean;
   saving: boolean;
   error: string | null;
   latencyLevel: number;
   variants: VideoVariant[];
-  streamKeys: StreamKeyEntry[];
+  streamKeys: StreamKey[];
   showStreamKeys: Set<number>;
+  editingKey: number | null;
+  broadcastHistory: BroadcastHistoryEntry[];
   newKeyValue: string;
   newKeyComment: string;
   rtmpPort: number;
+  rtmps: RTMPSConfig;
+  srtPort: number;
+  rtmpTakeover: RTMPTakeoverConfig;
+  restreamDestinations: RestreamDestination[];
+  publishCallback: PublishCallbackConfig;
+  reconnectGracePeriod: number;
   webPort: number;
   serverURL: string;
   videoCodec: string;
@@ -114,6 +124,18 @@ function defaultVariant(): VideoVariant {
   };
 }
 
+// datetime-local inputs work in local time without a zone.
+function toLocalInput(iso?: string): string {
+  if (!iso) return '';
+  const d = new Date(iso);
+  const pad = (n: number) => String(n).padStart(2, '0');
+  return `${d.getFullYear()}-${pad(d.getMonth() + 1)}-${pad(d.getDate())}T${pad(d.getHours())}:${pad(d.getMinutes())}`;
+}
+
+function fromLocalInput(value: string): string | undefined {
+  return value ? new Date(value).toISOString() : undefined;
+}
+
 export class VideoConfigTab extends Component<{ token: string }, VideoConfigState> {
   state: VideoConfigState = {
     loading: true,
@@ -123,9 +145,17 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
     variants: [],
     streamKeys: [],
     showStreamKeys: new Set<number>(),
+    editingKey: null,
+    broadcastHistory: [],
     newKeyValue: '',
     newKeyComment: '',
     rtmpPort: 1935,
+    rtmps: { enabled: false, port: 1936, certificatePath: '', keyPath: '' },
+    srtPort: 9000,
+    rtmpTakeover: { policy: 'reject', idleSeconds: 5 },
+    restreamDestinations: [],
+    publishCallback: { enabled: false, url: '', cacheSeconds: 10, failOpen: false },
+    reconnectGracePeriod: 0,
     webPort: 8080,
     serverURL: '',
     videoCodec: '',
@@ -135,15 +165,27 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
 
   componentDidMount() {
     this.loadConfig();
+    this.loadBroadcastHistory();
+  }
+
+  private async loadBroadcastHistory() {
+    try {
+      const history = await api.admin.getBroadcastHistory(this.props.token, 0, 10);
+      this.setState({ broadcastHistory: history?.results || [] });
+    } catch {
+      // The history is informational, the rest of the page works without it.
+    }
   }
 
   private async loadConfig() {
     try {
       const config = await api.admin.getConfig(this.props.token) as any;
       const vs = config?.videoSettings || {};
-      // streamKeys comes as array of {key, comment} from the Go backend
+      // Keep every field of the keys, so saving them does not drop their
+      // validity window or overrides.
       const rawKeys = config?.streamKeys || [];
-      const streamKeys: StreamKeyEntry[] = rawKeys.map((k: any) => ({
+      const streamKeys: StreamKey[] = rawKeys.map((k: any) => ({
+        ...k,
         key: k.key || k.Key || '',
         comment: k.comment || k.Comment || '',
       }));
@@ -159,6 +201,25 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
         variants: vs.videoQualityVariants || [defaultVariant()],
         streamKeys,
         rtmpPort: config?.rtmpServerPort || 1935,
+        rtmps: {
+          enabled: !!config?.rtmps?.enabled,
+          port: config?.rtmps?.port || 1936,
+          certificatePath: config?.rtmps?.certificatePath || '',
+          keyPath: config?.rtmps?.keyPath || '',
+        },
+        srtPort: config?.srtServerPort || 9000,
+        rtmpTakeover: {
+          policy: config?.rtmpTakeover?.policy || 'reject',
+          idleSeconds: config?.rtmpTakeover?.idleSeconds || 5,
+        },
+        restreamDestinations: config?.restreamDestinations || [],
+        publishCallback: {
+          enabled: !!config?.publishCallback?.enabled,
+          url: config?.publishCallback?.url || '',
+          cacheSeconds: config?.publishCallback?.cacheSeconds ?? 10,
+          failOpen: !!config?.publishCallback?.failOpen,
+        },
+        reconnectGracePeriod: config?.reconnectGracePeriod || 0,
         webPort: config?.webServerPort || 8080,
         serverURL,
         videoCodec: config?.videoCodec || '',
@@ -194,16 +255,29 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
       await api.admin.setStreamKeys(this.props.token, this.state.streamKeys);
       toast.success('Stream keys saved');
     } catch (err) {
-      toast.error('Failed to save stream keys');
+      toast.error('Failed to save stream keys. Each key must be unique, and expire after it becomes valid.');
     }
   };
 
+  private updateStreamKey(index: number, updates: Partial<StreamKey>) {
+    const streamKeys = [...this.state.streamKeys];
+    streamKeys[index] = { ...streamKeys[index], ...updates };
+    this.setState({ streamKeys });
+  }
+
+  private streamKeyStatus(sk: StreamKey): string | null {
+    const now = Date.now();
+    if (sk.expiresAt && new Date(sk.expiresAt).getTime() <= now) return 'Expired';
+    if (sk.notBefore && new Date(sk.notBefore).getTime() > now) return 'Not yet valid';
+    return null;
+  }
+
   private addStreamKey = () => {
     const key = this.state.newKeyValue.trim();
     if (!key) return;
     const comment = this.state.newKeyComment.trim() || `Key ${this.state.streamKeys.length + 1}`;
     this.setState({
-      streamKeys: [...this.state.streamKeys, { key, comment }],
+      streamKeys: [...this.state.streamKeys, { key, comment, label: comment }],
       newKeyValue: '',
       newKeyComment: '',
     }, () => this.handleSaveStreamKeys());
@@ -211,7 +285,7 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
 
   private removeStreamKey = (index: number) => {
     const streamKeys = this.state.streamKeys.filter((_, i) => i !== index);
-    this.setState({ streamKeys }, () => this.handleSaveStreamKeys());
+    this.setState({ streamKeys, editingKey: null }, () => this.handleSaveStreamKeys());
   };
 
   private toggleShowKey(index: number) {
@@ -229,6 +303,14 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
     }
   }
 
+  private getWhipURL(): string {
+    try {
+      return new URL('/whip', this.state.serverURL).toString();
+    } catch {
+      return `${window.location.origin}/whip`;
+    }
+  }
+
   private handleVideoCodecChange = async (codec: string) => {
     try {
       await api.admin.setVideoCodec(this.props.token, codec);
@@ -239,6 +321,78 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
     }
   };
 
+  private handleSaveTakeover = async (rtmpTakeover: RTMPTakeoverConfig) => {
+    this.setState({ rtmpTakeover });
+    try {
+      await api.admin.setRTMPTakeover(this.props.token, rtmpTakeover);
+      toast.success('Takeover policy updated');
+    } catch {
+      toast.error('Failed to update takeover policy');
+    }
+  };
+
+  private handleSaveRTMPS = async () => {
+    try {
+      await api.admin.setRTMPSConfig(this.props.token, this.state.rtmps);
+      toast.success('RTMPS updated. Restart the server to apply it.');
+    } catch {
+      toast.error('Failed to update RTMPS. Check the port and that the certificate files can be read.');
+    }
+  };
+
+  private updateRTMPS(updates: Partial<RTMPSConfig>) {
+    this.setState({ rtmps: { ...this.state.rtmps, ...updates } });
+  }
+
+  private handleSavePublishCallback = async () => {
+    try {
+      await api.admin.setPublishCallback(this.props.token, this.state.publishCallback);
+      toast.success('Publish callback updated');
+    } catch {
+      toast.error('Failed to update the publish callback. It needs an http:// or https:// URL.');
+    }
+  };
+
+  private updatePublishCallback(updates: Partial<PublishCallbackConfig>) {
+    this.setState({ publishCallback: { ...this.state.publishCallback, ...updates } });
+  }
+
+  private handleSaveRestream = async () => {
+    try {
+      await api.admin.setRestreamDestinations(this.props.token, this.state.restreamDestinations);
+      toast.success('Restream destinations updated');
+      this.loadConfig();
+    } catch {
+      toast.error('Failed to update restream destinations. Each one needs a name and an rtmp:// or rtmps:// URL.');
+    }
+  };
+
+  private updateRestreamDestination(index: number, updates: Partial<RestreamDestination>) {
+    const restreamDestinations = [...this.state.restreamDestinations];
+    restreamDestinations[index] = { ...restreamDestinations[index], ...updates };
+    this.setState({ restreamDestinations });
+  }
+
+  private addRestreamDestination = () => {
+    this.setState({
+      restreamDestinations: [...this.state.restreamDestinations, { id: '', name: '', url: '', streamKey: '', enabled: true }],
+    });
+  };
+
+  private removeRestreamDestination = (index: number) => {
+    this.setState({ restreamDestinations: this.state.restreamDestinations.filter((_, i) => i !== index) });
+  };
+
+  private handleSaveReconnectGracePeriod = async (reconnectGracePeriod: number) => {
+    this.setState({ reconnectGracePeriod });
+    try {
+      await api.admin.setReconnectGracePeriod(this.props.token, reconnectGracePeriod);
+      toast.success('Reconnect grace period updated');
+    } catch {
+      toast.error('Failed to update reconnect grace period');
+    }
+  };
+
   private updateVariant(index: number, updates: Partial<VideoVariant>) {
     const variants = [...this.state.variants];
     variants[index] = { ...variants[index], ...updates };
@@ -466,7 +620,7 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
   }
 
   render() {
-    const { loading, saving, error, latencyLevel, variants, streamKeys, showStreamKeys, newKeyValue, newKeyComment, rtmpPort, serverURL, videoCodec, supportedCodecs, streamKeyOverridden } = this.state;
+    const { loading, saving, error, latencyLevel, variants, streamKeys, showStreamKeys, editingKey, broadcastHistory, newKeyValue, newKeyComment, rtmpPort, rtmps, srtPort, rtmpTakeover, restreamDestinations, publishCallback, reconnectGracePeriod, serverURL, videoCodec, supportedCodecs, streamKeyOverridden } = this.state;
 
     if (loading) {
       return (
@@ -525,6 +679,65 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
               <p class="text-[10px] text-muted-foreground">Use this URL in OBS → Settings → Stream → Server. Put your stream key in the "Stream Key" field.</p>
             </div>
 
+            {/* RTMPS connection info */}
+            {rtmps.enabled && (
+              <div class="rounded-xl border border-border bg-muted/30 p-4 space-y-3">
+                <p class="text-[11px] text-muted-foreground font-semibold uppercase tracking-wider">RTMPS URL</p>
+                <div class="flex items-center gap-2">
+                  <code class="text-xs text-foreground font-mono bg-background px-3 py-1.5 rounded-lg border border-border flex-1 truncate">
+                    rtmps://{this.getRtmpHost()}:{rtmps.port}/live
+                  </code>
+                  <Button
+                    variant="outline"
+                    size="icon-sm"
+                    className="shrink-0"
+                    onClick={() => this.copyToClipboard(`rtmps://${this.getRtmpHost()}:${rtmps.port}/live`)}
+                  >
+                    <IconCopy />
+                  </Button>
+                </div>
+                <p class="text-[10px] text-muted-foreground">The same as RTMP, but your stream key is encrypted on the way.</p>
+              </div>
+            )}
+
+            {/* SRT connection info */}
+            <div class="rounded-xl border border-border bg-muted/30 p-4 space-y-3">
+              <p class="text-[11px] text-muted-foreground font-semibold uppercase tracking-wider">SRT URL</p>
+              <div class="flex items-center gap-2">
+                <code class="text-xs text-foreground font-mono bg-background px-3 py-1.5 rounded-lg border border-border flex-1 truncate">
+                  srt://{this.getRtmpHost()}:{srtPort}?streamid=#!::r=live/&lt;stream key&gt;,m=publish
+                </code>
+                <Button
+                  variant="outline"
+                  size="icon-sm"
+                  className="shrink-0"
+                  onClick={() => this.copyToClipboard(`srt://${this.getRtmpHost()}:${srtPort}?streamid=#!::r=live/<stream key>,m=publish`)}
+                >
+                  <IconCopy />
+                </Button>
+              </div>
+              <p class="text-[10px] text-muted-foreground">For unreliable networks such as mobile connections. Put your stream key after <code>live/</code> in the streamid.</p>
+            </div>
+
+            {/* WHIP connection info */}
+            <div class="rounded-xl border border-border bg-muted/30 p-4 space-y-3">
+              <p class="text-[11px] text-muted-foreground font-semibold uppercase tracking-wider">WHIP URL</p>
+              <div class="flex items-center gap-2">
+                <code class="text-xs text-foreground font-mono bg-background px-3 py-1.5 rounded-lg border border-border flex-1 truncate">
+                  {this.getWhipURL()}
+                </code>
+                <Button
+                  variant="outline"
+                  size="icon-sm"
+                  className="shrink-0"
+                  onClick={() => this.copyToClipboard(this.getWhipURL())}
+                >
+                  <IconCopy />
+                </Button>
+              </div>
+              <p class="text-[10px] text-muted-foreground">For sub-second latency with OBS 30+ (Settings → Stream → Service: WHIP) or a browser. Use your stream key as the Bearer Token.</p>
+            </div>
+
             <Separator />
 
             {/* Existing keys */}
@@ -535,27 +748,119 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
             )}
 
             <div class="space-y-2">
-              {streamKeys.map((sk, i) => (
-                <div key={i} class="flex items-center gap-2 rounded-lg border border-border p-3">
-                  <div class="flex-1 min-w-0">
-                    <p class="text-xs font-medium text-muted-foreground mb-1">{sk.comment || `Key ${i + 1}`}</p>
-                    <div class="flex items-center gap-1">
-                      <code class="text-xs font-mono truncate">
-                        {showStreamKeys.has(i) ? sk.key : '••••••••••••••••'}
-                      </code>
+              {streamKeys.map((sk, i) => {
+                const status = this.streamKeyStatus(sk);
+                return (
+                  <div key={i} class="rounded-lg border border-border p-3 space-y-3">
+                    <div class="flex items-center gap-2">
+                      <div class="flex-1 min-w-0">
+                        <div class="flex items-center gap-2 mb-1">
+                          <p class="text-xs font-medium text-muted-foreground">{sk.label || sk.comment || `Key ${i + 1}`}</p>
+                          {status && <Badge variant="outline" className="text-[10px]">{status}</Badge>}
+                          {(sk.latencyLevel || sk.outputVariants) && <Badge variant="secondary" className="text-[10px]">Overrides</Badge>}
+                        </div>
+                        <div class="flex items-center gap-1">
+                          <code class="text-xs font-mono truncate">
+                            {showStreamKeys.has(i) ? sk.key : '••••••••••••••••'}
+                          </code>
+                        </div>
+                        <p class="text-[10px] text-muted-foreground mt-1">
+                          {sk.lastUsedAt
+                            ? `Last used ${new Date(sk.lastUsedAt).toLocaleString()}${sk.lastUsedAddress ? ` from ${sk.lastUsedAddress}` : ''}`
+                            : 'Never used'}
+                        </p>
+                      </div>
+                      <Button variant="ghost" size="sm" className="h-7 text-xs shrink-0" onClick={() => this.setState({ editingKey: editingKey === i ? null : i })}>
+                        {editingKey === i ? 'Close' : 'Edit'}
+                      </Button>
+                      <Button variant="ghost" size="icon-sm" className="size-7 shrink-0" onClick={() => this.toggleShowKey(i)}>
+                        {showStreamKeys.has(i) ? <IconEyeOff /> : <IconEye />}
+                      </Button>
+                      <Button variant="ghost" size="icon-sm" className="size-7 shrink-0" onClick={() => this.copyToClipboard(sk.key)}>
+                        <IconCopy />
+                      </Button>
+                      <Button variant="ghost" size="icon-sm" className="size-7 shrink-0 text-destructive/60 hover:text-destructive" onClick={() => this.removeStreamKey(i)}>
+                        <IconTrash />
+                      </Button>
                     </div>
+
+                    {editingKey === i && (
+                      <div class="space-y-3 border-t border-border pt-3">
+                        <div class="space-y-1">
+                          <Label className="text-xs font-semibold text-muted-foreground">Label</Label>
+                          <Input
+                            className="text-xs"
+                            placeholder="Who or what streams with this key"
+                            value={sk.label || ''}
+                            onInput={(e: Event) => this.updateStreamKey(i, { label: (e.target as HTMLInputElement).value })}
+                          />
+                        </div>
+                        <div class="grid grid-cols-1 sm:grid-cols-2 gap-3">
+                          <div class="space-y-1">
+                            <Label className="text-xs font-semibold text-muted-foreground">Valid from</Label>
+                            <Input
+                              type="datetime-local"
+                              className="text-xs"
+                              value={toLocalInput(sk.notBefore)}
+                              onInput={(e: Event) => this.updateStreamKey(i, { notBefore: fromLocalInput((e.target as HTMLInputElement).value) })}
+                            />
+                          </div>
+                          <div class="space-y-1">
+                            <Label className="text-xs font-semibold text-muted-foreground">Expires</Label>
+                            <Input
+                              type="datetime-local"
+                              className="text-xs"
+                              value={toLocalInput(sk.expiresAt)}
+                              onInput={(e: Event) => this.updateStreamKey(i, { expiresAt: fromLocalInput((e.target as HTMLInputElement).value) })}
+                            />
+                          </div>
+                        </div>
+                        <div class="space-y-1">
+                          <Label className="text-xs font-semibold text-muted-foreground">Latency</Label>
+                          <div class="flex flex-wrap gap-2">
+                            <Button
+                              size="sm"
+                              variant={!sk.latencyLevel ? 'default' : 'outline'}
+                              className="h-7 text-xs"
+                              onClick={() => this.updateStreamKey(i, { latencyLevel: undefined })}
+                            >
+                              Default
+                            </Button>
+                            {LATENCY_LEVELS.map((level) => (
+                              <Button
+                                key={level.value}
+                                size="sm"
+                                variant={sk.latencyLevel === level.value ? 'default' : 'outline'}
+                                className="h-7 text-xs"
+                                onClick={() => this.updateStreamKey(i, { latencyLevel: level.value })}
+                              >
+                                {level.label}
+                              </Button>
+                            ))}
+                          </div>
+                        </div>
+                        <div class="flex items-center justify-between">
+                          <div>
+                            <Label className="text-xs font-semibold text-muted-foreground">Use its own output variants</Label>
+                            <p class="text-[10px] text-muted-foreground">
+                              {sk.outputVariants
+                                ? `${sk.outputVariants.length} variant${sk.outputVariants.length === 1 ? '' : 's'}, copied from the Output Variants below.`
+                                : 'Broadcasts with this key use the Output Variants below.'}
+                            </p>
+                          </div>
+                          <Switch
+                            checked={!!sk.outputVariants}
+                            onChange={(checked: boolean) => this.updateStreamKey(i, { outputVariants: checked ? variants.map((v) => ({ ...v })) : undefined })}
+                          />
+                        </div>
+                        <div class="flex justify-end">
+                          <Button size="sm" onClick={this.handleSaveStreamKeys}>Save Key</Button>
+                        </div>
+                      </div>
+                    )}
                   </div>
-                  <Button variant="ghost" size="icon-sm" className="size-7 shrink-0" onClick={() => this.toggleShowKey(i)}>
-                    {showStreamKeys.has(i) ? <IconEyeOff /> : <IconEye />}
-                  </Button>
-                  <Button variant="ghost" size="icon-sm" className="size-7 shrink-0" onClick={() => this.copyToClipboard(sk.key)}>
-                    <IconCopy />
-                  </Button>
-                  <Button variant="ghost" size="icon-sm" className="size-7 shrink-0 text-destructive/60 hover:text-destructive" onClick={() => this.removeStreamKey(i)}>
-                    <IconTrash />
-                  </Button>
-                </div>
-              ))}
+                );
+              })}
             </div>
 
             <Separator />
@@ -586,6 +891,262 @@ export class VideoConfigTab extends Component<{ token: string }, VideoConfigStat
           </CardContent>
         </Card>
 
+        {/* Broadcast history */}
+        <Card>
+          <CardHeader>
+            <CardTitle>Recent Broadcasts</CardTitle>
+            <CardDescription>Which stream key started each broadcast, and from where.</CardDescription>
+          </CardHeader>
+          <CardContent>
+            {broadcastHistory.length === 0 ? (
+              <p class="text-xs text-muted-foreground">No broadcasts yet.</p>
+            ) : (
+              <div class="space-y-2">
+                {broadcastHistory.map((entry) => (
+                  <div key={entry.id} class="flex items-center gap-3 rounded-lg border border-border px-3 py-2">
+                    <div class="flex-1 min-w-0">
+                      <p class="text-xs font-medium text-foreground truncate">
+                        {entry.streamKeyLabel || 'Unlabelled key'}
+                        {entry.streamKeyHint && <span class="font-mono text-muted-foreground"> …{entry.streamKeyHint}</span>}
+                      </p>
+                      <p class="text-[10px] text-muted-foreground">
+                        {new Date(entry.startedAt).toLocaleString()}
+                        {entry.endedAt ? ` – ${new Date(entry.endedAt).toLocaleTimeString()}` : ' – live'}
+                        {entry.remoteAddr && ` from ${entry.remoteAddr}`}
+                      </p>
+                    </div>
+                    <Badge variant="outline" className="text-[10px] uppercase">{entry.protocol}</Badge>
+                  </div>
+                ))}
+              </div>
+            )}
+          </CardContent>
+        </Card>
+
+        {/* Publish callback */}
+        <Card>
+          <CardHeader>
+            <CardTitle>Publish Callback</CardTitle>
+            <CardDescription>
+              Ask your own server whether a broadcaster may stream over RTMP, SRT or WHIP, instead of checking the stream keys above. It is sent the stream key, address and, over RTMP, the encoder details, and can set the stream title and which output variants to use.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-3">
+            <div class="flex items-center justify-between">
+              <Label className="text-xs font-semibold text-muted-foreground">Enable publish callback</Label>
+              <Switch
+                checked={publishCallback.enabled}
+                onChange={(enabled: boolean) => this.updatePublishCallback({ enabled })}
+              />
+            </div>
+            <div class="grid grid-cols-1 sm:grid-cols-3 gap-3">
+              <div class="space-y-1 sm:col-span-2">
+                <Label className="text-xs font-semibold text-muted-foreground">Callback URL</Label>
+                <Input
+                  className="text-xs font-mono"
+                  placeholder="https://example.com/on_publish"
+                  value={publishCallback.url}
+                  onInput={(e: Event) => this.updatePublishCallback({ url: (e.target as HTMLInputElement).value })}
+                />
+              </div>
+              <div class="space-y-1">
+                <Label className="text-xs font-semibold text-muted-foreground">Cache decisions (seconds)</Label>
+                <Input
+                  type="number"
+                  className="text-xs"
+                  min={0}
+                  max={3600}
+                  value={publishCallback.cacheSeconds}
+                  onInput={(e: Event) => this.updatePublishCallback({ cacheSeconds: parseInt((e.target as HTMLInputElement).value, 10) || 0 })}
+                />
+              </div>
+            </div>
+            <div class="flex items-center justify-between">
+              <div>
+                <Label className="text-xs font-semibold text-muted-foreground">Allow streaming when unreachable</Label>
+                <p class="text-[10px] text-muted-foreground">The configured stream keys are checked instead while the callback is down.</p>
+              </div>
+              <Switch
+                checked={publishCallback.failOpen}
+                onChange={(failOpen: boolean) => this.updatePublishCallback({ failOpen })}
+              />
+            </div>
+            <div class="flex justify-end">
+              <Button size="sm" onClick={this.handleSavePublishCallback}>Save callback</Button>
+            </div>
+          </CardContent>
+        </Card>
+
+        {/* RTMPS */}
+        <Card>
+          <CardHeader>
+            <CardTitle>RTMPS</CardTitle>
+            <CardDescription>
+              Accept RTMP over TLS, so your stream key is not sent in plain text. The certificate is reloaded when its files change or on SIGHUP.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-3">
+            <div class="flex items-center justify-between">
+              <Label className="text-xs font-semibold text-muted-foreground">Enable RTMPS</Label>
+              <Switch
+                checked={rtmps.enabled}
+                onChange={(enabled: boolean) => this.updateRTMPS({ enabled })}
+              />
+            </div>
+            <div class="grid grid-cols-1 sm:grid-cols-3 gap-3">
+              <div class="space-y-1">
+                <Label className="text-xs font-semibold text-muted-foreground">Port</Label>
+                <Input
+                  type="number"
+                  className="text-xs"
+                  min={1}
+                  max={65535}
+                  value={rtmps.port}
+                  onInput={(e: Event) => this.updateRTMPS({ port: parseInt((e.target as HTMLInputElement).value, 10) || 0 })}
+                />
+              </div>
+              <div class="space-y-1">
+                <Label className="text-xs font-semibold text-muted-foreground">Certificate file</Label>
+                <Input
+                  className="text-xs font-mono"
+                  placeholder="/etc/letsencrypt/live/example.com/fullchain.pem"
+                  value={rtmps.certificatePath}
+                  onInput={(e: Event) => this.updateRTMPS({ certificatePath: (e.target as HTMLInputElement).value })}
+                />
+              </div>
+              <div class="space-y-1">
+                <Label className="text-xs font-semibold text-muted-foreground">Key file</Label>
+                <Input
+                  className="text-xs font-mono"
+                  placeholder="/etc/letsencrypt/live/example.com/privkey.pem"
+                  value={rtmps.keyPath}
+                  onInput={(e: Event) => this.updateRTMPS({ keyPath: (e.target as HTMLInputElement).value })}
+                />
+              </div>
+            </div>
+            <div class="flex items-center justify-between">
+              <p class="text-[10px] text-muted-foreground">Changes apply after restarting the server.</p>
+              <Button size="sm" onClick={this.handleSaveRTMPS}>Save RTMPS</Button>
+            </div>
+          </CardContent>
+        </Card>
+
+        {/* Restreaming */}
+        <Card>
+          <CardHeader>
+            <CardTitle>Restreaming</CardTitle>
+            <CardDescription>
+              Forward the inbound RTMP stream to other platforms as it arrives. Each destination reconnects on its own, and one that fails never interrupts your stream here.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-3">
+            {restreamDestinations.length === 0 && (
+              <p class="text-xs text-muted-foreground italic">No destinations yet.</p>
+            )}
+            {restreamDestinations.map((destination, index) => (
+              <div key={destination.id || index} class="rounded-lg border border-border p-3 space-y-2">
+                <div class="flex items-center gap-2">
+                  <Input
+                    className="text-xs flex-1"
+                    placeholder="Name, e.g. YouTube"
+                    value={destination.name}
+                    onInput={(e: Event) => this.updateRestreamDestination(index, { name: (e.target as HTMLInputElement).value })}
+                  />
+                  <Switch
+                    checked={destination.enabled}
+                    onChange={(enabled: boolean) => this.updateRestreamDestination(index, { enabled })}
+                  />
+                  <Button variant="ghost" size="icon-sm" onClick={() => this.removeRestreamDestination(index)} title="Remove destination">
+                    <IconTrash />
+                  </Button>
+                </div>
+                <div class="grid grid-cols-1 sm:grid-cols-2 gap-2">
+                  <Input
+                    className="text-xs font-mono"
+                    placeholder="rtmp://a.rtmp.youtube.com/live2"
+                    value={destination.url}
+                    onInput={(e: Event) => this.updateRestreamDestination(index, { url: (e.target as HTMLInputElement).value })}
+                  />
+                  <Input
+                    type="password"
+                    className="text-xs font-mono"
+                    placeholder="Stream key"
+                    value={destination.streamKey}
+                    onInput={(e: Event) => this.updateRestreamDestination(index, { streamKey: (e.target as HTMLInputElement).value })}
+                  />
+                </div>
+              </div>
+            ))}
+            <div class="flex items-center justify-between">
+              <Button variant="outline" size="sm" onClick={this.addRestreamDestination} className="gap-1">
+                <IconPlus /> Add destination
+              </Button>
+              <Button size="sm" onClick={this.handleSaveRestream}>Save destinations</Button>
+            </div>
+            <p class="text-[10px] text-muted-foreground">Streams sent over SRT or WHIP are not restreamed.</p>
+          </CardContent>
+        </Card>
+
+        {/* RTMP takeover */}
+        <Card>
+          <CardHeader>
+            <CardTitle>Reconnecting Publishers</CardTitle>
+            <CardDescription>
+              What happens when broadcasting software connects while a stream is already running, for example after your connection drops.
+              A publisher that takes over continues the same broadcast without going offline.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-3">
+            <div class="grid grid-cols-1 sm:grid-cols-3 gap-3">
+              {TAKEOVER_POLICIES.map((option) => {
+                const isActive = rtmpTakeover.policy === option.value;
+                return (
+                  <button
+                    key={option.value}
+                    class={cn('radio-card rounded-xl px-4 py-4 text-left cursor-pointer', isActive && 'active')}
+                    onClick={() => this.handleSaveTakeover({ ...rtmpTakeover, policy: option.value })}
+                  >
+                    <p class={cn('text-sm font-semibold mb-1', isActive ? 'text-primary' : 'text-foreground')}>{option.label}</p>
+                    <p class="text-xs text-muted-foreground leading-relaxed">{option.description}</p>
+                  </button>
+                );
+              })}
+            </div>
+            <div class="flex items-center gap-2">
+              <Label className="text-xs font-semibold text-muted-foreground">Grace period (seconds)</Label>
+              <Input
+                type="number"
+                className="w-24 text-xs"
+                min={0}
+                max={300}
+                value={reconnectGracePeriod}
+                onChange={(e: Event) => {
+                  const seconds = parseInt((e.target as HTMLInputElement).value, 10);
+                  if (seconds >= 0 && seconds <= 300) this.handleSaveReconnectGracePeriod(seconds);
+                }}
+              />
+            </div>
+            <p class="text-[10px] text-muted-foreground">
+              When the connection drops, viewers see a reconnecting screen for up to this long before the stream ends. 0 ends it right away.
+            </p>
+            {rtmpTakeover.policy === 'idle' && (
+              <div class="flex items-center gap-2">
+                <Label className="text-xs font-semibold text-muted-foreground">Seconds without data</Label>
+                <Input
+                  type="number"
+                  className="w-24 text-xs"
+                  min={1}
+                  value={rtmpTakeover.idleSeconds}
+                  onChange={(e: Event) => {
+                    const idleSeconds = parseInt((e.target as HTMLInputElement).value, 10);
+                    if (idleSeconds > 0) this.handleSaveTakeover({ ...rtmpTakeover, idleSeconds });
+                  }}
+                />
+              </div>
+            )}
+          </CardContent>
+        </Card>
+
         {/* Video Codec */}
         {supportedCodecs.length > 1 && (
           <Card>
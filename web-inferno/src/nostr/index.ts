@@ -9,3 +9,4 @@ export * from './nip07';
 export * from './nip53';
 export * from './nip55';
 export * from './utils';
+export * from './nip98';
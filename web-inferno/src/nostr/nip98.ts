@@ -0,0 +1,41 @@
+import { sha256 } from '@noble/hashes/sha256';
+import { bytesToHex } from '@noble/hashes/utils';
+import { createEvent } from './event';
+import { signWithExtension } from './nip07';
+import { utf8Encode } from './utils';
+
+// NIP-98: HTTP Auth
+// Each request carries a freshly signed kind 27235 event bound to its URL,
+// method and body, so a leaked header cannot be reused for anything else.
+
+export const HTTP_AUTH_KIND = 27235;
+
+function absoluteUrl(url: string): string {
+  if (/^https?:\/\//.test(url)) return url;
+  return `${window.location.origin}${url}`;
+}
+
+export async function createNip98Header(pubkey: string, url: string, method: string, body?: string): Promise<string> {
+  const tags = [
+    ['u', absoluteUrl(url)],
+    ['method', method.toUpperCase()],
+  ];
+  if (body) {
+    tags.push(['payload', bytesToHex(sha256(utf8Encode(body)))]);
+  }
+
+  const signed = await signWithExtension(createEvent(HTTP_AUTH_KIND, '', tags, pubkey));
+  return `Nostr ${btoa(JSON.stringify(signed))}`;
+}
+
+// Admin tokens are either a hex Nostr pubkey (signed per request) or the stream key (basic auth).
+export function isPubkeyToken(token: string): boolean {
+  return /^[0-9a-f]{64}$/.test(token);
+}
+
+export async function adminAuthHeader(token: string, url: string, method: string, body?: string): Promise<string> {
+  if (isPubkeyToken(token)) {
+    return createNip98Header(token, url, method, body);
+  }
+  return 'Basic ' + btoa('admin:' + token);
+}
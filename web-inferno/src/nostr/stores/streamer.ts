@@ -1,6 +1,8 @@
+import { createNip98Header } from '../nip98';
+
 // Streamer Detection Store
 // Checks if the current Nostr-authenticated user is the stream admin.
-// Uses the same auth check as the admin panel: Bearer ${pubkey} → /api/admin/serverconfig
+// Uses the same auth check as the admin panel: a NIP-98 signed request → /api/admin/serverconfig
 
 type Listener = () => void;
 
@@ -52,7 +54,7 @@ export async function checkStreamer(pubkey: string | null): Promise<boolean> {
 
   try {
     const res = await fetch('/api/admin/serverconfig', {
-      headers: { Authorization: `Bearer ${pubkey}` },
+      headers: { Authorization: await createNip98Header(pubkey, '/api/admin/serverconfig', 'GET') },
     });
     const isStreamer = res.ok;
     state = { isStreamer, isChecking: false, checkedPubkey: pubkey };
@@ -2,10 +2,15 @@ import type { ServerStatus, ClientConfig, ChatMessage, CurrentUser, SocketEvent,
 import { MessageType } from './types';
 import { WebSocketService } from './websocket';
 import { api } from './api';
+import { createEvent } from './nostr/event';
+import { signWithExtension } from './nostr/nip07';
 import { getLocalStorage, setLocalStorage, removeLocalStorage, STORAGE_KEYS } from './utils';
 
 type Listener = () => void;
 
+// NIP-42 client authentication event kind, used to answer the server's challenge.
+const NOSTR_CLIENT_AUTH_KIND = 22242;
+
 export interface AppState {
   loading: boolean;
   config: ClientConfig | null;
@@ -75,6 +80,18 @@ function setupWebSocket() {
     notify();
   });
 
+  ws.on(MessageType.NOSTR_CHAT, (event) => {
+    const msg = event as ChatMessage;
+    state.messages = [...state.messages, msg];
+    notify();
+  });
+
+  ws.on(MessageType.ZAP, (event) => {
+    const msg = event as ChatMessage;
+    state.messages = [...state.messages, msg];
+    notify();
+  });
+
   ws.on(MessageType.NAME_CHANGE, (event) => {
     const msg = event as ChatMessage;
     state.messages = [...state.messages, msg];
@@ -125,6 +142,8 @@ function setupWebSocket() {
         displayName: info.user.displayName,
         displayColor: info.user.displayColor,
         isModerator: info.user.isModerator,
+        avatar: info.user.avatar,
+        suggestedDisplayName: info.suggestedDisplayName,
       };
       notify();
     }
@@ -250,12 +269,73 @@ export const store = {
     }
   },
 
+  // Link the signed-in Nostr pubkey to the chat user. A pubkey that was
+  // already linked on another device takes over that existing chat identity.
+  async linkNostrIdentity(pubkey: string) {
+    if (!state.accessToken) return;
+
+    // The event names this server, so it can't be used to log in elsewhere.
+    const { challenge, relay } = await api.startNostrAuth(state.accessToken);
+    const tags = [['challenge', challenge], ['relay', relay || window.location.origin]];
+    const event = await signWithExtension(createEvent(NOSTR_CLIENT_AUTH_KIND, '', tags, pubkey));
+    await api.verifyNostrAuth(state.accessToken, event);
+
+    // Reconnect so the socket picks up the (possibly different) chat user.
+    if (ws) {
+      ws.disconnect();
+    }
+    setupWebSocket();
+  },
+
+  // Ask for a NIP-05 identifier on this server for the linked Nostr pubkey,
+  // or give it up. Returns the identifier when one was granted.
+  async setNIP05(enabled: boolean, name?: string): Promise<string | null> {
+    if (!state.accessToken) return null;
+
+    const res = await api.setNostrNIP05(state.accessToken, enabled, name);
+    if (res.success === false) {
+      throw new Error(res.message || 'Unable to set the NIP-05 identifier');
+    }
+    return res.identifier ?? null;
+  },
+
+  // Subscribe the pubkey to a direct message when the stream goes live, or
+  // unsubscribe it.
+  async setNostrNotifications(pubkey: string, enabled: boolean) {
+    if (!state.accessToken) return;
+
+    const res = enabled
+      ? await api.registerNotification(state.accessToken, 'NOSTR_DIRECT_MESSAGE', pubkey)
+      : await api.unregisterNotification(state.accessToken, 'NOSTR_DIRECT_MESSAGE', pubkey);
+    if (res && res.success === false) {
+      throw new Error(res.message || 'Unable to update notifications');
+    }
+  },
+
   sendChat(body: string) {
     if (ws) {
       ws.sendChat(body);
     }
   },
 
+  // Take the name from the linked Nostr profile as the chat display name.
+  acceptSuggestedDisplayName() {
+    const name = state.currentUser?.suggestedDisplayName;
+    if (!ws || !state.currentUser || !name) return;
+
+    ws.sendNameChange(name);
+    state.currentUser = { ...state.currentUser, displayName: name, suggestedDisplayName: undefined };
+    setLocalStorage(STORAGE_KEYS.displayName, name);
+    notify();
+  },
+
+  dismissSuggestedDisplayName() {
+    if (!state.currentUser) return;
+
+    state.currentUser = { ...state.currentUser, suggestedDisplayName: undefined };
+    notify();
+  },
+
   toggleChat() {
     state.chatVisible = !state.chatVisible;
     notify();
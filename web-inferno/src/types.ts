@@ -58,6 +58,7 @@ interface Federation {
 
 interface NotificationConfig {
   browser: { enabled: boolean; publicKey: string };
+  nostr?: { enabled: boolean };
 }
 
 // Chat message types
@@ -74,6 +75,8 @@ export enum MessageType {
   FEDIVERSE_ENGAGEMENT_FOLLOW = "FEDIVERSE_ENGAGEMENT_FOLLOW",
   FEDIVERSE_ENGAGEMENT_LIKE = "FEDIVERSE_ENGAGEMENT_LIKE",
   FEDIVERSE_ENGAGEMENT_REPOST = "FEDIVERSE_ENGAGEMENT_REPOST",
+  NOSTR_CHAT = "NOSTR_CHAT",
+  ZAP = "ZAP",
   CONNECTED_USER_INFO = "CONNECTED_USER_INFO",
   ERROR_USER_DISABLED = "ERROR_USER_DISABLED",
   ERROR_NEEDS_REGISTRATION = "ERROR_NEEDS_REGISTRATION",
@@ -95,6 +98,9 @@ export interface ChatMessage extends SocketEvent {
   title?: string;
   image?: string;
   link?: string;
+  // Zap fields, the amount is in sats
+  amount?: number;
+  npub?: string;
 }
 
 export interface ChatUser {
@@ -107,6 +113,13 @@ export interface ChatUser {
   isBot?: boolean;
   authenticated?: boolean;
   isModerator?: boolean;
+  // Set for messages sent from Nostr clients
+  pubkey?: string;
+  npub?: string;
+  // From the linked Nostr profile
+  avatar?: string;
+  nip05?: string;
+  nip05Verified?: boolean;
 }
 
 export interface CurrentUser {
@@ -114,6 +127,9 @@ export interface CurrentUser {
   displayName: string;
   displayColor: number;
   isModerator?: boolean;
+  avatar?: string;
+  // Name from the linked Nostr profile offered as the display name
+  suggestedDisplayName?: string;
 }
 
 export interface UserRegistrationResponse {
@@ -146,6 +162,14 @@ export interface AdminConfig {
   ffmpegPath: string;
   webServerPort: number;
   rtmpServerPort: number;
+  srtServerPort: number;
+  webRTCServerPort: number;
+  rtmps: RTMPSConfig;
+  broadcasterTransport: '' | 'rtmp' | 'rtmps' | 'srt' | 'whip';
+  rtmpTakeover: RTMPTakeoverConfig;
+  restreamDestinations: RestreamDestination[];
+  publishCallback: PublishCallbackConfig;
+  reconnectGracePeriod: number;
   streamKey: string;
   chatDisabled: boolean;
   chatJoinMessagesEnabled: boolean;
@@ -168,6 +192,79 @@ export interface VideoSettings {
   latencyLevel: number;
 }
 
+export type RTMPTakeoverPolicy = 'reject' | 'samekey' | 'idle';
+
+export interface RTMPSConfig {
+  enabled: boolean;
+  port: number;
+  certificatePath: string;
+  keyPath: string;
+}
+
+export interface RTMPTakeoverConfig {
+  policy: RTMPTakeoverPolicy;
+  idleSeconds: number;
+}
+
+export interface RestreamDestination {
+  id: string;
+  name: string;
+  url: string;
+  streamKey: string;
+  enabled: boolean;
+}
+
+export interface PublishCallbackConfig {
+  enabled: boolean;
+  url: string;
+  cacheSeconds: number;
+  failOpen: boolean;
+}
+
+export interface StreamKey {
+  key: string;
+  comment: string;
+  label?: string;
+  notBefore?: string;
+  expiresAt?: string;
+  lastUsedAt?: string;
+  lastUsedAddress?: string;
+  latencyLevel?: number;
+  outputVariants?: VideoVariant[];
+}
+
+export interface InboundStreamHealth {
+  startedAt: string;
+  bitrateKbps: number;
+  keyframeIntervalSeconds: number;
+  avDriftMilliseconds: number;
+  timestampJumps: number;
+  droppedFrames: number;
+  latePackets: number;
+}
+
+export interface BroadcastHistoryEntry {
+  id: string;
+  streamKeyLabel: string;
+  streamKeyHint?: string;
+  protocol: string;
+  remoteAddr: string;
+  startedAt: string;
+  endedAt?: string;
+}
+
+export interface RestreamStatus {
+  id: string;
+  name: string;
+  url: string;
+  state: 'connecting' | 'connected' | 'reconnecting';
+  connectedAt?: string;
+  lastError?: string;
+  bitrate: number;
+  droppedPackets: number;
+  reconnects: number;
+}
+
 export interface VideoVariant {
   videoPassthrough: boolean;
   audioPassthrough: boolean;
@@ -201,6 +298,7 @@ interface AdminFederation {
 interface AdminNotifications {
   discord: { enabled: boolean; webhook: string; goLiveMessage: string };
   browser: { enabled: boolean; goLiveMessage: string };
+  nostr?: { enabled: boolean; goLiveMessage: string };
 }
 
 // Hardware stats from GET /api/admin/status
@@ -229,3 +327,58 @@ interface DiskInfo {
   free: number;
   percent: number;
 }
+
+export type AdminRole = 'owner' | 'admin' | 'moderator' | 'integration';
+
+export interface AdminAccess {
+  pubkey?: string;
+  role: AdminRole;
+}
+
+export interface AdminPubkey {
+  pubkey: string;
+  role: AdminRole;
+  label?: string;
+  addedBy?: string;
+  primary?: boolean;
+  createdAt: string;
+}
+
+export interface AdminAuditEntry {
+  id: number;
+  timestamp: string;
+  pubkey?: string;
+  role: AdminRole;
+  method: string;
+  path: string;
+  status: number;
+}
+
+export interface Recording {
+  id: string;
+  liveActivity?: string;
+  title: string;
+  summary?: string;
+  playlistUrl?: string;
+  videoUrl?: string;
+  thumbnailUrl?: string;
+  width?: number;
+  height?: number;
+  eventId?: string;
+  legacyEventId?: string;
+  eventKind?: number;
+  startedAt: string;
+  endedAt: string;
+  publishedAt?: string;
+}
+
+export interface RecordingUpdate {
+  id: string;
+  title?: string;
+  summary?: string;
+  playlistUrl?: string;
+  videoUrl?: string;
+  thumbnailUrl?: string;
+  width?: number;
+  height?: number;
+}
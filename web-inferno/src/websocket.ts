@@ -99,6 +99,10 @@ export class WebSocketService {
     this.send(JSON.stringify({ type: MessageType.CHAT, body }));
   }
 
+  sendNameChange(newName: string) {
+    this.send(JSON.stringify({ type: MessageType.NAME_CHANGE, newName }));
+  }
+
   isConnected(): boolean {
     return this.connected;
   }
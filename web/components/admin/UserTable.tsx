@@ -1,4 +1,4 @@
-import { Table } from 'antd';
+import { Table, Tag, Tooltip } from 'antd';
 import { format } from 'date-fns';
 import { SortOrder } from 'antd/lib/table/interface';
 import { FC } from 'react';
@@ -17,9 +17,24 @@ export function formatDisplayDate(date: string | Date) {
 
 export type UserTableProps = {
   data: User[];
+  showBanSource?: boolean;
 };
 
-export const UserTable: FC<UserTableProps> = ({ data }) => {
+const BanSource: FC<{ user: User }> = ({ user }) => {
+  const { nostrMute } = user;
+  if (!nostrMute) {
+    return <Tag>Manual</Tag>;
+  }
+
+  const entry = nostrMute.type === 'word' ? `muted word "${nostrMute.value}"` : 'muted pubkey';
+  return (
+    <Tooltip title={`Disabled for the ${entry}. Remove it from your mute list to re-enable.`}>
+      <Tag color="purple">Nostr mute list</Tag>
+    </Tooltip>
+  );
+};
+
+export const UserTable: FC<UserTableProps> = ({ data, showBanSource }) => {
   const columns = [
     {
       title: 'Last Known Display Name',
@@ -50,6 +65,20 @@ export const UserTable: FC<UserTableProps> = ({ data }) => {
         new Date(a.disabledAt).getTime() - new Date(b.disabledAt).getTime(),
       sortDirections: ['descend', 'ascend'] as SortOrder[],
     },
+    ...(showBanSource
+      ? [
+          {
+            title: 'Source',
+            key: 'source',
+            render: (_, user: User) => <BanSource user={user} />,
+            filters: [
+              { text: 'Manual', value: 'manual' },
+              { text: 'Nostr mute list', value: 'nostr' },
+            ],
+            onFilter: (value, user: User) => (value === 'nostr') === !!user.nostrMute,
+          },
+        ]
+      : []),
     {
       title: '',
       key: 'block',
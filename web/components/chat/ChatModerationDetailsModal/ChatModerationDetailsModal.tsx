@@ -24,10 +24,20 @@ export type ChatModerationDetailsModalProps = {
 
 export interface UserDetails {
   user: User;
+  nostrProfile?: NostrProfile;
   connectedClients: Client[];
   messages: Message[];
 }
 
+export interface NostrProfile {
+  pubkey: string;
+  name?: string;
+  picture?: string;
+  nip05?: string;
+  nip05Verified?: boolean;
+  fetchedAt: Date;
+}
+
 export interface Client {
   messageCount: number;
   userAgent: string;
@@ -52,6 +62,9 @@ export interface User {
   scopes: string[];
   isBot: boolean;
   authenticated: boolean;
+  avatar?: string;
+  nip05?: string;
+  nip05Verified?: boolean;
 }
 
 const removeMessage = async (messageId: string, accessToken: string) => {
@@ -118,8 +131,8 @@ export const ChatModerationDetailsModal: FC<ChatModerationDetailsModalProps> = (
     return null;
   }
 
-  const { user, connectedClients, messages } = userDetails;
-  const { displayColor, createdAt, previousNames, scopes, isBot, authenticated } = user;
+  const { user, nostrProfile, connectedClients, messages } = userDetails;
+  const { displayColor, createdAt, previousNames, scopes, isBot, authenticated, avatar } = user;
 
   const totalMessagesSent = connectedClients.reduce((acc, client) => acc + client.messageCount, 0);
   const createdAtDate = format(new Date(createdAt), 'PP pp');
@@ -161,13 +174,33 @@ export const ChatModerationDetailsModal: FC<ChatModerationDetailsModalProps> = (
       )}
     >
       <Spin spinning={loading}>
+        {avatar && (
+          <img className={styles.avatar} src={avatar} alt="" referrerPolicy="no-referrer" />
+        )}
         <UserColorBlock color={displayColor} />
         {scopes?.map(scope => <Tag key={scope}>{scope}</Tag>)}
         {authenticated && <Tag>Authenticated</Tag>}
         {isBot && <Tag>Bot</Tag>}
+        {nostrProfile?.nip05 &&
+          (nostrProfile.nip05Verified ? (
+            <Tag color="green">NIP-05 verified</Tag>
+          ) : (
+            <Tag color="orange">NIP-05 unverified</Tag>
+          ))}
         <ValueRow label="Messages Sent Across Clients" value={totalMessagesSent.toString()} />
         <ValueRow label="User Created" value={createdAtDate} />
         <ValueRow label="Known As" value={previousNames.join(',')} />
+        {nostrProfile && (
+          <>
+            <ValueRow label="Nostr Pubkey" value={nostrProfile.pubkey} />
+            {nostrProfile.name && <ValueRow label="Nostr Name" value={nostrProfile.name} />}
+            {nostrProfile.nip05 && <ValueRow label="NIP-05" value={nostrProfile.nip05} />}
+            <ValueRow
+              label="Profile Synced"
+              value={format(new Date(nostrProfile.fetchedAt), 'PP pp')}
+            />
+          </>
+        )}
         <Collapse accordion>
           <Panel header="Currently Connected Clients" key="connected-clients">
             <Collapse accordion>
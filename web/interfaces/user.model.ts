@@ -10,6 +10,9 @@ export class User {
     this.scopes = u.scopes;
     this.authenticated = u.authenticated;
     this.isBot = u.isBot;
+    this.avatar = u.avatar;
+    this.nip05 = u.nip05;
+    this.nip05Verified = u.nip05Verified;
 
     if (this.scopes && this.scopes.length > 0) {
       this.isModerator = this.scopes.includes('MODERATOR');
@@ -35,4 +38,10 @@ export class User {
   isBot: boolean;
 
   isModerator: boolean;
+
+  avatar?: string;
+
+  nip05?: string;
+
+  nip05Verified?: boolean;
 }
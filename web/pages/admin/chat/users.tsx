@@ -105,7 +105,7 @@ export default function ChatUsers() {
       {t('Banned Users')} ({disabledUsers.length})
     </span>
   );
-  const bannedUsersTable = <UserTable data={disabledUsers} />;
+  const bannedUsersTable = <UserTable data={disabledUsers} showBanSource />;
 
   const bannedIPTabTitle = (
     <span>
@@ -4,6 +4,7 @@ import Link from 'next/link';
 
 import { DiscordNotify as Discord } from '../../components/admin/notification/discord';
 import { BrowserNotify as Browser } from '../../components/admin/notification/browser';
+import { NostrNotify as Nostr } from '../../components/admin/notification/nostr';
 import { FediverseNotify as Federation } from '../../components/admin/notification/federation';
 import {
   TextFieldWithSubmit,
@@ -107,6 +108,14 @@ export default function ConfigNotify() {
           <Discord />
         </Col>
 
+        <Col
+          span={10}
+          className={`form-module ${enabled ? '' : 'disabled'}`}
+          style={{ margin: '5px', display: 'flex', flexDirection: 'column' }}
+        >
+          <Nostr />
+        </Col>
+
         <Col
           span={10}
           className={`form-module ${enabled ? '' : 'disabled'}`}
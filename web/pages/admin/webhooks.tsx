@@ -51,6 +51,21 @@ const availableEvents = {
     description: 'When a stream title is changed',
     color: 'yellow',
   },
+  ZAP: {
+    name: 'Zap received',
+    description: 'When someone zaps the stream on Nostr',
+    color: 'gold',
+  },
+  RESTREAM_CONNECTED: {
+    name: 'Restream connected',
+    description: 'When the stream starts being forwarded to a restream destination',
+    color: 'cyan',
+  },
+  RESTREAM_DISCONNECTED: {
+    name: 'Restream disconnected',
+    description: 'When forwarding the stream to a restream destination stops or fails',
+    color: 'volcano',
+  },
 };
 
 function convertEventStringToTag(eventString: string) {
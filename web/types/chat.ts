@@ -17,6 +17,14 @@ export interface User {
   previousNames: [string];
   nameChangedAt: Date;
   scopes?: [string];
+  nostrMute?: NostrMute;
+}
+
+// Set when the user was disabled by the admin's Nostr mute list.
+export interface NostrMute {
+  type: 'pubkey' | 'word';
+  value: string;
+  createdAt: Date;
 }
 
 export interface UsernameHistory {
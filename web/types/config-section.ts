@@ -105,6 +105,11 @@ export interface Federation {
   blockedDomains: string[];
 }
 
+export interface NostrNotification {
+  enabled: boolean;
+  goLiveMessage: string;
+}
+
 export interface BrowserNotification {
   enabled: boolean;
   goLiveMessage: string;
@@ -119,6 +124,7 @@ export interface DiscordNotification {
 export interface NotificationsConfig {
   browser: BrowserNotification;
   discord: DiscordNotification;
+  nostr: NostrNotification;
 }
 
 export interface Health {
@@ -604,6 +604,16 @@ export const BROWSER_PUSH_CONFIG_FIELDS = {
   },
 };
 
+export const NOSTR_NOTIFICATION_CONFIG_FIELDS = {
+  goLiveMessage: {
+    fieldName: 'goLiveMessage',
+    label: 'Go Live Text',
+    maxLength: 200,
+    tip: 'The direct message to send when you go live.',
+    placeholder: `I've gone live! Come watch!`,
+  },
+};
+
 export const PASSWORD_COMPLEXITY_RULES = [
   { min: 8, message: '- minimum 8 characters' },
   { max: 192, message: '- maximum 192 characters' },
@@ -62,6 +62,7 @@ const initialServerConfigState: ConfigDetails = {
   notifications: {
     browser: { enabled: false, goLiveMessage: '' },
     discord: { enabled: false, webhook: '', goLiveMessage: '' },
+    nostr: { enabled: false, goLiveMessage: '' },
   },
   externalActions: [],
   supportedCodecs: [],
@@ -3,18 +3,19 @@ package handlers
 import (
 	"net/http"
 
-	"github.com/TekkadanPlays/oni/core/rtmp"
+	"github.com/TekkadanPlays/oni/core"
+	"github.com/TekkadanPlays/oni/models"
 	"github.com/TekkadanPlays/oni/webserver/handlers/admin"
 	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
 	"github.com/TekkadanPlays/oni/webserver/router/middleware"
 )
 
 func (*ServerInterfaceImpl) StatusAdmin(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.Status)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.Status)(w, r)
 }
 
 func (*ServerInterfaceImpl) StatusAdminOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.Status)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.Status)(w, r)
 }
 
 func (*ServerInterfaceImpl) DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
@@ -34,19 +35,19 @@ func (*ServerInterfaceImpl) GetServerConfigOptions(w http.ResponseWriter, r *htt
 }
 
 func (*ServerInterfaceImpl) GetViewersOverTime(w http.ResponseWriter, r *http.Request, params generated.GetViewersOverTimeParams) {
-	middleware.RequireAdminAuth(admin.GetViewersOverTime)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetViewersOverTime)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetViewersOverTime)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetViewersOverTime)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetActiveViewers(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetActiveViewers)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetActiveViewers)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetActiveViewersOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetActiveViewers)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetActiveViewers)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetHardwareStats(w http.ResponseWriter, r *http.Request) {
@@ -57,68 +58,204 @@ func (*ServerInterfaceImpl) GetHardwareStatsOptions(w http.ResponseWriter, r *ht
 	middleware.RequireAdminAuth(admin.GetHardwareStats)(w, r)
 }
 
+func (*ServerInterfaceImpl) BanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.BanNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) BanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.BanNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) UnbanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UnbanNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) UnbanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UnbanNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrPubkeyBans(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetNostrPubkeyBans)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrPubkeyBansOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetNostrPubkeyBans)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetNostrIdentity)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetNostrIdentity)(w, r)
+}
+
+func (*ServerInterfaceImpl) ImportNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.ImportNostrIdentity)(w, r)
+}
+
+func (*ServerInterfaceImpl) ImportNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.ImportNostrIdentity)(w, r)
+}
+
+func (*ServerInterfaceImpl) RotateNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.RotateNostrIdentity)(w, r)
+}
+
+func (*ServerInterfaceImpl) RotateNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.RotateNostrIdentity)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrRelayHealth(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetNostrRelayHealth)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrRelayHealthOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetNostrRelayHealth)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrZapTotals(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetNostrZapTotals)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrZapTotalsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetNostrZapTotals)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetBroadcastHistory(w http.ResponseWriter, r *http.Request, params generated.GetBroadcastHistoryParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetBroadcastHistory))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetBroadcastHistoryOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetBroadcastHistory))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrRecordings(w http.ResponseWriter, r *http.Request, params generated.GetNostrRecordingsParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetNostrRecordings))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetNostrRecordingsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetNostrRecordings))(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateNostrRecording(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateNostrRecording)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateNostrRecordingOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateNostrRecording)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteNostrRecordingAnnouncement(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteNostrRecordingAnnouncement)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteNostrRecordingAnnouncementOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteNostrRecordingAnnouncement)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminPubkeys(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetAdminPubkeys)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminPubkeysOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetAdminPubkeys)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetAdminPubkeyRole(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetAdminPubkeyRole)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetAdminPubkeyRoleOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetAdminPubkeyRole)(w, r)
+}
+
+func (*ServerInterfaceImpl) RemoveAdminPubkey(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.RemoveAdminPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) RemoveAdminPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.RemoveAdminPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminAccess(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleIntegration, admin.GetAdminAccess)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminAccessOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleIntegration, admin.GetAdminAccess)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminAuditLog(w http.ResponseWriter, r *http.Request, params generated.GetAdminAuditLogParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetAdminAuditLog))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminAuditLogOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetAdminAuditLog))(w, r)
+}
+
 func (*ServerInterfaceImpl) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetConnectedChatClients)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetConnectedChatClients)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetConnectedChatClients)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetConnectedChatClients)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetChatMessagesAdmin(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetChatMessages)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetChatMessages)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetChatMessages)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetChatMessages)(w, r)
 }
 
 func (*ServerInterfaceImpl) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.UpdateMessageVisibility)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UpdateMessageVisibility)(w, r)
 }
 
 func (*ServerInterfaceImpl) UpdateMessageVisibilityAdminOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.UpdateMessageVisibility)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UpdateMessageVisibility)(w, r)
 }
 
 func (*ServerInterfaceImpl) UpdateUserEnabledAdmin(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.UpdateUserEnabled)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UpdateUserEnabled)(w, r)
 }
 
 func (*ServerInterfaceImpl) UpdateUserEnabledAdminOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.UpdateUserEnabled)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UpdateUserEnabled)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetDisabledUsers)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetDisabledUsers)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetDisabledUsersOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetDisabledUsers)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetDisabledUsers)(w, r)
 }
 
 func (*ServerInterfaceImpl) BanIPAddress(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.BanIPAddress)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.BanIPAddress)(w, r)
 }
 
 func (*ServerInterfaceImpl) BanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.BanIPAddress)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.BanIPAddress)(w, r)
 }
 
 func (*ServerInterfaceImpl) UnbanIPAddress(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.UnBanIPAddress)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UnBanIPAddress)(w, r)
 }
 
 func (*ServerInterfaceImpl) UnbanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.UnBanIPAddress)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.UnBanIPAddress)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetIPAddressBans(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetIPAddressBans)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetIPAddressBans)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetIPAddressBansOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetIPAddressBans)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetIPAddressBans)(w, r)
 }
 
 func (*ServerInterfaceImpl) UpdateUserModerator(w http.ResponseWriter, r *http.Request) {
@@ -130,11 +267,11 @@ func (*ServerInterfaceImpl) UpdateUserModeratorOptions(w http.ResponseWriter, r
 }
 
 func (*ServerInterfaceImpl) GetModerators(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetModerators)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetModerators)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetModeratorsOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.GetModerators)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleModerator, admin.GetModerators)(w, r)
 }
 
 func (*ServerInterfaceImpl) GetLogs(w http.ResponseWriter, r *http.Request) {
@@ -289,6 +426,14 @@ func (*ServerInterfaceImpl) GetVideoPlaybackMetricsOptions(w http.ResponseWriter
 	middleware.RequireAdminAuth(admin.GetVideoPlaybackMetrics)(w, r)
 }
 
+func (*ServerInterfaceImpl) GetInboundStreamMetrics(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetInboundStreamMetrics)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetInboundStreamMetricsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetInboundStreamMetrics)(w, r)
+}
+
 func (*ServerInterfaceImpl) SendFederatedMessage(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.SendFederatedMessage)(w, r)
 }
@@ -307,6 +452,6 @@ func (*ServerInterfaceImpl) GetFederatedActionsOptions(w http.ResponseWriter, r
 
 // DisconnectInboundConnection will force-disconnect an inbound stream.
 func DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
-	rtmp.Disconnect()
+	core.DisconnectInboundConnection()
 	w.WriteHeader(http.StatusOK)
 }
@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/adminrepository"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
+	"github.com/TekkadanPlays/oni/webserver/router/middleware"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// The longest label accepted for an admin pubkey.
+const maxAdminPubkeyLabelLength = 64
+
+// GetAdminPubkeys will return the Nostr pubkeys with a role on the admin
+// API, starting with the server's primary admin pubkey.
+func GetAdminPubkeys(w http.ResponseWriter, r *http.Request) {
+	admins, err := adminrepository.Get().GetAdminPubkeys()
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	if primary := configrepository.Get().GetAdminNostrPubkey(); primary != "" {
+		admins = append([]*models.AdminPubkey{{
+			Pubkey:  primary,
+			Role:    models.AdminRoleOwner,
+			Primary: true,
+		}}, admins...)
+	}
+
+	webutils.WriteResponse(w, admins)
+}
+
+// SetAdminPubkeyRole will give a Nostr pubkey a role on the admin API.
+func SetAdminPubkeyRole(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.SetAdminPubkeyRoleJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to parse request")
+		return
+	}
+
+	pubkey, ok := parseAdminPubkeyFromRequest(w, request.Pubkey)
+	if !ok {
+		return
+	}
+
+	role := models.AdminRole("")
+	if request.Role != nil {
+		role = models.AdminRole(*request.Role)
+	}
+	if !role.IsValid() {
+		webutils.WriteSimpleResponse(w, false, "role must be owner, admin, moderator or integration")
+		return
+	}
+
+	label := ""
+	if request.Label != nil {
+		label = utils.MakeSafeStringOfLength(strings.TrimSpace(*request.Label), maxAdminPubkeyLabelLength)
+	}
+
+	if err := adminrepository.Get().SetAdminPubkey(&models.AdminPubkey{
+		Pubkey:    pubkey,
+		Role:      role,
+		Label:     label,
+		AddedBy:   middleware.GetAdminAccess(r).Pubkey,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		log.Errorln("error saving admin pubkey", err)
+		webutils.WriteSimpleResponse(w, false, "error saving admin pubkey")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, pubkey+" is now "+string(role))
+}
+
+// RemoveAdminPubkey will remove the role a Nostr pubkey has on the admin API.
+func RemoveAdminPubkey(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.RemoveAdminPubkeyJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to parse request")
+		return
+	}
+
+	pubkey, ok := parseAdminPubkeyFromRequest(w, request.Pubkey)
+	if !ok {
+		return
+	}
+
+	if err := adminrepository.Get().RemoveAdminPubkey(pubkey); err != nil {
+		webutils.WriteSimpleResponse(w, false, "error removing admin pubkey")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "removed")
+}
+
+// GetAdminAccess will return the pubkey and role the request was made with.
+func GetAdminAccess(w http.ResponseWriter, r *http.Request) {
+	access := middleware.GetAdminAccess(r)
+
+	webutils.WriteResponse(w, map[string]string{
+		"pubkey": access.Pubkey,
+		"role":   string(access.Role),
+	})
+}
+
+// GetAdminAuditLog will return the changes made through the admin API,
+// newest first.
+func GetAdminAuditLog(page int, pageSize int, w http.ResponseWriter, r *http.Request) {
+	offset := pageSize * page
+
+	entries, total, err := adminrepository.Get().GetAuditEntries(pageSize, offset)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: entries,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+// parseAdminPubkeyFromRequest returns the hex pubkey from the request,
+// refusing the primary admin pubkey which is only changed on its own.
+func parseAdminPubkeyFromRequest(w http.ResponseWriter, value *string) (string, bool) {
+	if value == nil {
+		webutils.WriteSimpleResponse(w, false, "pubkey is required")
+		return "", false
+	}
+
+	pubkey, err := nostr.ParsePublicKey(*value)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, "pubkey must be an npub or hex pubkey")
+		return "", false
+	}
+
+	if strings.EqualFold(pubkey, configrepository.Get().GetAdminNostrPubkey()) {
+		webutils.WriteSimpleResponse(w, false, "the primary admin pubkey is always an owner")
+		return "", false
+	}
+
+	return pubkey, true
+}
@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/TekkadanPlays/oni/persistence/broadcastrepository"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+)
+
+// GetBroadcastHistory will return which stream key started each broadcast,
+// newest first.
+func GetBroadcastHistory(page int, pageSize int, w http.ResponseWriter, r *http.Request) {
+	offset := pageSize * page
+
+	entries, total, err := broadcastrepository.Get().GetBroadcasts(pageSize, offset)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: entries,
+	}
+
+	webutils.WriteResponse(w, response)
+}
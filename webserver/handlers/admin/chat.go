@@ -114,6 +114,61 @@ func GetIPAddressBans(w http.ResponseWriter, r *http.Request) {
 	webutils.WriteResponse(w, bans)
 }
 
+// BanNostrPubkey will ban a Nostr pubkey from sending chat messages.
+func BanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	pubkey, ok := parseNostrPubkey(configValue.Value)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "value must be an npub or hex pubkey")
+		return
+	}
+
+	if err := chat.BanNostrPubkey(pubkey); err != nil {
+		webutils.WriteSimpleResponse(w, false, "error saving Nostr pubkey ban")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "Nostr pubkey banned")
+}
+
+// UnbanNostrPubkey will remove a Nostr pubkey ban.
+func UnbanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	pubkey, ok := parseNostrPubkey(configValue.Value)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "value must be an npub or hex pubkey")
+		return
+	}
+
+	if err := chat.UnbanNostrPubkey(pubkey); err != nil {
+		webutils.WriteSimpleResponse(w, false, "error removing Nostr pubkey ban")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "Nostr pubkey unbanned")
+}
+
+// GetNostrPubkeyBans will return all the Nostr pubkeys banned from chat.
+func GetNostrPubkeyBans(w http.ResponseWriter, r *http.Request) {
+	webutils.WriteResponse(w, configrepository.Get().GetNostrBannedPubkeys())
+}
+
 // UpdateUserEnabled enable or disable a single user by ID.
 func UpdateUserEnabled(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -135,13 +190,18 @@ func UpdateUserEnabled(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := updateUserStatus(request); err != nil {
+	if err := chat.SetUserEnabled(*request.UserId, *request.Enabled); err != nil {
 		webutils.WriteSimpleResponse(w, false, err.Error())
 		return
 	}
 
+	// A moderator's decision replaces one made by the Nostr mute list.
+	if err := userrepository.Get().RemoveNostrMute(*request.UserId); err != nil {
+		log.Errorln("error removing nostr mute", err)
+	}
+
 	if !*request.Enabled {
-		if err := handleUserDisabling(*request.UserId); err != nil {
+		if _, err := chat.RemoveDisabledUser(*request.UserId); err != nil {
 			webutils.WriteSimpleResponse(w, false, err.Error())
 			return
 		}
@@ -150,61 +210,6 @@ func UpdateUserEnabled(w http.ResponseWriter, r *http.Request) {
 	webutils.WriteSimpleResponse(w, true, fmt.Sprintf("%s enabled: %t", *request.UserId, *request.Enabled))
 }
 
-func updateUserStatus(request generated.UpdateUserEnabledJSONBody) error {
-	userRepository := userrepository.Get()
-	chatMessageRepository := chatmessagerepository.Get()
-
-	if err := userRepository.SetEnabled(*request.UserId, *request.Enabled); err != nil {
-		log.Errorln("error changing user enabled status", err)
-		return err
-	}
-
-	messageIDs, err := chatMessageRepository.GetMessageIdsForUserID(*request.UserId)
-	if err != nil {
-		return errors.Wrap(err, "error fetching user messages")
-	}
-
-	if !*request.Enabled && len(messageIDs) > 0 {
-		if err := chat.SetMessagesVisibility(messageIDs, *request.Enabled); err != nil {
-			log.Errorln("error changing user messages visibility", err)
-			return err
-		}
-	}
-	return nil
-}
-
-func handleUserDisabling(userID string) error {
-	clients, err := chat.GetClientsForUser(userID)
-	if len(clients) == 0 {
-		return nil
-	}
-
-	if err != nil {
-		log.Errorln("error fetching clients for user: ", err)
-		return err
-	}
-
-	chat.DisconnectClients(clients)
-	userRepository := userrepository.Get()
-	disconnectedUser := userRepository.GetUserByID(userID)
-	_ = chat.SendSystemAction(fmt.Sprintf("**%s** has been removed from chat.", disconnectedUser.DisplayName), true)
-
-	localIP4Address := "127.0.0.1"
-	localIP6Address := "::1"
-
-	authRepository := authrepository.Get()
-	for _, client := range clients {
-		ipAddress := client.IPAddress
-		if ipAddress != localIP4Address && ipAddress != localIP6Address {
-			reason := fmt.Sprintf("Banning of %s", disconnectedUser.DisplayName)
-			if err := authRepository.BanIPAddress(ipAddress, reason); err != nil {
-				log.Errorln("error banning IP address: ", err)
-			}
-		}
-	}
-	return nil
-}
-
 // GetDisabledUsers will return all the disabled users.
 func GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
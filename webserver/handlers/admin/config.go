@@ -1,21 +1,30 @@
 package admin
 
 import (
+	"crypto/subtle"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"net/netip"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/TekkadanPlays/oni/activitypub/outbox"
+	"github.com/TekkadanPlays/oni/config"
 	"github.com/TekkadanPlays/oni/core"
 	"github.com/TekkadanPlays/oni/core/chat"
+	"github.com/TekkadanPlays/oni/core/ingest"
+	"github.com/TekkadanPlays/oni/core/restream"
 	"github.com/TekkadanPlays/oni/core/webhooks"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/live"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/utils"
 	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
@@ -24,6 +33,9 @@ import (
 	"github.com/teris-io/shortid"
 )
 
+// The longest a broadcast may wait for the broadcaster to reconnect.
+const maxReconnectGracePeriod = 300
+
 // ConfigValue is a container object that holds a value, is encoded, and saved to the database.
 type ConfigValue struct {
 	Value interface{} `json:"value"`
@@ -82,6 +94,7 @@ func SetStreamTitle(w http.ResponseWriter, r *http.Request) {
 		sendSystemChatAction(fmt.Sprintf("Stream title changed to **%s**", value), true)
 		go webhooks.SendStreamStatusEvent(models.StreamTitleUpdated)
 	}
+	live.StreamUpdated()
 	webutils.WriteSimpleResponse(w, true, "changed")
 }
 
@@ -234,26 +247,94 @@ func SetAdminPassword(w http.ResponseWriter, r *http.Request) {
 	webutils.WriteSimpleResponse(w, true, "changed")
 }
 
+// adminNostrPubkeyLock prevents two bootstrap requests racing to claim the admin pubkey.
+var adminNostrPubkeyLock sync.Mutex
+
 // SetAdminNostrPubkey will set the hex Nostr pubkey authorized for admin access.
 func SetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
 	if !requirePOST(w, r) {
 		return
 	}
 
-	configValue, success := getValueFromRequest(w, r)
-	if !success {
+	pubkey, _, ok := getAdminNostrPubkeyFromRequest(w, r)
+	if !ok {
 		return
 	}
 
+	adminNostrPubkeyLock.Lock()
+	defer adminNostrPubkeyLock.Unlock()
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetAdminNostrPubkey(pubkey); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	// An admin has been configured so the bootstrap token is no longer valid.
+	config.AdminSetupToken = ""
+
+	// Moderate chat with the new admin's mute list.
+	go chat.StartNostrMuteListSync()
+
+	webutils.WriteSimpleResponse(w, true, "changed")
+}
+
+// BootstrapAdminNostrPubkey will set the first admin Nostr pubkey when the
+// one-time setup token printed at startup is provided.
+func BootstrapAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	pubkey, token, ok := getAdminNostrPubkeyFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	adminNostrPubkeyLock.Lock()
+	defer adminNostrPubkeyLock.Unlock()
+
 	configRepository := configrepository.Get()
-	if err := configRepository.SetAdminNostrPubkey(configValue.Value.(string)); err != nil {
+	setupToken := config.AdminSetupToken
+	if configRepository.GetAdminNostrPubkey() != "" || setupToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(setupToken)) != 1 {
+		log.Warnln("Rejected attempt to claim the admin Nostr pubkey from", utils.GetIPAddressFromRequest(r))
+		http.Error(w, "invalid setup token", http.StatusUnauthorized)
+		return
+	}
+
+	if err := configRepository.SetAdminNostrPubkey(pubkey); err != nil {
 		webutils.WriteSimpleResponse(w, false, err.Error())
 		return
 	}
 
+	config.AdminSetupToken = ""
+	log.Infoln("Admin Nostr pubkey has been claimed using the setup token:", pubkey)
+
+	go chat.StartNostrMuteListSync()
+
 	webutils.WriteSimpleResponse(w, true, "changed")
 }
 
+func getAdminNostrPubkeyFromRequest(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	var request generated.SetAdminNostrPubkeyJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to parse new value")
+		return "", "", false
+	}
+
+	if request.Value == nil || !nostr.IsValidPublicKey(strings.ToLower(*request.Value)) {
+		webutils.WriteSimpleResponse(w, false, "value must be a valid hex Nostr pubkey")
+		return "", "", false
+	}
+
+	token := ""
+	if request.SetupToken != nil {
+		token = *request.SetupToken
+	}
+
+	return strings.ToLower(*request.Value), token, true
+}
+
 // GetAdminNostrPubkey returns the configured admin Nostr pubkey.
 func GetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
 	configRepository := configrepository.Get()
@@ -433,6 +514,249 @@ func SetRTMPServerPort(w http.ResponseWriter, r *http.Request) {
 	webutils.WriteSimpleResponse(w, true, "rtmp port set")
 }
 
+// SetSRTServerPort will handle the web config request to set the inbound SRT port.
+func SetSRTServerPort(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	port, ok := configValue.Value.(float64)
+	if !ok || port < 1 || port > 65535 {
+		webutils.WriteSimpleResponse(w, false, "srt port must be a number between 1 and 65535")
+		return
+	}
+
+	if err := configrepository.Get().SetSRTPortNumber(port); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "srt port set")
+}
+
+// SetWebRTCServerPort will handle the web config request to set the UDP port
+// WHIP broadcasters send their media to.
+func SetWebRTCServerPort(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	port, ok := configValue.Value.(float64)
+	if !ok || port < 1 || port > 65535 {
+		webutils.WriteSimpleResponse(w, false, "webrtc port must be a number between 1 and 65535")
+		return
+	}
+
+	if err := configrepository.Get().SetWebRTCPortNumber(port); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "webrtc port set")
+}
+
+// SetRTMPSConfig will handle the web config request to configure the RTMPS
+// listener.
+func SetRTMPSConfig(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.RTMPSConfiguration `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update rtmps configuration with provided values")
+		return
+	}
+
+	configRepository := configrepository.Get()
+	if config.Value.Port < 1 || config.Value.Port > 65535 || config.Value.Port == configRepository.GetRTMPPortNumber() {
+		webutils.WriteSimpleResponse(w, false, "rtmps port must be a number between 1 and 65535 that rtmp does not use")
+		return
+	}
+
+	if config.Value.Enabled {
+		if _, err := tls.LoadX509KeyPair(config.Value.CertificatePath, config.Value.KeyPath); err != nil {
+			webutils.WriteSimpleResponse(w, false, "unable to load the rtmps certificate: "+err.Error())
+			return
+		}
+	}
+
+	if err := configRepository.SetRTMPSConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "rtmps configuration set")
+}
+
+// SetRTMPTakeover will handle the web config request to set how a new inbound
+// RTMP connection may take over the running stream.
+func SetRTMPTakeover(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.RTMPTakeoverConfiguration `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update rtmp takeover policy with provided values")
+		return
+	}
+
+	if !config.Value.IsValid() {
+		webutils.WriteSimpleResponse(w, false, "rtmp takeover policy must be reject, samekey or idle with a positive idle time")
+		return
+	}
+
+	if err := configrepository.Get().SetRTMPTakeoverConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "rtmp takeover policy set")
+}
+
+// SetRestreamDestinations will handle the web config request to set the
+// external services the stream is forwarded to.
+func SetRestreamDestinations(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value []models.RestreamDestination `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update restream destinations with provided values")
+		return
+	}
+
+	ids := map[string]bool{}
+	for i := range config.Value {
+		destination := &config.Value[i]
+		destination.Name = strings.TrimSpace(destination.Name)
+		destination.URL = strings.TrimSpace(destination.URL)
+		destination.StreamKey = strings.TrimSpace(destination.StreamKey)
+
+		if destination.Name == "" {
+			webutils.WriteSimpleResponse(w, false, "restream destinations must have a name")
+			return
+		}
+
+		u, err := url.Parse(destination.URL)
+		if err != nil || (u.Scheme != "rtmp" && u.Scheme != "rtmps") || u.Host == "" {
+			webutils.WriteSimpleResponse(w, false, destination.Name+" must have an rtmp:// or rtmps:// url")
+			return
+		}
+
+		if destination.ID == "" {
+			destination.ID = shortid.MustGenerate()
+		}
+		if ids[destination.ID] {
+			webutils.WriteSimpleResponse(w, false, "restream destinations must have unique ids")
+			return
+		}
+		ids[destination.ID] = true
+	}
+
+	if err := configrepository.Get().SetRestreamDestinations(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	restream.Reload()
+
+	webutils.WriteSimpleResponse(w, true, "restream destinations set")
+}
+
+// SetPublishCallback will handle the web config request to set the HTTP
+// callback that decides who may stream.
+func SetPublishCallback(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.PublishCallbackConfiguration `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update publish callback with provided values")
+		return
+	}
+
+	config.Value.URL = strings.TrimSpace(config.Value.URL)
+	if config.Value.Enabled {
+		u, err := url.Parse(config.Value.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			webutils.WriteSimpleResponse(w, false, "publish callback must have an http:// or https:// url")
+			return
+		}
+	}
+
+	if config.Value.CacheSeconds < 0 || config.Value.CacheSeconds > 3600 {
+		webutils.WriteSimpleResponse(w, false, "publish callback cache must be between 0 and 3600 seconds")
+		return
+	}
+
+	if err := configrepository.Get().SetPublishCallbackConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "publish callback set")
+}
+
+// SetReconnectGracePeriod will handle the web config request to set how many
+// seconds a broadcast waits for the broadcaster to reconnect.
+func SetReconnectGracePeriod(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	seconds, ok := configValue.Value.(float64)
+	if !ok || seconds < 0 || seconds > maxReconnectGracePeriod {
+		webutils.WriteSimpleResponse(w, false, fmt.Sprintf("reconnect grace period must be between 0 and %d seconds", maxReconnectGracePeriod))
+		return
+	}
+
+	if err := configrepository.Get().SetReconnectGracePeriod(seconds); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "reconnect grace period set")
+}
+
 // SetServerURL will handle the web config request to set the full server URL.
 func SetServerURL(w http.ResponseWriter, r *http.Request) {
 	if !requirePOST(w, r) {
@@ -962,15 +1286,33 @@ func SetStreamKeys(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	keys := map[string]bool{}
 	for _, streamKey := range *streamKeys.Value {
-		if *streamKey.Key == "" {
+		if streamKey.Key == nil || *streamKey.Key == "" {
 			webutils.WriteSimpleResponse(w, false, "stream key cannot be empty")
 			return
 		}
+
+		if keys[*streamKey.Key] {
+			webutils.WriteSimpleResponse(w, false, "stream keys must be unique")
+			return
+		}
+		keys[*streamKey.Key] = true
+
+		if streamKey.NotBefore != nil && streamKey.ExpiresAt != nil && !streamKey.ExpiresAt.After(*streamKey.NotBefore) {
+			webutils.WriteSimpleResponse(w, false, "stream key must expire after it becomes valid")
+			return
+		}
+
+		if streamKey.LatencyLevel != nil {
+			if _, ok := models.GetLatencyConfigs()[*streamKey.LatencyLevel]; !ok {
+				webutils.WriteSimpleResponse(w, false, "stream key latency level must be between 0 and 4")
+				return
+			}
+		}
 	}
 
-	configRepository := configrepository.Get()
-	if err := configRepository.SetStreamKeys(*streamKeys.Value); err != nil {
+	if err := ingest.SaveStreamKeys(*streamKeys.Value); err != nil {
 		webutils.WriteSimpleResponse(w, false, err.Error())
 		return
 	}
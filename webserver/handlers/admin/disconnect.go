@@ -5,8 +5,6 @@ import (
 
 	"github.com/TekkadanPlays/oni/core"
 	webutils "github.com/TekkadanPlays/oni/webserver/utils"
-
-	"github.com/TekkadanPlays/oni/core/rtmp"
 )
 
 // DisconnectInboundConnection will force-disconnect an inbound stream.
@@ -16,6 +14,6 @@ func DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	rtmp.Disconnect()
+	core.DisconnectInboundConnection()
 	webutils.WriteSimpleResponse(w, true, "inbound stream disconnected")
 }
@@ -0,0 +1,305 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TekkadanPlays/oni/core/chat"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/live"
+	"github.com/TekkadanPlays/oni/nostr/relay"
+	"github.com/TekkadanPlays/oni/nostr/relayserver"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/zaprepository"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+)
+
+type nostrIdentityResponse struct {
+	Pubkey string `json:"pubkey"`
+	Npub   string `json:"npub"`
+}
+
+// GetNostrIdentity returns the public key the server signs Nostr events with.
+func GetNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	webutils.WriteResponse(w, nostrIdentityResponse{
+		Pubkey: identity.PublicKey(),
+		Npub:   identity.Npub(),
+	})
+}
+
+// ImportNostrIdentity will replace the server's Nostr key with an existing one.
+func ImportNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	key, ok := configValue.Value.(string)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "key must be an nsec or hex private key")
+		return
+	}
+
+	if _, err := identity.Import(key); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	live.StreamUpdated()
+	go live.PublishProfile()
+	chat.StartNostrBridge()
+
+	GetNostrIdentity(w, r)
+}
+
+// RotateNostrIdentity will replace the server's Nostr key with a new one.
+func RotateNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	if _, err := identity.Rotate(); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	live.StreamUpdated()
+	go live.PublishProfile()
+	chat.StartNostrBridge()
+
+	GetNostrIdentity(w, r)
+}
+
+// SetNostrRelays will set the relays the server publishes Nostr events to.
+func SetNostrRelays(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	values, ok := configValue.Value.([]interface{})
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "relays must be a list of urls")
+		return
+	}
+
+	relays := make([]string, 0, len(values))
+	for _, value := range values {
+		relay, ok := value.(string)
+		if !ok {
+			webutils.WriteSimpleResponse(w, false, "relays must be a list of urls")
+			return
+		}
+
+		relay = strings.TrimSpace(relay)
+		u, err := url.Parse(relay)
+		if err != nil || (u.Scheme != "ws" && u.Scheme != "wss") || u.Host == "" {
+			webutils.WriteSimpleResponse(w, false, relay+" is not a valid relay url")
+			return
+		}
+
+		relays = append(relays, relay)
+	}
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetNostrRelays(relays); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	relay.Get().SetRelays(relays)
+
+	webutils.WriteSimpleResponse(w, true, "nostr relays saved")
+}
+
+// SetNostrLightningAddress will set the lightning address zaps sent to the
+// stream's own lightning address are forwarded to.
+func SetNostrLightningAddress(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	address, ok := configValue.Value.(string)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "lightning address must look like name@domain")
+		return
+	}
+
+	address = strings.ToLower(strings.TrimSpace(address))
+	if address != "" {
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		params, err := zaps.FetchPayParams(ctx, address)
+		cancel()
+		if err != nil {
+			webutils.WriteSimpleResponse(w, false, err.Error())
+			return
+		}
+		if !params.AllowsNostr || params.NostrPubkey == "" {
+			webutils.WriteSimpleResponse(w, false, address+" does not support Nostr zaps")
+			return
+		}
+	}
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetNostrLightningAddress(address); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	go live.PublishProfile()
+
+	webutils.WriteSimpleResponse(w, true, "lightning address saved")
+}
+
+// SetNostrWalletConnect will connect the streamer's wallet with a
+// nostr+walletconnect:// URI. An empty value disconnects it.
+func SetNostrWalletConnect(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	uri, ok := configValue.Value.(string)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "wallet connect uri must be a string")
+		return
+	}
+
+	if err := zaps.SetWalletConnectURI(strings.TrimSpace(uri)); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	// The stream's lightning address may have appeared or disappeared.
+	go live.PublishProfile()
+
+	if uri == "" {
+		webutils.WriteSimpleResponse(w, true, "wallet disconnected")
+		return
+	}
+	webutils.WriteSimpleResponse(w, true, "wallet connected")
+}
+
+// SetNostrNIP05Names will save the extra NIP-05 names served for the
+// server, each mapped to an npub or hex pubkey.
+func SetNostrNIP05Names(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	values, ok := configValue.Value.(map[string]interface{})
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "names must map each name to a pubkey")
+		return
+	}
+
+	names := map[string]string{}
+	for name, value := range values {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !nostr.IsValidNIP05Name(name) {
+			webutils.WriteSimpleResponse(w, false, name+" is not a valid NIP-05 name")
+			return
+		}
+
+		pubkey, valid := parseNostrPubkey(value)
+		if !valid {
+			webutils.WriteSimpleResponse(w, false, "the pubkey of "+name+" must be an npub or hex pubkey")
+			return
+		}
+		names[name] = pubkey
+	}
+
+	if err := configrepository.Get().SetNostrNIP05Names(names); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "NIP-05 names saved")
+}
+
+// SetNostrRelayEnabled will enable or disable the built-in Nostr relay for
+// the stream.
+func SetNostrRelayEnabled(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	enabled, ok := configValue.Value.(bool)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "value must be a boolean")
+		return
+	}
+
+	if err := configrepository.Get().SetNostrRelayEnabled(enabled); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	if server := relayserver.Get(); server != nil {
+		server.SetEnabled(enabled)
+	}
+
+	// Let clients of the live activity know where to find the relay.
+	go live.StreamUpdated()
+
+	webutils.WriteSimpleResponse(w, true, "changed")
+}
+
+// GetNostrZapTotals returns the total of the zaps sent to each stream.
+func GetNostrZapTotals(w http.ResponseWriter, r *http.Request) {
+	totals, err := zaprepository.Get().GetZapTotals()
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, totals)
+}
+
+// GetNostrRelayHealth returns the connection health of each Nostr relay.
+func GetNostrRelayHealth(w http.ResponseWriter, r *http.Request) {
+	webutils.WriteResponse(w, relay.Get().Health())
+}
+
+// parseNostrPubkey accepts an npub or hex pubkey and returns it as hex.
+func parseNostrPubkey(value interface{}) (string, bool) {
+	pubkey, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+
+	pubkey, err := nostr.ParsePublicKey(pubkey)
+	return pubkey, err == nil
+}
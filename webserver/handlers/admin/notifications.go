@@ -61,3 +61,29 @@ func SetBrowserNotificationConfiguration(w http.ResponseWriter, r *http.Request)
 
 	webutils.WriteSimpleResponse(w, true, "updated browser push config with provided values")
 }
+
+// SetNostrNotificationConfiguration will set the Nostr direct message notification configuration.
+func SetNostrNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.NostrNotificationConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update nostr notification config with provided values")
+		return
+	}
+
+	if err := configRepository.SetNostrNotificationConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update nostr notification config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated nostr notification config with provided values")
+}
@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/TekkadanPlays/oni/nostr/live"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/recordingrepository"
+	"github.com/TekkadanPlays/oni/utils"
+	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// The longest title and summary accepted for a recording.
+const (
+	maxRecordingTitleLength   = 200
+	maxRecordingSummaryLength = 2000
+)
+
+// GetNostrRecordings will return the past broadcasts and their recordings,
+// newest first.
+func GetNostrRecordings(page int, pageSize int, w http.ResponseWriter, r *http.Request) {
+	offset := pageSize * page
+
+	recordings, total, err := recordingrepository.Get().GetRecordings(pageSize, offset)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: recordings,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+// UpdateNostrRecording will update a recording and, once it has a video to
+// play, announce it as a NIP-71 video event.
+func UpdateNostrRecording(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.UpdateNostrRecordingJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to parse request")
+		return
+	}
+
+	recording := recordingrepository.Get().GetRecording(request.Id)
+	if recording == nil {
+		webutils.WriteSimpleResponse(w, false, "recording not found")
+		return
+	}
+
+	if request.Title != nil {
+		recording.Title = utils.MakeSafeStringOfLength(strings.TrimSpace(*request.Title), maxRecordingTitleLength)
+	}
+	if request.Summary != nil {
+		recording.Summary = utils.MakeSafeStringOfLength(strings.TrimSpace(*request.Summary), maxRecordingSummaryLength)
+	}
+
+	urls := []struct {
+		value *string
+		field *string
+		name  string
+	}{
+		{request.PlaylistUrl, &recording.PlaylistURL, "playlist url"},
+		{request.VideoUrl, &recording.VideoURL, "video url"},
+		{request.ThumbnailUrl, &recording.ThumbnailURL, "thumbnail url"},
+	}
+	for _, u := range urls {
+		if u.value == nil {
+			continue
+		}
+		value := strings.TrimSpace(*u.value)
+		if value != "" && !utils.IsValidURL(value) {
+			webutils.WriteSimpleResponse(w, false, u.name+" is not a valid url")
+			return
+		}
+		*u.field = value
+	}
+
+	if request.Width != nil && request.Height != nil {
+		if *request.Width < 0 || *request.Height < 0 {
+			webutils.WriteSimpleResponse(w, false, "width and height must not be negative")
+			return
+		}
+		recording.Width = *request.Width
+		recording.Height = *request.Height
+	}
+
+	if recording.HasVideo() {
+		if err := live.PublishRecording(recording); err != nil {
+			webutils.WriteSimpleResponse(w, false, "unable to announce the recording: "+err.Error())
+			return
+		}
+		webutils.WriteResponse(w, recording)
+		return
+	}
+
+	// Without a video there is nothing left to announce.
+	saveRecording := recordingrepository.Get().SetRecording
+	if recording.EventID != "" {
+		saveRecording = live.DeleteRecordingAnnouncement
+	}
+	if err := saveRecording(recording); err != nil {
+		log.Errorln("error saving recording", err)
+		webutils.WriteSimpleResponse(w, false, "error saving recording")
+		return
+	}
+
+	webutils.WriteResponse(w, recording)
+}
+
+// DeleteNostrRecordingAnnouncement will ask relays to delete the video
+// events announcing a recording.
+func DeleteNostrRecordingAnnouncement(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.DeleteNostrRecordingAnnouncementJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to parse request")
+		return
+	}
+
+	recording := recordingrepository.Get().GetRecording(request.Id)
+	if recording == nil {
+		webutils.WriteSimpleResponse(w, false, "recording not found")
+		return
+	}
+
+	if err := live.DeleteRecordingAnnouncement(recording); err != nil {
+		log.Errorln("error saving recording", err)
+		webutils.WriteSimpleResponse(w, false, "error saving recording")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "deletion requested")
+}
+
+// SetNostrLegacyVideoEvents will set if recordings are also announced with
+// kind 34235 video events for older Nostr clients.
+func SetNostrLegacyVideoEvents(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	enabled, ok := configValue.Value.(bool)
+	if !ok {
+		webutils.WriteSimpleResponse(w, false, "value must be a boolean")
+		return
+	}
+
+	if err := configrepository.Get().SetNostrLegacyVideoEvents(enabled); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "changed")
+}
@@ -5,8 +5,11 @@ import (
 	"net/http"
 
 	"github.com/TekkadanPlays/oni/config"
+	"github.com/TekkadanPlays/oni/core"
 	"github.com/TekkadanPlays/oni/core/transcoder"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/utils"
 	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
@@ -35,6 +38,12 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 			ScaledHeight:       variant.ScaledHeight,
 		})
 	}
+
+	broadcasterTransport := ""
+	if broadcaster := core.GetBroadcaster(); broadcaster != nil {
+		broadcasterTransport = broadcaster.Protocol
+	}
+
 	response := serverConfigAdminResponse{
 		InstanceDetails: webConfigResponse{
 			Name:                configRepository.GetServerName(),
@@ -58,6 +67,14 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 		WebServerPort:             config.WebServerPort,
 		WebServerIP:               config.WebServerIP,
 		RTMPServerPort:            configRepository.GetRTMPPortNumber(),
+		SRTServerPort:             configRepository.GetSRTPortNumber(),
+		WebRTCServerPort:          configRepository.GetWebRTCPortNumber(),
+		RTMPS:                     configRepository.GetRTMPSConfig(),
+		BroadcasterTransport:      broadcasterTransport,
+		RTMPTakeover:              configRepository.GetRTMPTakeoverConfig(),
+		RestreamDestinations:      configRepository.GetRestreamDestinations(),
+		PublishCallback:           configRepository.GetPublishCallbackConfig(),
+		ReconnectGracePeriod:      int(configRepository.GetReconnectGracePeriod().Seconds()),
 		ChatDisabled:              configRepository.GetChatDisabled(),
 		ChatJoinMessagesEnabled:   configRepository.GetChatJoinPartMessagesEnabled(),
 		SocketHostOverride:        configRepository.GetWebsocketOverrideHost(),
@@ -92,6 +109,18 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 		Notifications: notificationsConfigResponse{
 			Discord: configRepository.GetDiscordConfig(),
 			Browser: configRepository.GetBrowserPushConfig(),
+			Nostr:   configRepository.GetNostrNotificationConfig(),
+		},
+		Nostr: nostrConfigResponse{
+			Pubkey:            identity.PublicKey(),
+			Npub:              identity.Npub(),
+			Relays:            configRepository.GetNostrRelays(),
+			LightningAddress:  configRepository.GetNostrLightningAddress(),
+			LUD16:             zaps.LightningAddress(),
+			WalletConnected:   zaps.Wallet() != nil,
+			NIP05Names:        configRepository.GetNostrNIP05Names(),
+			LegacyVideoEvents: configRepository.GetNostrLegacyVideoEvents(),
+			RelayEnabled:      configRepository.GetNostrRelayEnabled(),
 		},
 	}
 
@@ -104,33 +133,42 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 }
 
 type serverConfigAdminResponse struct {
-	InstanceDetails           webConfigResponse           `json:"instanceDetails"`
-	Notifications             notificationsConfigResponse `json:"notifications"`
-	YP                        yp                          `json:"yp"`
-	FFmpegPath                string                      `json:"ffmpegPath"`
-	AdminPassword             string                      `json:"adminPassword"`
-	SocketHostOverride        string                      `json:"socketHostOverride,omitempty"`
-	WebServerIP               string                      `json:"webServerIP"`
-	VideoCodec                string                      `json:"videoCodec"`
-	VideoServingEndpoint      string                      `json:"videoServingEndpoint"`
-	S3                        models.S3                   `json:"s3"`
-	Federation                federationConfigResponse    `json:"federation"`
-	SupportedCodecs           []string                    `json:"supportedCodecs"`
-	ExternalActions           []models.ExternalAction     `json:"externalActions"`
-	ForbiddenUsernames        []string                    `json:"forbiddenUsernames"`
-	SuggestedUsernames        []string                    `json:"suggestedUsernames"`
-	StreamKeys                []generated.StreamKey       `json:"streamKeys"`
-	VideoSettings             videoSettings               `json:"videoSettings"`
-	RTMPServerPort            int                         `json:"rtmpServerPort"`
-	WebServerPort             int                         `json:"webServerPort"`
-	ChatDisabled              bool                        `json:"chatDisabled"`
-	ChatJoinMessagesEnabled   bool                        `json:"chatJoinMessagesEnabled"`
-	ChatEstablishedUserMode   bool                        `json:"chatEstablishedUserMode"`
-	ChatSpamProtectionEnabled bool                        `json:"chatSpamProtectionEnabled"`
-	ChatSlurFilterEnabled     bool                        `json:"chatSlurFilterEnabled"`
-	DisableSearchIndexing     bool                        `json:"disableSearchIndexing"`
-	StreamKeyOverridden       bool                        `json:"streamKeyOverridden"`
-	HideViewerCount           bool                        `json:"hideViewerCount"`
+	InstanceDetails           webConfigResponse                   `json:"instanceDetails"`
+	Notifications             notificationsConfigResponse         `json:"notifications"`
+	YP                        yp                                  `json:"yp"`
+	FFmpegPath                string                              `json:"ffmpegPath"`
+	AdminPassword             string                              `json:"adminPassword"`
+	SocketHostOverride        string                              `json:"socketHostOverride,omitempty"`
+	WebServerIP               string                              `json:"webServerIP"`
+	VideoCodec                string                              `json:"videoCodec"`
+	VideoServingEndpoint      string                              `json:"videoServingEndpoint"`
+	S3                        models.S3                           `json:"s3"`
+	Federation                federationConfigResponse            `json:"federation"`
+	Nostr                     nostrConfigResponse                 `json:"nostr"`
+	SupportedCodecs           []string                            `json:"supportedCodecs"`
+	ExternalActions           []models.ExternalAction             `json:"externalActions"`
+	ForbiddenUsernames        []string                            `json:"forbiddenUsernames"`
+	SuggestedUsernames        []string                            `json:"suggestedUsernames"`
+	StreamKeys                []generated.StreamKey               `json:"streamKeys"`
+	VideoSettings             videoSettings                       `json:"videoSettings"`
+	RTMPServerPort            int                                 `json:"rtmpServerPort"`
+	SRTServerPort             int                                 `json:"srtServerPort"`
+	WebRTCServerPort          int                                 `json:"webRTCServerPort"`
+	RTMPS                     models.RTMPSConfiguration           `json:"rtmps"`
+	BroadcasterTransport      string                              `json:"broadcasterTransport"`
+	RTMPTakeover              models.RTMPTakeoverConfiguration    `json:"rtmpTakeover"`
+	RestreamDestinations      []models.RestreamDestination        `json:"restreamDestinations"`
+	PublishCallback           models.PublishCallbackConfiguration `json:"publishCallback"`
+	ReconnectGracePeriod      int                                 `json:"reconnectGracePeriod"`
+	WebServerPort             int                                 `json:"webServerPort"`
+	ChatDisabled              bool                                `json:"chatDisabled"`
+	ChatJoinMessagesEnabled   bool                                `json:"chatJoinMessagesEnabled"`
+	ChatEstablishedUserMode   bool                                `json:"chatEstablishedUserMode"`
+	ChatSpamProtectionEnabled bool                                `json:"chatSpamProtectionEnabled"`
+	ChatSlurFilterEnabled     bool                                `json:"chatSlurFilterEnabled"`
+	DisableSearchIndexing     bool                                `json:"disableSearchIndexing"`
+	StreamKeyOverridden       bool                                `json:"streamKeyOverridden"`
+	HideViewerCount           bool                                `json:"hideViewerCount"`
 }
 
 type videoSettings struct {
@@ -170,7 +208,20 @@ type federationConfigResponse struct {
 	ShowEngagement bool     `json:"showEngagement"`
 }
 
+type nostrConfigResponse struct {
+	Pubkey            string            `json:"pubkey"`
+	Npub              string            `json:"npub"`
+	LightningAddress  string            `json:"lightningAddress"`
+	LUD16             string            `json:"lud16"`
+	NIP05Names        map[string]string `json:"nip05Names"`
+	Relays            []string          `json:"relays"`
+	WalletConnected   bool              `json:"walletConnected"`
+	LegacyVideoEvents bool              `json:"legacyVideoEvents"`
+	RelayEnabled      bool              `json:"relayEnabled"`
+}
+
 type notificationsConfigResponse struct {
 	Browser models.BrowserNotificationConfiguration `json:"browser"`
 	Discord models.DiscordConfiguration             `json:"discord"`
+	Nostr   models.NostrNotificationConfiguration   `json:"nostr"`
 }
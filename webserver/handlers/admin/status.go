@@ -1,12 +1,16 @@
 package admin
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/TekkadanPlays/oni/core"
+	"github.com/TekkadanPlays/oni/core/restream"
 	"github.com/TekkadanPlays/oni/metrics"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/webserver/router/middleware"
 	log "github.com/sirupsen/logrus"
@@ -20,16 +24,24 @@ func Status(w http.ResponseWriter, r *http.Request) {
 	status := core.GetStatus()
 	currentBroadcast := core.GetCurrentBroadcast()
 	health := metrics.GetStreamHealthOverview()
+
+	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	defer cancel()
+	wallet := zaps.GetWalletStatus(ctx)
+
 	response := adminStatusResponse{
 		Broadcaster:            broadcaster,
 		CurrentBroadcast:       currentBroadcast,
 		Online:                 status.Online,
+		Reconnecting:           core.IsReconnecting(),
 		Health:                 health,
 		ViewerCount:            status.ViewerCount,
 		OverallPeakViewerCount: status.OverallMaxViewerCount,
 		SessionPeakViewerCount: status.SessionMaxViewerCount,
 		VersionNumber:          status.VersionNumber,
 		StreamTitle:            configRepository.GetStreamTitle(),
+		Wallet:                 wallet,
+		Restreams:              restream.Status(),
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -45,10 +57,13 @@ type adminStatusResponse struct {
 	Broadcaster            *models.Broadcaster          `json:"broadcaster"`
 	CurrentBroadcast       *models.CurrentBroadcast     `json:"currentBroadcast"`
 	Health                 *models.StreamHealthOverview `json:"health"`
+	Wallet                 *zaps.WalletStatus           `json:"wallet,omitempty"`
+	Restreams              []models.RestreamStatus      `json:"restreams"`
 	StreamTitle            string                       `json:"streamTitle"`
 	VersionNumber          string                       `json:"versionNumber"`
 	ViewerCount            int                          `json:"viewerCount"`
 	OverallPeakViewerCount int                          `json:"overallPeakViewerCount"`
 	SessionPeakViewerCount int                          `json:"sessionPeakViewerCount"`
 	Online                 bool                         `json:"online"`
+	Reconnecting           bool                         `json:"reconnecting"`
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/TekkadanPlays/oni/core"
 	"github.com/TekkadanPlays/oni/metrics"
+	"github.com/TekkadanPlays/oni/models"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	log "github.com/sirupsen/logrus"
 )
@@ -86,3 +87,33 @@ func GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
 		log.Errorln(err)
 	}
 }
+
+// GetInboundStreamMetrics returns the health of the inbound stream, as
+// measured from the packets the broadcaster sends.
+func GetInboundStreamMetrics(w http.ResponseWriter, r *http.Request) {
+	type response struct {
+		Current          *models.InboundStreamHealth `json:"current"`
+		Bitrate          []metrics.TimestampedValue  `json:"bitrate"`
+		KeyframeInterval []metrics.TimestampedValue  `json:"keyframeInterval"`
+		AVDrift          []metrics.TimestampedValue  `json:"avDrift"`
+		TimestampJumps   []metrics.TimestampedValue  `json:"timestampJumps"`
+		DroppedFrames    []metrics.TimestampedValue  `json:"droppedFrames"`
+		LatePackets      []metrics.TimestampedValue  `json:"latePackets"`
+	}
+
+	resp := response{
+		Current:          metrics.GetInboundStreamHealth(),
+		Bitrate:          metrics.GetInboundBitrateOverTime(),
+		KeyframeInterval: metrics.GetKeyframeIntervalOverTime(),
+		AVDrift:          metrics.GetAVDriftOverTime(),
+		TimestampJumps:   metrics.GetTimestampJumpsOverTime(),
+		DroppedFrames:    metrics.GetDroppedFramesOverTime(),
+		LatePackets:      metrics.GetLatePacketsOverTime(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		log.Errorln(err)
+	}
+}
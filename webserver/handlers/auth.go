@@ -5,6 +5,7 @@ import (
 
 	"github.com/TekkadanPlays/oni/webserver/handlers/auth/fediverse"
 	"github.com/TekkadanPlays/oni/webserver/handlers/auth/indieauth"
+	"github.com/TekkadanPlays/oni/webserver/handlers/auth/nostr"
 	"github.com/TekkadanPlays/oni/webserver/handlers/generated"
 	"github.com/TekkadanPlays/oni/webserver/router/middleware"
 )
@@ -32,3 +33,15 @@ func (*ServerInterfaceImpl) RegisterFediverseOTPRequest(w http.ResponseWriter, r
 func (*ServerInterfaceImpl) VerifyFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
 	fediverse.VerifyFediverseOTPRequest(w, r)
 }
+
+func (*ServerInterfaceImpl) RegisterNostrAuthChallenge(w http.ResponseWriter, r *http.Request, params generated.RegisterNostrAuthChallengeParams) {
+	middleware.RequireUserAccessToken(nostr.RegisterChallengeRequest)(w, r)
+}
+
+func (*ServerInterfaceImpl) VerifyNostrAuthChallenge(w http.ResponseWriter, r *http.Request, params generated.VerifyNostrAuthChallengeParams) {
+	middleware.RequireUserAccessToken(nostr.VerifyChallengeRequest)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrNIP05(w http.ResponseWriter, r *http.Request, params generated.SetNostrNIP05Params) {
+	middleware.RequireUserAccessToken(nostr.SetNIP05Request)(w, r)
+}
@@ -0,0 +1,154 @@
+package nostr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	nostrauth "github.com/TekkadanPlays/oni/auth/nostr"
+	"github.com/TekkadanPlays/oni/core/chat"
+	"github.com/TekkadanPlays/oni/models"
+	nostrlib "github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/nostr/nip05"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// RegisterChallengeRequest registers a new Nostr auth challenge for the given access token.
+func RegisterChallengeRequest(u models.User, w http.ResponseWriter, r *http.Request) {
+	accessToken := r.URL.Query().Get("accessToken")
+	reg, err := nostrauth.RegisterChallenge(accessToken, u.ID, u.DisplayName)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, "Could not register auth request: "+err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, map[string]string{"challenge": reg.Challenge, "relay": authURL(r)})
+}
+
+// authURL returns the URL clients sign their auth events for. The
+// configured server URL is used when there is one, as the host a request
+// names can be chosen by whoever sends it.
+func authURL(r *http.Request) string {
+	if serverURL := configrepository.Get().GetServerURL(); serverURL != "" {
+		return strings.TrimSuffix(serverURL, "/")
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// VerifyChallengeRequest verifies the signed challenge event for the given access token.
+func VerifyChallengeRequest(u models.User, w http.ResponseWriter, r *http.Request) {
+	type request struct {
+		Event *nostrlib.Event `json:"event"`
+	}
+	var req request
+
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&req); err != nil {
+		webutils.WriteSimpleResponse(w, false, "Could not decode request: "+err.Error())
+		return
+	}
+
+	if req.Event == nil {
+		webutils.WriteSimpleResponse(w, false, "Could not decode request: event is required")
+		return
+	}
+
+	accessToken := r.URL.Query().Get("accessToken")
+	authRegistration, pubkey, err := nostrauth.ValidateChallenge(accessToken, req.Event, []string{authURL(r)})
+	if err != nil {
+		log.Debugln("Nostr auth challenge failed:", err)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	userRepository := userrepository.Get()
+
+	// Check if a user with this auth already exists, if so, log them in.
+	if existing := userRepository.GetUserByAuth(pubkey, models.Nostr); existing != nil {
+		// Handle existing auth.
+		log.Debugln("user with provided nostr identity already exists, logging them in")
+
+		// Update the current user's access token to point to the existing user id.
+		if err := userRepository.SetAccessTokenToOwner(accessToken, existing.ID); err != nil {
+			webutils.WriteSimpleResponse(w, false, err.Error())
+			return
+		}
+
+		go chat.SyncNostrProfile(existing.ID, pubkey)
+
+		if authRegistration.UserDisplayName != existing.DisplayName {
+			loginMessage := fmt.Sprintf("**%s** is now authenticated as **%s**", authRegistration.UserDisplayName, existing.DisplayName)
+			if err := chat.SendSystemAction(loginMessage, true); err != nil {
+				log.Errorln(err)
+			}
+		}
+
+		webutils.WriteSimpleResponse(w, true, "")
+
+		return
+	}
+
+	// Otherwise, save this as new auth.
+	log.Debug("nostr pubkey does not already exist, saving it as a new one for the current user")
+	if err := userRepository.AddAuth(u.ID, pubkey, models.Nostr); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	// Update the current user's authenticated flag so we can show it in
+	// the chat UI.
+	if err := userRepository.SetUserAsAuthenticated(u.ID); err != nil {
+		log.Errorln(err)
+	}
+
+	go chat.SyncNostrProfile(u.ID, pubkey)
+	go chat.CheckNostrMuteList(u.ID, pubkey)
+
+	webutils.WriteSimpleResponse(w, true, "")
+}
+
+// SetNIP05Request gives the Nostr authenticated user a NIP-05 identifier on
+// this server, or removes it.
+func SetNIP05Request(u models.User, w http.ResponseWriter, r *http.Request) {
+	type request struct {
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+	}
+	type response struct {
+		Identifier string `json:"identifier,omitempty"`
+		Enabled    bool   `json:"enabled"`
+	}
+	var req request
+
+	decoder := json.NewDecoder(r.Body)
+	if err := decoder.Decode(&req); err != nil {
+		webutils.WriteSimpleResponse(w, false, "Could not decode request: "+err.Error())
+		return
+	}
+
+	if !req.Enabled {
+		if err := nip05.OptOut(&u); err != nil {
+			webutils.WriteSimpleResponse(w, false, err.Error())
+			return
+		}
+		webutils.WriteResponse(w, response{Enabled: false})
+		return
+	}
+
+	name, err := nip05.OptIn(&u, req.Name)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, response{Identifier: nip05.Identifier(name), Enabled: true})
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/TekkadanPlays/oni/activitypub"
 	"github.com/TekkadanPlays/oni/config"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr/identity"
+	"github.com/TekkadanPlays/oni/nostr/zaps"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/utils"
 	"github.com/TekkadanPlays/oni/webserver/router/middleware"
@@ -38,6 +40,7 @@ type webConfigResponse struct {
 	ChatSpamProtectionDisabled bool                         `json:"chatSpamProtectionDisabled"`
 	NSFW                       bool                         `json:"nsfw"`
 	Authentication             authenticationConfigResponse `json:"authentication"`
+	Nostr                      nostrConfigResponse          `json:"nostr"`
 }
 
 type federationConfigResponse struct {
@@ -53,6 +56,16 @@ type browserNotificationsConfigResponse struct {
 
 type notificationsConfigResponse struct {
 	Browser browserNotificationsConfigResponse `json:"browser"`
+	Nostr   nostrNotificationsConfigResponse   `json:"nostr"`
+}
+
+type nostrNotificationsConfigResponse struct {
+	Enabled bool `json:"enabled"`
+}
+
+type nostrConfigResponse struct {
+	Npub  string `json:"npub,omitempty"`
+	LUD16 string `json:"lud16,omitempty"`
 }
 
 type authenticationConfigResponse struct {
@@ -114,6 +127,9 @@ func getConfigResponse() webConfigResponse {
 			Enabled:   browserPushEnabled,
 			PublicKey: browserPushPublicKey,
 		},
+		Nostr: nostrNotificationsConfigResponse{
+			Enabled: configRepository.GetNostrNotificationConfig().Enabled && identity.PublicKey() != "",
+		},
 	}
 
 	authenticationResponse := authenticationConfigResponse{
@@ -142,6 +158,10 @@ func getConfigResponse() webConfigResponse {
 		Authentication:             authenticationResponse,
 		AppearanceVariables:        configRepository.GetCustomColorVariableValues(),
 		HideViewerCount:            configRepository.GetHideViewerCount(),
+		Nostr: nostrConfigResponse{
+			Npub:  identity.Npub(),
+			LUD16: zaps.LightningAddress(),
+		},
 	}
 }
 
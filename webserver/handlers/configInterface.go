@@ -3,24 +3,25 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/TekkadanPlays/oni/models"
 	"github.com/TekkadanPlays/oni/webserver/handlers/admin"
 	"github.com/TekkadanPlays/oni/webserver/router/middleware"
 )
 
 func (*ServerInterfaceImpl) SetAdminPassword(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.SetAdminPassword)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetAdminPassword)(w, r)
 }
 
 func (*ServerInterfaceImpl) SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.SetAdminPassword)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetAdminPassword)(w, r)
 }
 
 func (*ServerInterfaceImpl) SetStreamKeys(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.SetStreamKeys)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetStreamKeys)(w, r)
 }
 
 func (*ServerInterfaceImpl) SetStreamKeysOptions(w http.ResponseWriter, r *http.Request) {
-	middleware.RequireAdminAuth(admin.SetStreamKeys)(w, r)
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetStreamKeys)(w, r)
 }
 
 func (*ServerInterfaceImpl) SetExtraPageContent(w http.ResponseWriter, r *http.Request) {
@@ -207,6 +208,62 @@ func (*ServerInterfaceImpl) SetRTMPServerPortOptions(w http.ResponseWriter, r *h
 	middleware.RequireAdminAuth(admin.SetRTMPServerPort)(w, r)
 }
 
+func (*ServerInterfaceImpl) SetRTMPSConfig(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetRTMPSConfig)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetRTMPSConfigOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetRTMPSConfig)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetRestreamDestinations(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetRestreamDestinations)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetRestreamDestinationsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetRestreamDestinations)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetPublishCallback(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetPublishCallback)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetPublishCallbackOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetPublishCallback)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetSRTServerPort(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetSRTServerPort)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetSRTServerPortOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetSRTServerPort)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetWebRTCServerPort(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetWebRTCServerPort)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetWebRTCServerPortOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetWebRTCServerPort)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetReconnectGracePeriod(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetReconnectGracePeriod)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetReconnectGracePeriodOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetReconnectGracePeriod)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetRTMPTakeover(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetRTMPTakeover)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetRTMPTakeoverOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetRTMPTakeover)(w, r)
+}
+
 func (*ServerInterfaceImpl) SetSocketHostOverride(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.SetSocketHostOverride)(w, r)
 }
@@ -366,3 +423,77 @@ func (*ServerInterfaceImpl) SetBrowserNotificationConfiguration(w http.ResponseW
 func (*ServerInterfaceImpl) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.SetBrowserNotificationConfiguration)(w, r)
 }
+
+func (*ServerInterfaceImpl) SetNostrNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetAdminNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	// Without credentials the request can only claim an unset pubkey using the setup token.
+	if r.Header.Get("Authorization") == "" {
+		admin.BootstrapAdminNostrPubkey(w, r)
+		return
+	}
+
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetAdminNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetAdminNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminRole(models.AdminRoleOwner, admin.SetAdminNostrPubkey)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrRelays(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrRelays)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrRelaysOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrRelays)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrLightningAddress(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrLightningAddress)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrLightningAddressOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrLightningAddress)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrWalletConnect(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrWalletConnect)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrWalletConnectOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrWalletConnect)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrNIP05Names(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrNIP05Names)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrNIP05NamesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrNIP05Names)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrLegacyVideoEvents(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrLegacyVideoEvents)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrLegacyVideoEventsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrLegacyVideoEvents)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrRelayEnabled(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrRelayEnabled)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNostrRelayEnabledOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNostrRelayEnabled)(w, r)
+}
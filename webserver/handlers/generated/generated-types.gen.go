@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
 package generated
 
 import (
@@ -15,20 +15,81 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AdminRole.
+const (
+	Admin       AdminRole = "admin"
+	Integration AdminRole = "integration"
+	Moderator   AdminRole = "moderator"
+	Owner       AdminRole = "owner"
+)
+
+// Defines values for AdminServerConfigBroadcasterTransport.
+const (
+	AdminServerConfigBroadcasterTransportRtmp  AdminServerConfigBroadcasterTransport = "rtmp"
+	AdminServerConfigBroadcasterTransportRtmps AdminServerConfigBroadcasterTransport = "rtmps"
+	AdminServerConfigBroadcasterTransportSrt   AdminServerConfigBroadcasterTransport = "srt"
+	AdminServerConfigBroadcasterTransportWhip  AdminServerConfigBroadcasterTransport = "whip"
+)
+
+// Defines values for BroadcastHistoryEntryProtocol.
+const (
+	BroadcastHistoryEntryProtocolRtmp BroadcastHistoryEntryProtocol = "rtmp"
+	BroadcastHistoryEntryProtocolSrt  BroadcastHistoryEntryProtocol = "srt"
+	BroadcastHistoryEntryProtocolWhip BroadcastHistoryEntryProtocol = "whip"
+)
+
+// Defines values for BroadcasterProtocol.
+const (
+	Rtmp  BroadcasterProtocol = "rtmp"
+	Rtmps BroadcasterProtocol = "rtmps"
+	Srt   BroadcasterProtocol = "srt"
+	Whip  BroadcasterProtocol = "whip"
+)
+
+// Defines values for NostrMuteType.
+const (
+	Pubkey NostrMuteType = "pubkey"
+	Word   NostrMuteType = "word"
+)
+
+// Defines values for NostrRelayHealthStatus.
+const (
+	NostrRelayHealthStatusConnected    NostrRelayHealthStatus = "connected"
+	NostrRelayHealthStatusConnecting   NostrRelayHealthStatus = "connecting"
+	NostrRelayHealthStatusDisconnected NostrRelayHealthStatus = "disconnected"
+)
+
+// Defines values for RTMPTakeoverConfigurationPolicy.
+const (
+	Idle    RTMPTakeoverConfigurationPolicy = "idle"
+	Reject  RTMPTakeoverConfigurationPolicy = "reject"
+	Samekey RTMPTakeoverConfigurationPolicy = "samekey"
+)
+
+// Defines values for RestreamStatusState.
+const (
+	RestreamStatusStateConnected    RestreamStatusState = "connected"
+	RestreamStatusStateConnecting   RestreamStatusState = "connecting"
+	RestreamStatusStateReconnecting RestreamStatusState = "reconnecting"
+)
+
 // Defines values for WebhookEventType.
 const (
-	CHAT               WebhookEventType = "CHAT"
-	CHATACTION         WebhookEventType = "CHAT_ACTION"
-	NAMECHANGE         WebhookEventType = "NAME_CHANGE"
-	PING               WebhookEventType = "PING"
-	PONG               WebhookEventType = "PONG"
-	STREAMSTARTED      WebhookEventType = "STREAM_STARTED"
-	STREAMSTOPPED      WebhookEventType = "STREAM_STOPPED"
-	STREAMTITLEUPDATED WebhookEventType = "STREAM_TITLE_UPDATED"
-	SYSTEM             WebhookEventType = "SYSTEM"
-	USERJOINED         WebhookEventType = "USER_JOINED"
-	USERPARTED         WebhookEventType = "USER_PARTED"
-	VISIBILITYUPDATE   WebhookEventType = "VISIBILITY-UPDATE"
+	CHAT                 WebhookEventType = "CHAT"
+	CHATACTION           WebhookEventType = "CHAT_ACTION"
+	NAMECHANGE           WebhookEventType = "NAME_CHANGE"
+	PING                 WebhookEventType = "PING"
+	PONG                 WebhookEventType = "PONG"
+	RESTREAMCONNECTED    WebhookEventType = "RESTREAM_CONNECTED"
+	RESTREAMDISCONNECTED WebhookEventType = "RESTREAM_DISCONNECTED"
+	STREAMSTARTED        WebhookEventType = "STREAM_STARTED"
+	STREAMSTOPPED        WebhookEventType = "STREAM_STOPPED"
+	STREAMTITLEUPDATED   WebhookEventType = "STREAM_TITLE_UPDATED"
+	SYSTEM               WebhookEventType = "SYSTEM"
+	USERJOINED           WebhookEventType = "USER_JOINED"
+	USERPARTED           WebhookEventType = "USER_PARTED"
+	VISIBILITYUPDATE     WebhookEventType = "VISIBILITY-UPDATE"
+	ZAP                  WebhookEventType = "ZAP"
 )
 
 // ActionMessage defines model for ActionMessage.
@@ -39,6 +100,21 @@ type ActionMessage struct {
 	Type      *string `json:"type,omitempty"`
 }
 
+// AdminAuditEntry defines model for AdminAuditEntry.
+type AdminAuditEntry struct {
+	Id     *int    `json:"id,omitempty"`
+	Method *string `json:"method,omitempty"`
+	Path   *string `json:"path,omitempty"`
+
+	// Pubkey Empty for changes made with the admin password.
+	Pubkey *string `json:"pubkey,omitempty"`
+
+	// Role Owners manage admin pubkeys, admins everything else, moderators chat moderation and integrations the external API.
+	Role      *AdminRole `json:"role,omitempty"`
+	Status    *int       `json:"status,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
 // AdminConfigValue defines model for AdminConfigValue.
 type AdminConfigValue struct {
 	Value *AdminConfigValue_Value `json:"value,omitempty"`
@@ -82,36 +158,71 @@ type AdminLog struct {
 type AdminNotificationsConfig struct {
 	Browser *BrowserNotificationConfiguration `json:"browser,omitempty"`
 	Discord *DiscordNotificationConfiguration `json:"discord,omitempty"`
+	Nostr   *NostrNotificationConfiguration   `json:"nostr,omitempty"`
 }
 
+// AdminPubkey defines model for AdminPubkey.
+type AdminPubkey struct {
+	AddedBy   *string    `json:"addedBy,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Label     *string    `json:"label,omitempty"`
+	Primary   *bool      `json:"primary,omitempty"`
+	Pubkey    *string    `json:"pubkey,omitempty"`
+
+	// Role Owners manage admin pubkeys, admins everything else, moderators chat moderation and integrations the external API.
+	Role *AdminRole `json:"role,omitempty"`
+}
+
+// AdminRole Owners manage admin pubkeys, admins everything else, moderators chat moderation and integrations the external API.
+type AdminRole string
+
 // AdminServerConfig defines model for AdminServerConfig.
 type AdminServerConfig struct {
-	AdminPassword           *string                   `json:"adminPassword,omitempty"`
-	ChatDisabled            *bool                     `json:"chatDisabled,omitempty"`
-	ChatEstablishedUserMode *bool                     `json:"chatEstablishedUserMode,omitempty"`
-	ChatJoinMessagesEnabled *bool                     `json:"chatJoinMessagesEnabled,omitempty"`
-	DisableSearchIndexing   *bool                     `json:"disableSearchIndexing,omitempty"`
-	ExternalActions         *[]ExternalAction         `json:"externalActions,omitempty"`
-	Federation              *AdminFederationConfig    `json:"federation,omitempty"`
-	FfmpegPath              *string                   `json:"ffmpegPath,omitempty"`
-	ForbiddenUsernames      *[]string                 `json:"forbiddenUsernames,omitempty"`
-	HideViewerCount         *bool                     `json:"hideViewerCount,omitempty"`
-	InstanceDetails         *AdminWebConfig           `json:"instanceDetails,omitempty"`
-	Notifications           *AdminNotificationsConfig `json:"notifications,omitempty"`
-	RtmpServerPort          *int                      `json:"rtmpServerPort,omitempty"`
-	S3                      *S3Info                   `json:"s3,omitempty"`
-	SocketHostOverride      *string                   `json:"socketHostOverride,omitempty"`
-	StreamKeyOverridden     *bool                     `json:"streamKeyOverridden,omitempty"`
-	StreamKeys              *[]StreamKey              `json:"streamKeys,omitempty"`
-	SuggestedUsernames      *[]string                 `json:"suggestedUsernames,omitempty"`
-	SupportedCodecs         *[]string                 `json:"supportedCodecs,omitempty"`
-	VideoCodec              *string                   `json:"videoCodec,omitempty"`
-	VideoServingEndpoint    *string                   `json:"videoServingEndpoint,omitempty"`
-	VideoSettings           *AdminVideoSettings       `json:"videoSettings,omitempty"`
-	WebServerIP             *string                   `json:"webServerIP,omitempty"`
-	WebServerPort           *int                      `json:"webServerPort,omitempty"`
-	Yp                      *AdminYPInfo              `json:"yp,omitempty"`
-}
+	AdminPassword *string `json:"adminPassword,omitempty"`
+
+	// BroadcasterTransport The transport the current broadcaster streams with, empty when nobody is.
+	BroadcasterTransport    *AdminServerConfigBroadcasterTransport `json:"broadcasterTransport,omitempty"`
+	ChatDisabled            *bool                                  `json:"chatDisabled,omitempty"`
+	ChatEstablishedUserMode *bool                                  `json:"chatEstablishedUserMode,omitempty"`
+	ChatJoinMessagesEnabled *bool                                  `json:"chatJoinMessagesEnabled,omitempty"`
+	DisableSearchIndexing   *bool                                  `json:"disableSearchIndexing,omitempty"`
+	ExternalActions         *[]ExternalAction                      `json:"externalActions,omitempty"`
+	Federation              *AdminFederationConfig                 `json:"federation,omitempty"`
+	FfmpegPath              *string                                `json:"ffmpegPath,omitempty"`
+	ForbiddenUsernames      *[]string                              `json:"forbiddenUsernames,omitempty"`
+	HideViewerCount         *bool                                  `json:"hideViewerCount,omitempty"`
+	InstanceDetails         *AdminWebConfig                        `json:"instanceDetails,omitempty"`
+	Notifications           *AdminNotificationsConfig              `json:"notifications,omitempty"`
+
+	// PublishCallback An optional HTTP endpoint asked if a broadcaster may stream over RTMP, SRT or WHIP, instead of checking the stream keys. It is sent the stream key, remote address, protocol and, over RTMP, the encoder metadata as JSON. A 2xx answer allows the broadcaster unless it has "allow" false, and may set "streamTitle" and the "variants" to use by name. A 4xx answer denies them.
+	PublishCallback *PublishCallbackConfiguration `json:"publishCallback,omitempty"`
+
+	// ReconnectGracePeriod Seconds a broadcast waits for the broadcaster to reconnect before going offline.
+	ReconnectGracePeriod *int                       `json:"reconnectGracePeriod,omitempty"`
+	RestreamDestinations *[]RestreamDestination     `json:"restreamDestinations,omitempty"`
+	RtmpServerPort       *int                       `json:"rtmpServerPort,omitempty"`
+	RtmpTakeover         *RTMPTakeoverConfiguration `json:"rtmpTakeover,omitempty"`
+
+	// Rtmps The optional TLS listener broadcasters can stream to. Changes apply after a restart, renewed certificates are loaded on SIGHUP or when the files change.
+	Rtmps                *RTMPSConfiguration `json:"rtmps,omitempty"`
+	S3                   *S3Info             `json:"s3,omitempty"`
+	SocketHostOverride   *string             `json:"socketHostOverride,omitempty"`
+	SrtServerPort        *int                `json:"srtServerPort,omitempty"`
+	StreamKeyOverridden  *bool               `json:"streamKeyOverridden,omitempty"`
+	StreamKeys           *[]StreamKey        `json:"streamKeys,omitempty"`
+	SuggestedUsernames   *[]string           `json:"suggestedUsernames,omitempty"`
+	SupportedCodecs      *[]string           `json:"supportedCodecs,omitempty"`
+	VideoCodec           *string             `json:"videoCodec,omitempty"`
+	VideoServingEndpoint *string             `json:"videoServingEndpoint,omitempty"`
+	VideoSettings        *AdminVideoSettings `json:"videoSettings,omitempty"`
+	WebRTCServerPort     *int                `json:"webRTCServerPort,omitempty"`
+	WebServerIP          *string             `json:"webServerIP,omitempty"`
+	WebServerPort        *int                `json:"webServerPort,omitempty"`
+	Yp                   *AdminYPInfo        `json:"yp,omitempty"`
+}
+
+// AdminServerConfigBroadcasterTransport The transport the current broadcaster streams with, empty when nobody is.
+type AdminServerConfigBroadcasterTransport string
 
 // AdminStatus defines model for AdminStatus.
 type AdminStatus struct {
@@ -120,10 +231,15 @@ type AdminStatus struct {
 	Health                 *StreamHealthOverview `json:"health,omitempty"`
 	Online                 *bool                 `json:"online,omitempty"`
 	OverallPeakViewerCount *int                  `json:"overallPeakViewerCount,omitempty"`
+	Reconnecting           *bool                 `json:"reconnecting,omitempty"`
+	Restreams              *[]RestreamStatus     `json:"restreams,omitempty"`
 	SessionPeakViewerCount *int                  `json:"sessionPeakViewerCount,omitempty"`
 	StreamTitle            *string               `json:"streamTitle,omitempty"`
 	VersionNumber          *string               `json:"versionNumber,omitempty"`
 	ViewerCount            *int                  `json:"viewerCount,omitempty"`
+
+	// Wallet The streamer's Nostr Wallet Connect wallet
+	Wallet *NostrWalletStatus `json:"wallet,omitempty"`
 }
 
 // AdminVideoSettings defines model for AdminVideoSettings.
@@ -174,13 +290,36 @@ type BaseAPIResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// BroadcastHistoryEntry defines model for BroadcastHistoryEntry.
+type BroadcastHistoryEntry struct {
+	// EndedAt Unset while the broadcast is live.
+	EndedAt    *time.Time                     `json:"endedAt,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Protocol   *BroadcastHistoryEntryProtocol `json:"protocol,omitempty"`
+	RemoteAddr *string                        `json:"remoteAddr,omitempty"`
+	StartedAt  *time.Time                     `json:"startedAt,omitempty"`
+
+	// StreamKeyHint The last characters of the stream key, for keys long enough to not give them away.
+	StreamKeyHint  *string `json:"streamKeyHint,omitempty"`
+	StreamKeyLabel *string `json:"streamKeyLabel,omitempty"`
+}
+
+// BroadcastHistoryEntryProtocol defines model for BroadcastHistoryEntry.Protocol.
+type BroadcastHistoryEntryProtocol string
+
 // Broadcaster defines model for Broadcaster.
 type Broadcaster struct {
+	// Link Network conditions of the inbound connection, for protocols that report them.
+	Link          *InboundLinkStats     `json:"link,omitempty"`
+	Protocol      *BroadcasterProtocol  `json:"protocol,omitempty"`
 	RemoteAddr    *string               `json:"remoteAddr,omitempty"`
 	StreamDetails *InboundStreamDetails `json:"streamDetails,omitempty"`
 	Time          *time.Time            `json:"time,omitempty"`
 }
 
+// BroadcasterProtocol defines model for Broadcaster.Protocol.
+type BroadcasterProtocol string
+
 // BrowserConfig defines model for BrowserConfig.
 type BrowserConfig struct {
 	Enabled   *bool   `json:"enabled,omitempty"`
@@ -345,6 +484,21 @@ type IPAddress struct {
 	Notes     *string    `json:"notes,omitempty"`
 }
 
+// InboundLinkStats Network conditions of the inbound connection, for protocols that report them.
+type InboundLinkStats struct {
+	// PacketLoss Percentage of packets that had to be retransmitted.
+	PacketLoss           *float32 `json:"packetLoss,omitempty"`
+	PacketsDropped       *int     `json:"packetsDropped,omitempty"`
+	PacketsLost          *int     `json:"packetsLost,omitempty"`
+	PacketsRetransmitted *int     `json:"packetsRetransmitted,omitempty"`
+
+	// ReceiveBitrate Current receive rate in kbps.
+	ReceiveBitrate *int `json:"receiveBitrate,omitempty"`
+
+	// Rtt Smoothed round trip time in milliseconds.
+	Rtt *float32 `json:"rtt,omitempty"`
+}
+
 // InboundStreamDetails defines model for InboundStreamDetails.
 type InboundStreamDetails struct {
 	AudioBitrate *int     `json:"audioBitrate,omitempty"`
@@ -357,6 +511,28 @@ type InboundStreamDetails struct {
 	Width        *int     `json:"width,omitempty"`
 }
 
+// InboundStreamHealth The health of the inbound stream, as measured from the packets that arrive rather than what the encoder reports. Null when nothing is streaming.
+type InboundStreamHealth struct {
+	// AvDriftMilliseconds How far the video is ahead of the audio, negative when behind.
+	AvDriftMilliseconds *int `json:"avDriftMilliseconds,omitempty"`
+
+	// BitrateKbps Measured over the last few seconds.
+	BitrateKbps *int `json:"bitrateKbps,omitempty"`
+
+	// DroppedFrames Counted since the stream started.
+	DroppedFrames *int `json:"droppedFrames,omitempty"`
+
+	// KeyframeIntervalSeconds The time between the last two keyframes.
+	KeyframeIntervalSeconds *float64 `json:"keyframeIntervalSeconds,omitempty"`
+
+	// LatePackets Counted since the stream started.
+	LatePackets *int       `json:"latePackets,omitempty"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+
+	// TimestampJumps Counted since the stream started.
+	TimestampJumps *int `json:"timestampJumps,omitempty"`
+}
+
 // IndieAuthProfile defines model for IndieAuthProfile.
 type IndieAuthProfile struct {
 	Name  *string `json:"name,omitempty"`
@@ -416,12 +592,130 @@ type ModerationConnectedClient struct {
 type ModerationUserDetails struct {
 	ConnectedClients *[]ModerationConnectedClient `json:"connectedClients,omitempty"`
 	Messages         *[]UserMessage               `json:"messages,omitempty"`
+	NostrProfile     *NostrProfile                `json:"nostrProfile,omitempty"`
 	User             *User                        `json:"user,omitempty"`
 }
 
+// NostrConfig defines model for NostrConfig.
+type NostrConfig struct {
+	// Lud16 The lightning address viewers can zap the stream at
+	Lud16 *string `json:"lud16,omitempty"`
+	Npub  *string `json:"npub,omitempty"`
+}
+
+// NostrEvent A signed NIP-01 Nostr event
+type NostrEvent struct {
+	Content   *string     `json:"content,omitempty"`
+	CreatedAt *int64      `json:"created_at,omitempty"`
+	Id        *string     `json:"id,omitempty"`
+	Kind      *int        `json:"kind,omitempty"`
+	Pubkey    *string     `json:"pubkey,omitempty"`
+	Sig       *string     `json:"sig,omitempty"`
+	Tags      *[][]string `json:"tags,omitempty"`
+}
+
+// NostrIdentity The Nostr identity the server signs events with
+type NostrIdentity struct {
+	// Npub NIP-19 encoded public key
+	Npub *string `json:"npub,omitempty"`
+
+	// Pubkey Hex encoded public key
+	Pubkey *string `json:"pubkey,omitempty"`
+}
+
+// NostrMute Set on users disabled by the admin's Nostr mute list rather than by a moderator.
+type NostrMute struct {
+	CreatedAt *time.Time     `json:"createdAt,omitempty"`
+	Type      *NostrMuteType `json:"type,omitempty"`
+
+	// Value The muted pubkey or word the user was disabled for.
+	Value *string `json:"value,omitempty"`
+}
+
+// NostrMuteType defines model for NostrMute.Type.
+type NostrMuteType string
+
+// NostrNotificationConfiguration defines model for NostrNotificationConfiguration.
+type NostrNotificationConfiguration struct {
+	Enabled       *bool   `json:"enabled,omitempty"`
+	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
+}
+
+// NostrProfile defines model for NostrProfile.
+type NostrProfile struct {
+	CreatedAt     *int64     `json:"createdAt,omitempty"`
+	FetchedAt     *time.Time `json:"fetchedAt,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+	Nip05         *string    `json:"nip05,omitempty"`
+	Nip05Verified *bool      `json:"nip05Verified,omitempty"`
+	Picture       *string    `json:"picture,omitempty"`
+	Pubkey        *string    `json:"pubkey,omitempty"`
+}
+
+// NostrRelayHealth Connection health of a single Nostr relay
+type NostrRelayHealth struct {
+	ConnectedAt       *time.Time              `json:"connectedAt,omitempty"`
+	EventsAccepted    *int                    `json:"eventsAccepted,omitempty"`
+	EventsPublished   *int                    `json:"eventsPublished,omitempty"`
+	EventsReceived    *int                    `json:"eventsReceived,omitempty"`
+	EventsRejected    *int                    `json:"eventsRejected,omitempty"`
+	LastError         *string                 `json:"lastError,omitempty"`
+	ReconnectAttempts *int                    `json:"reconnectAttempts,omitempty"`
+	Status            *NostrRelayHealthStatus `json:"status,omitempty"`
+	Url               *string                 `json:"url,omitempty"`
+}
+
+// NostrRelayHealthStatus defines model for NostrRelayHealth.Status.
+type NostrRelayHealthStatus string
+
+// NostrWalletPayment A payment received by the streamer's wallet
+type NostrWalletPayment struct {
+	AmountMsat  *int64     `json:"amountMsat,omitempty"`
+	Comment     *string    `json:"comment,omitempty"`
+	PaidAt      *time.Time `json:"paidAt,omitempty"`
+	PaymentHash *string    `json:"paymentHash,omitempty"`
+	Sender      *string    `json:"sender,omitempty"`
+	Zap         *bool      `json:"zap,omitempty"`
+}
+
+// NostrWalletStatus The streamer's Nostr Wallet Connect wallet
+type NostrWalletStatus struct {
+	BalanceMsat *int64 `json:"balanceMsat,omitempty"`
+
+	// Error Why the balance could not be fetched
+	Error          *string               `json:"error,omitempty"`
+	RecentPayments *[]NostrWalletPayment `json:"recentPayments,omitempty"`
+	Relays         *[]string             `json:"relays,omitempty"`
+	WalletPubkey   *string               `json:"walletPubkey,omitempty"`
+}
+
+// NostrZapTotal The zaps sent to a single stream
+type NostrZapTotal struct {
+	AmountMsat *int64     `json:"amountMsat,omitempty"`
+	Count      *int       `json:"count,omitempty"`
+	FirstZapAt *time.Time `json:"firstZapAt,omitempty"`
+	LastZapAt  *time.Time `json:"lastZapAt,omitempty"`
+	StreamId   *string    `json:"streamId,omitempty"`
+}
+
 // NotificationConfig defines model for NotificationConfig.
 type NotificationConfig struct {
 	Browser *BrowserConfig `json:"browser,omitempty"`
+	Nostr   *struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	} `json:"nostr,omitempty"`
+}
+
+// PaginatedAdminAuditLog defines model for PaginatedAdminAuditLog.
+type PaginatedAdminAuditLog struct {
+	Results *[]AdminAuditEntry `json:"results,omitempty"`
+	Total   *int               `json:"total,omitempty"`
+}
+
+// PaginatedBroadcastHistory defines model for PaginatedBroadcastHistory.
+type PaginatedBroadcastHistory struct {
+	Results *[]BroadcastHistoryEntry `json:"results,omitempty"`
+	Total   *int                     `json:"total,omitempty"`
 }
 
 // PaginatedFederatedActivity defines model for PaginatedFederatedActivity.
@@ -436,6 +730,12 @@ type PaginatedFollowers struct {
 	Total   *int       `json:"total,omitempty"`
 }
 
+// PaginatedRecordings defines model for PaginatedRecordings.
+type PaginatedRecordings struct {
+	Results *[]Recording `json:"results,omitempty"`
+	Total   *int         `json:"total,omitempty"`
+}
+
 // PlaybackMetrics defines model for PlaybackMetrics.
 type PlaybackMetrics struct {
 	Bandwidth             *float64 `json:"bandwidth,omitempty"`
@@ -445,6 +745,85 @@ type PlaybackMetrics struct {
 	QualityVariantChanges *float64 `json:"qualityVariantChanges,omitempty"`
 }
 
+// PublishCallbackConfiguration An optional HTTP endpoint asked if a broadcaster may stream over RTMP, SRT or WHIP, instead of checking the stream keys. It is sent the stream key, remote address, protocol and, over RTMP, the encoder metadata as JSON. A 2xx answer allows the broadcaster unless it has "allow" false, and may set "streamTitle" and the "variants" to use by name. A 4xx answer denies them.
+type PublishCallbackConfiguration struct {
+	// CacheSeconds How long a decision is reused for the same stream key and address.
+	CacheSeconds *int  `json:"cacheSeconds,omitempty"`
+	Enabled      *bool `json:"enabled,omitempty"`
+
+	// FailOpen Whether broadcasters with a configured stream key may stream when the callback can not be reached.
+	FailOpen *bool   `json:"failOpen,omitempty"`
+	Url      *string `json:"url,omitempty"`
+}
+
+// RTMPSConfiguration The optional TLS listener broadcasters can stream to. Changes apply after a restart, renewed certificates are loaded on SIGHUP or when the files change.
+type RTMPSConfiguration struct {
+	CertificatePath *string `json:"certificatePath,omitempty"`
+	Enabled         *bool   `json:"enabled,omitempty"`
+	KeyPath         *string `json:"keyPath,omitempty"`
+	Port            *int    `json:"port,omitempty"`
+}
+
+// RTMPTakeoverConfiguration defines model for RTMPTakeoverConfiguration.
+type RTMPTakeoverConfiguration struct {
+	IdleSeconds *int `json:"idleSeconds,omitempty"`
+
+	// Policy reject keeps the current publisher, samekey lets a publisher using the same stream key replace it, idle lets any publisher replace it after idleSeconds without data.
+	Policy *RTMPTakeoverConfigurationPolicy `json:"policy,omitempty"`
+}
+
+// RTMPTakeoverConfigurationPolicy reject keeps the current publisher, samekey lets a publisher using the same stream key replace it, idle lets any publisher replace it after idleSeconds without data.
+type RTMPTakeoverConfigurationPolicy string
+
+// Recording defines model for Recording.
+type Recording struct {
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	EventId   *string    `json:"eventId,omitempty"`
+	EventKind *int       `json:"eventKind,omitempty"`
+	Height    *int       `json:"height,omitempty"`
+
+	// Id The identifier of the broadcast's NIP-53 live activity.
+	Id            *string    `json:"id,omitempty"`
+	LegacyEventId *string    `json:"legacyEventId,omitempty"`
+	LiveActivity  *string    `json:"liveActivity,omitempty"`
+	PlaylistUrl   *string    `json:"playlistUrl,omitempty"`
+	PublishedAt   *time.Time `json:"publishedAt,omitempty"`
+	StartedAt     *time.Time `json:"startedAt,omitempty"`
+	Summary       *string    `json:"summary,omitempty"`
+	ThumbnailUrl  *string    `json:"thumbnailUrl,omitempty"`
+	Title         *string    `json:"title,omitempty"`
+	VideoUrl      *string    `json:"videoUrl,omitempty"`
+	Width         *int       `json:"width,omitempty"`
+}
+
+// RestreamDestination An external RTMP or RTMPS service the inbound RTMP stream is forwarded to. The stream key is added to the url as its last path segment.
+type RestreamDestination struct {
+	Enabled   *bool   `json:"enabled,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	StreamKey *string `json:"streamKey,omitempty"`
+	Url       *string `json:"url,omitempty"`
+}
+
+// RestreamStatus defines model for RestreamStatus.
+type RestreamStatus struct {
+	// Bitrate The rate the stream is forwarded at, in kbps.
+	Bitrate     *int       `json:"bitrate,omitempty"`
+	ConnectedAt *time.Time `json:"connectedAt,omitempty"`
+
+	// DroppedPackets Packets missed because the destination could not keep up.
+	DroppedPackets *int                 `json:"droppedPackets,omitempty"`
+	Id             *string              `json:"id,omitempty"`
+	LastError      *string              `json:"lastError,omitempty"`
+	Name           *string              `json:"name,omitempty"`
+	Reconnects     *int                 `json:"reconnects,omitempty"`
+	State          *RestreamStatusState `json:"state,omitempty"`
+	Url            *string              `json:"url,omitempty"`
+}
+
+// RestreamStatusState defines model for RestreamStatus.State.
+type RestreamStatusState string
+
 // S3Info defines model for S3Info.
 type S3Info struct {
 	AccessKey      *string `json:"accessKey,omitempty"`
@@ -484,10 +863,21 @@ type StreamHealthOverview struct {
 	Representation   *int    `json:"representation,omitempty"`
 }
 
-// StreamKey defines model for StreamKey.
+// StreamKey A key broadcasters may stream with. The key can only be used between notBefore and expiresAt when they are set, and its overrides replace the configured latency level and output variants for the broadcasts it starts.
 type StreamKey struct {
-	Comment *string `json:"comment,omitempty"`
-	Key     *string `json:"key,omitempty"`
+	Comment   *string    `json:"comment,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Key       *string    `json:"key,omitempty"`
+
+	// Label A short name for the key, shown in the broadcast history.
+	Label *string `json:"label,omitempty"`
+
+	// LastUsedAddress The IP address the key was last streamed from.
+	LastUsedAddress *string                `json:"lastUsedAddress,omitempty"`
+	LastUsedAt      *time.Time             `json:"lastUsedAt,omitempty"`
+	LatencyLevel    *int                   `json:"latencyLevel,omitempty"`
+	NotBefore       *time.Time             `json:"notBefore,omitempty"`
+	OutputVariants  *[]StreamOutputVariant `json:"outputVariants,omitempty"`
 }
 
 // StreamOutputVariant defines model for StreamOutputVariant.
@@ -519,16 +909,28 @@ type TimestampedValue struct {
 
 // User defines model for User.
 type User struct {
-	Authenticated *bool     `json:"authenticated,omitempty"`
-	CreatedAt     *string   `json:"createdAt,omitempty"`
-	DisabledAt    *string   `json:"disabledAt,omitempty"`
-	DisplayColor  *int      `json:"displayColor,omitempty"`
-	DisplayName   *string   `json:"displayName,omitempty"`
-	Id            *string   `json:"id,omitempty"`
-	IsBot         *bool     `json:"isBot,omitempty"`
-	NameChangedAt *string   `json:"nameChangedAt,omitempty"`
-	PreviousNames *[]string `json:"previousNames,omitempty"`
-	Scopes        *[]string `json:"scopes,omitempty"`
+	Authenticated *bool `json:"authenticated,omitempty"`
+
+	// Avatar Picture from the user's linked Nostr profile.
+	Avatar        *string `json:"avatar,omitempty"`
+	CreatedAt     *string `json:"createdAt,omitempty"`
+	DisabledAt    *string `json:"disabledAt,omitempty"`
+	DisplayColor  *int    `json:"displayColor,omitempty"`
+	DisplayName   *string `json:"displayName,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	IsBot         *bool   `json:"isBot,omitempty"`
+	NameChangedAt *string `json:"nameChangedAt,omitempty"`
+
+	// Nip05 NIP-05 identifier from the user's linked Nostr profile.
+	Nip05 *string `json:"nip05,omitempty"`
+
+	// Nip05Verified If the NIP-05 identifier points at the user's linked pubkey.
+	Nip05Verified *bool `json:"nip05Verified,omitempty"`
+
+	// NostrMute Set on users disabled by the admin's Nostr mute list rather than by a moderator.
+	NostrMute     *NostrMute `json:"nostrMute,omitempty"`
+	PreviousNames *[]string  `json:"previousNames,omitempty"`
+	Scopes        *[]string  `json:"scopes,omitempty"`
 }
 
 // UserEvent defines model for UserEvent.
@@ -580,6 +982,7 @@ type WebConfig struct {
 	Logo                 *string               `json:"logo,omitempty"`
 	MaxSocketPayloadSize *int                  `json:"maxSocketPayloadSize,omitempty"`
 	Name                 *string               `json:"name,omitempty"`
+	Nostr                *NostrConfig          `json:"nostr,omitempty"`
 	Notifications        *NotificationConfig   `json:"notifications,omitempty"`
 	Nsfw                 *bool                 `json:"nsfw,omitempty"`
 	OfflineMessage       *string               `json:"offlineMessage,omitempty"`
@@ -663,6 +1066,18 @@ type DeleteExternalAPIUserJSONBody struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// GetAdminAuditLogParams defines parameters for GetAdminAuditLog.
+type GetAdminAuditLogParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetBroadcastHistoryParams defines parameters for GetBroadcastHistory.
+type GetBroadcastHistoryParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // UpdateUserEnabledAdminJSONBody defines parameters for UpdateUserEnabledAdmin.
 type UpdateUserEnabledAdminJSONBody struct {
 	Enabled *bool   `json:"enabled,omitempty"`
@@ -675,6 +1090,12 @@ type UpdateUserModeratorJSONBody struct {
 	UserId      *string `json:"userId,omitempty"`
 }
 
+// SetAdminNostrPubkeyJSONBody defines parameters for SetAdminNostrPubkey.
+type SetAdminNostrPubkeyJSONBody struct {
+	SetupToken *string `json:"setupToken,omitempty"`
+	Value      *string `json:"value,omitempty"`
+}
+
 // SetCustomColorVariableValuesJSONBody defines parameters for SetCustomColorVariableValues.
 type SetCustomColorVariableValuesJSONBody struct {
 	Value *map[string]string `json:"value,omitempty"`
@@ -705,6 +1126,33 @@ type SetDiscordNotificationConfigurationJSONBody struct {
 	Value *DiscordNotificationConfiguration `json:"value,omitempty"`
 }
 
+// SetNostrNotificationConfigurationJSONBody defines parameters for SetNostrNotificationConfiguration.
+type SetNostrNotificationConfigurationJSONBody struct {
+	Value *NostrNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SetPublishCallbackJSONBody defines parameters for SetPublishCallback.
+type SetPublishCallbackJSONBody struct {
+	// Value An optional HTTP endpoint asked if a broadcaster may stream over RTMP, SRT or WHIP, instead of checking the stream keys. It is sent the stream key, remote address, protocol and, over RTMP, the encoder metadata as JSON. A 2xx answer allows the broadcaster unless it has "allow" false, and may set "streamTitle" and the "variants" to use by name. A 4xx answer denies them.
+	Value *PublishCallbackConfiguration `json:"value,omitempty"`
+}
+
+// SetRestreamDestinationsJSONBody defines parameters for SetRestreamDestinations.
+type SetRestreamDestinationsJSONBody struct {
+	Value *[]RestreamDestination `json:"value,omitempty"`
+}
+
+// SetRTMPSConfigJSONBody defines parameters for SetRTMPSConfig.
+type SetRTMPSConfigJSONBody struct {
+	// Value The optional TLS listener broadcasters can stream to. Changes apply after a restart, renewed certificates are loaded on SIGHUP or when the files change.
+	Value *RTMPSConfiguration `json:"value,omitempty"`
+}
+
+// SetRTMPTakeoverJSONBody defines parameters for SetRTMPTakeover.
+type SetRTMPTakeoverJSONBody struct {
+	Value *RTMPTakeoverConfiguration `json:"value,omitempty"`
+}
+
 // SetS3ConfigurationJSONBody defines parameters for SetS3Configuration.
 type SetS3ConfigurationJSONBody struct {
 	Value *S3Info `json:"value,omitempty"`
@@ -755,6 +1203,49 @@ type ApproveFollowerJSONBody struct {
 	Approved *bool   `json:"approved,omitempty"`
 }
 
+// RemoveAdminPubkeyJSONBody defines parameters for RemoveAdminPubkey.
+type RemoveAdminPubkeyJSONBody struct {
+	Pubkey *string `json:"pubkey,omitempty"`
+}
+
+// SetAdminPubkeyRoleJSONBody defines parameters for SetAdminPubkeyRole.
+type SetAdminPubkeyRoleJSONBody struct {
+	Label *string `json:"label,omitempty"`
+
+	// Pubkey An npub or hex pubkey.
+	Pubkey *string `json:"pubkey,omitempty"`
+
+	// Role Owners manage admin pubkeys, admins everything else, moderators chat moderation and integrations the external API.
+	Role *AdminRole `json:"role,omitempty"`
+}
+
+// GetNostrRecordingsParams defines parameters for GetNostrRecordings.
+type GetNostrRecordingsParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeleteNostrRecordingAnnouncementJSONBody defines parameters for DeleteNostrRecordingAnnouncement.
+type DeleteNostrRecordingAnnouncementJSONBody struct {
+	Id string `json:"id"`
+}
+
+// UpdateNostrRecordingJSONBody defines parameters for UpdateNostrRecording.
+type UpdateNostrRecordingJSONBody struct {
+	Height *int   `json:"height,omitempty"`
+	Id     string `json:"id"`
+
+	// PlaylistUrl The HLS playlist of the recording.
+	PlaylistUrl  *string `json:"playlistUrl,omitempty"`
+	Summary      *string `json:"summary,omitempty"`
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+	Title        *string `json:"title,omitempty"`
+
+	// VideoUrl A video file of the recording, such as an MP4.
+	VideoUrl *string `json:"videoUrl,omitempty"`
+	Width    *int    `json:"width,omitempty"`
+}
+
 // GetViewersOverTimeParams defines parameters for GetViewersOverTime.
 type GetViewersOverTimeParams struct {
 	// WindowStart Start date in unix time
@@ -802,6 +1293,35 @@ type HandleIndieAuthRedirectParams struct {
 	State IndieAuthState `form:"state" json:"state"`
 }
 
+// RegisterNostrAuthChallengeParams defines parameters for RegisterNostrAuthChallenge.
+type RegisterNostrAuthChallengeParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// SetNostrNIP05JSONBody defines parameters for SetNostrNIP05.
+type SetNostrNIP05JSONBody struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Name The name to use, defaults to one based on the display name
+	Name *string `json:"name,omitempty"`
+}
+
+// SetNostrNIP05Params defines parameters for SetNostrNIP05.
+type SetNostrNIP05Params struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// VerifyNostrAuthChallengeJSONBody defines parameters for VerifyNostrAuthChallenge.
+type VerifyNostrAuthChallengeJSONBody struct {
+	// Event A signed NIP-01 Nostr event
+	Event *NostrEvent `json:"event,omitempty"`
+}
+
+// VerifyNostrAuthChallengeParams defines parameters for VerifyNostrAuthChallenge.
+type VerifyNostrAuthChallengeParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
 // HandleIndieAuthEndpointGetParams defines parameters for HandleIndieAuthEndpointGet.
 type HandleIndieAuthEndpointGetParams struct {
 	ClientId      IndieAuthClientId      `form:"client_id" json:"client_id"`
@@ -860,6 +1380,20 @@ type GetUserDetailsParams struct {
 	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
 }
 
+// UnregisterForLiveNotificationsJSONBody defines parameters for UnregisterForLiveNotifications.
+type UnregisterForLiveNotificationsJSONBody struct {
+	// Channel Name of notification channel
+	Channel *string `json:"channel,omitempty"`
+
+	// Destination Target of the notification in the channel
+	Destination *string `json:"destination,omitempty"`
+}
+
+// UnregisterForLiveNotificationsParams defines parameters for UnregisterForLiveNotifications.
+type UnregisterForLiveNotificationsParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
 // RegisterForLiveNotificationsJSONBody defines parameters for RegisterForLiveNotifications.
 type RegisterForLiveNotificationsJSONBody struct {
 	// Channel Name of notification channel
@@ -888,6 +1422,12 @@ type DeleteExternalAPIUserJSONRequestBody DeleteExternalAPIUserJSONBody
 // UpdateMessageVisibilityAdminJSONRequestBody defines body for UpdateMessageVisibilityAdmin for application/json ContentType.
 type UpdateMessageVisibilityAdminJSONRequestBody = MessageVisibilityUpdate
 
+// BanNostrPubkeyJSONRequestBody defines body for BanNostrPubkey for application/json ContentType.
+type BanNostrPubkeyJSONRequestBody = AdminConfigValue
+
+// UnbanNostrPubkeyJSONRequestBody defines body for UnbanNostrPubkey for application/json ContentType.
+type UnbanNostrPubkeyJSONRequestBody = AdminConfigValue
+
 // BanIPAddressJSONRequestBody defines body for BanIPAddress for application/json ContentType.
 type BanIPAddressJSONRequestBody = AdminConfigValue
 
@@ -900,6 +1440,9 @@ type UpdateUserEnabledAdminJSONRequestBody UpdateUserEnabledAdminJSONBody
 // UpdateUserModeratorJSONRequestBody defines body for UpdateUserModerator for application/json ContentType.
 type UpdateUserModeratorJSONRequestBody UpdateUserModeratorJSONBody
 
+// SetAdminNostrPubkeyJSONRequestBody defines body for SetAdminNostrPubkey for application/json ContentType.
+type SetAdminNostrPubkeyJSONRequestBody SetAdminNostrPubkeyJSONBody
+
 // SetAdminPasswordJSONRequestBody defines body for SetAdminPassword for application/json ContentType.
 type SetAdminPasswordJSONRequestBody = AdminConfigValue
 
@@ -972,12 +1515,33 @@ type SetLogoJSONRequestBody = AdminConfigValue
 // SetServerNameJSONRequestBody defines body for SetServerName for application/json ContentType.
 type SetServerNameJSONRequestBody = AdminConfigValue
 
+// SetNostrLegacyVideoEventsJSONRequestBody defines body for SetNostrLegacyVideoEvents for application/json ContentType.
+type SetNostrLegacyVideoEventsJSONRequestBody = AdminConfigValue
+
+// SetNostrLightningAddressJSONRequestBody defines body for SetNostrLightningAddress for application/json ContentType.
+type SetNostrLightningAddressJSONRequestBody = AdminConfigValue
+
+// SetNostrNIP05NamesJSONRequestBody defines body for SetNostrNIP05Names for application/json ContentType.
+type SetNostrNIP05NamesJSONRequestBody = AdminConfigValue
+
+// SetNostrRelayEnabledJSONRequestBody defines body for SetNostrRelayEnabled for application/json ContentType.
+type SetNostrRelayEnabledJSONRequestBody = AdminConfigValue
+
+// SetNostrRelaysJSONRequestBody defines body for SetNostrRelays for application/json ContentType.
+type SetNostrRelaysJSONRequestBody = AdminConfigValue
+
+// SetNostrWalletConnectJSONRequestBody defines body for SetNostrWalletConnect for application/json ContentType.
+type SetNostrWalletConnectJSONRequestBody = AdminConfigValue
+
 // SetBrowserNotificationConfigurationJSONRequestBody defines body for SetBrowserNotificationConfiguration for application/json ContentType.
 type SetBrowserNotificationConfigurationJSONRequestBody SetBrowserNotificationConfigurationJSONBody
 
 // SetDiscordNotificationConfigurationJSONRequestBody defines body for SetDiscordNotificationConfiguration for application/json ContentType.
 type SetDiscordNotificationConfigurationJSONRequestBody SetDiscordNotificationConfigurationJSONBody
 
+// SetNostrNotificationConfigurationJSONRequestBody defines body for SetNostrNotificationConfiguration for application/json ContentType.
+type SetNostrNotificationConfigurationJSONRequestBody SetNostrNotificationConfigurationJSONBody
+
 // SetNSFWJSONRequestBody defines body for SetNSFW for application/json ContentType.
 type SetNSFWJSONRequestBody = AdminConfigValue
 
@@ -987,9 +1551,24 @@ type SetCustomOfflineMessageJSONRequestBody = AdminConfigValue
 // SetExtraPageContentJSONRequestBody defines body for SetExtraPageContent for application/json ContentType.
 type SetExtraPageContentJSONRequestBody = AdminConfigValue
 
+// SetPublishCallbackJSONRequestBody defines body for SetPublishCallback for application/json ContentType.
+type SetPublishCallbackJSONRequestBody SetPublishCallbackJSONBody
+
+// SetReconnectGracePeriodJSONRequestBody defines body for SetReconnectGracePeriod for application/json ContentType.
+type SetReconnectGracePeriodJSONRequestBody = AdminConfigValue
+
+// SetRestreamDestinationsJSONRequestBody defines body for SetRestreamDestinations for application/json ContentType.
+type SetRestreamDestinationsJSONRequestBody SetRestreamDestinationsJSONBody
+
+// SetRTMPSConfigJSONRequestBody defines body for SetRTMPSConfig for application/json ContentType.
+type SetRTMPSConfigJSONRequestBody SetRTMPSConfigJSONBody
+
 // SetRTMPServerPortJSONRequestBody defines body for SetRTMPServerPort for application/json ContentType.
 type SetRTMPServerPortJSONRequestBody = AdminConfigValue
 
+// SetRTMPTakeoverJSONRequestBody defines body for SetRTMPTakeover for application/json ContentType.
+type SetRTMPTakeoverJSONRequestBody SetRTMPTakeoverJSONBody
+
 // SetS3ConfigurationJSONRequestBody defines body for SetS3Configuration for application/json ContentType.
 type SetS3ConfigurationJSONRequestBody SetS3ConfigurationJSONBody
 
@@ -1005,6 +1584,9 @@ type SetSocialHandlesJSONRequestBody SetSocialHandlesJSONBody
 // SetSocketHostOverrideJSONRequestBody defines body for SetSocketHostOverride for application/json ContentType.
 type SetSocketHostOverrideJSONRequestBody = AdminConfigValue
 
+// SetSRTServerPortJSONRequestBody defines body for SetSRTServerPort for application/json ContentType.
+type SetSRTServerPortJSONRequestBody = AdminConfigValue
+
 // SetStreamKeysJSONRequestBody defines body for SetStreamKeys for application/json ContentType.
 type SetStreamKeysJSONRequestBody SetStreamKeysJSONBody
 
@@ -1026,6 +1608,9 @@ type SetStreamOutputVariantsJSONRequestBody SetStreamOutputVariantsJSONBody
 // SetVideoServingEndpointJSONRequestBody defines body for SetVideoServingEndpoint for application/json ContentType.
 type SetVideoServingEndpointJSONRequestBody = AdminConfigValue
 
+// SetWebRTCServerPortJSONRequestBody defines body for SetWebRTCServerPort for application/json ContentType.
+type SetWebRTCServerPortJSONRequestBody = AdminConfigValue
+
 // SetWebServerIPJSONRequestBody defines body for SetWebServerIP for application/json ContentType.
 type SetWebServerIPJSONRequestBody = AdminConfigValue
 
@@ -1047,6 +1632,21 @@ type SendFederatedMessageJSONRequestBody = AdminConfigValue
 // ApproveFollowerJSONRequestBody defines body for ApproveFollower for application/json ContentType.
 type ApproveFollowerJSONRequestBody ApproveFollowerJSONBody
 
+// RemoveAdminPubkeyJSONRequestBody defines body for RemoveAdminPubkey for application/json ContentType.
+type RemoveAdminPubkeyJSONRequestBody RemoveAdminPubkeyJSONBody
+
+// SetAdminPubkeyRoleJSONRequestBody defines body for SetAdminPubkeyRole for application/json ContentType.
+type SetAdminPubkeyRoleJSONRequestBody SetAdminPubkeyRoleJSONBody
+
+// ImportNostrIdentityJSONRequestBody defines body for ImportNostrIdentity for application/json ContentType.
+type ImportNostrIdentityJSONRequestBody = AdminConfigValue
+
+// DeleteNostrRecordingAnnouncementJSONRequestBody defines body for DeleteNostrRecordingAnnouncement for application/json ContentType.
+type DeleteNostrRecordingAnnouncementJSONRequestBody DeleteNostrRecordingAnnouncementJSONBody
+
+// UpdateNostrRecordingJSONRequestBody defines body for UpdateNostrRecording for application/json ContentType.
+type UpdateNostrRecordingJSONRequestBody UpdateNostrRecordingJSONBody
+
 // CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
 type CreateWebhookJSONRequestBody CreateWebhookJSONBody
 
@@ -1062,6 +1662,12 @@ type VerifyFediverseOTPRequestJSONRequestBody VerifyFediverseOTPRequestJSONBody
 // StartIndieAuthFlowJSONRequestBody defines body for StartIndieAuthFlow for application/json ContentType.
 type StartIndieAuthFlowJSONRequestBody StartIndieAuthFlowJSONBody
 
+// SetNostrNIP05JSONRequestBody defines body for SetNostrNIP05 for application/json ContentType.
+type SetNostrNIP05JSONRequestBody SetNostrNIP05JSONBody
+
+// VerifyNostrAuthChallengeJSONRequestBody defines body for VerifyNostrAuthChallenge for application/json ContentType.
+type VerifyNostrAuthChallengeJSONRequestBody VerifyNostrAuthChallengeJSONBody
+
 // HandleIndieAuthEndpointPostFormdataRequestBody defines body for HandleIndieAuthEndpointPost for application/x-www-form-urlencoded ContentType.
 type HandleIndieAuthEndpointPostFormdataRequestBody HandleIndieAuthEndpointPostFormdataBody
 
@@ -1095,6 +1701,9 @@ type ExternalSetStreamTitleJSONRequestBody = AdminConfigValue
 // ReportPlaybackMetricsJSONRequestBody defines body for ReportPlaybackMetrics for application/json ContentType.
 type ReportPlaybackMetricsJSONRequestBody = PlaybackMetrics
 
+// UnregisterForLiveNotificationsJSONRequestBody defines body for UnregisterForLiveNotifications for application/json ContentType.
+type UnregisterForLiveNotificationsJSONRequestBody UnregisterForLiveNotificationsJSONBody
+
 // RegisterForLiveNotificationsJSONRequestBody defines body for RegisterForLiveNotifications for application/json ContentType.
 type RegisterForLiveNotificationsJSONRequestBody RegisterForLiveNotificationsJSONBody
 
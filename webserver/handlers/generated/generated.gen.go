@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
 package generated
 
 import (
@@ -32,6 +32,18 @@ type ServerInterface interface {
 	// Delete a single external API user
 	// (POST /admin/accesstokens/delete)
 	DeleteExternalAPIUser(w http.ResponseWriter, r *http.Request)
+	// Get a paginated list of the changes made through the admin API
+	// (GET /admin/audit)
+	GetAdminAuditLog(w http.ResponseWriter, r *http.Request, params GetAdminAuditLogParams)
+
+	// (OPTIONS /admin/audit)
+	GetAdminAuditLogOptions(w http.ResponseWriter, r *http.Request)
+	// Get a paginated list of the broadcasts and the stream keys that started them
+	// (GET /admin/broadcasts)
+	GetBroadcastHistory(w http.ResponseWriter, r *http.Request, params GetBroadcastHistoryParams)
+
+	// (OPTIONS /admin/broadcasts)
+	GetBroadcastHistoryOptions(w http.ResponseWriter, r *http.Request)
 	// Get a detailed list of currently connected chat clients
 	// (GET /admin/chat/clients)
 	GetConnectedChatClients(w http.ResponseWriter, r *http.Request)
@@ -50,6 +62,24 @@ type ServerInterface interface {
 	// Update visibility of chat messages
 	// (POST /admin/chat/messagevisibility)
 	UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request)
+	// Get all Nostr pubkeys banned from chat
+	// (GET /admin/chat/nostr/bans)
+	GetNostrPubkeyBans(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/nostr/bans)
+	GetNostrPubkeyBansOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/nostr/bans/create)
+	BanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request)
+	// Ban a Nostr pubkey from chat
+	// (POST /admin/chat/nostr/bans/create)
+	BanNostrPubkey(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/nostr/bans/remove)
+	UnbanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request)
+	// Remove a Nostr pubkey ban
+	// (POST /admin/chat/nostr/bans/remove)
+	UnbanNostrPubkey(w http.ResponseWriter, r *http.Request)
 	// Get a list of disabled users
 	// (GET /admin/chat/users/disabled)
 	GetDisabledUsers(w http.ResponseWriter, r *http.Request)
@@ -92,6 +122,15 @@ type ServerInterface interface {
 	// Set moderator status for a user
 	// (POST /admin/chat/users/setmoderator)
 	UpdateUserModerator(w http.ResponseWriter, r *http.Request)
+	// Return the hex Nostr pubkey authorized for admin access
+	// (GET /admin/config/adminnostrpubkey)
+	GetAdminNostrPubkey(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/adminnostrpubkey)
+	SetAdminNostrPubkeyOptions(w http.ResponseWriter, r *http.Request)
+	// Set the hex Nostr pubkey authorized for admin access
+	// (POST /admin/config/adminnostrpubkey)
+	SetAdminNostrPubkey(w http.ResponseWriter, r *http.Request)
 
 	// (OPTIONS /admin/config/adminpass)
 	SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request)
@@ -237,6 +276,42 @@ type ServerInterface interface {
 	// (POST /admin/config/name)
 	SetServerName(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/nostr/legacyvideoevents)
+	SetNostrLegacyVideoEventsOptions(w http.ResponseWriter, r *http.Request)
+	// Set if recordings are also announced with kind 34235 video events
+	// (POST /admin/config/nostr/legacyvideoevents)
+	SetNostrLegacyVideoEvents(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/nostr/lightningaddress)
+	SetNostrLightningAddressOptions(w http.ResponseWriter, r *http.Request)
+	// Set the lightning address zaps to the stream are forwarded to
+	// (POST /admin/config/nostr/lightningaddress)
+	SetNostrLightningAddress(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/nostr/nip05names)
+	SetNostrNIP05NamesOptions(w http.ResponseWriter, r *http.Request)
+	// Set the extra NIP-05 names served for the server
+	// (POST /admin/config/nostr/nip05names)
+	SetNostrNIP05Names(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/nostr/relayenabled)
+	SetNostrRelayEnabledOptions(w http.ResponseWriter, r *http.Request)
+	// Enable or disable the built-in Nostr relay for the stream
+	// (POST /admin/config/nostr/relayenabled)
+	SetNostrRelayEnabled(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/nostr/relays)
+	SetNostrRelaysOptions(w http.ResponseWriter, r *http.Request)
+	// Set the Nostr relays the server publishes events to
+	// (POST /admin/config/nostr/relays)
+	SetNostrRelays(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/nostr/walletconnect)
+	SetNostrWalletConnectOptions(w http.ResponseWriter, r *http.Request)
+	// Connect the streamer's wallet with Nostr Wallet Connect
+	// (POST /admin/config/nostr/walletconnect)
+	SetNostrWalletConnect(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/notifications/browser)
 	SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
 	// Configure Browser notifications
@@ -249,6 +324,12 @@ type ServerInterface interface {
 	// (POST /admin/config/notifications/discord)
 	SetDiscordNotificationConfiguration(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/notifications/nostr)
+	SetNostrNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure Nostr direct message notifications
+	// (POST /admin/config/notifications/nostr)
+	SetNostrNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/nsfw)
 	SetNSFWOptions(w http.ResponseWriter, r *http.Request)
 	// Update NSFW marking
@@ -267,12 +348,42 @@ type ServerInterface interface {
 	// (POST /admin/config/pagecontent)
 	SetExtraPageContent(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/publishcallback)
+	SetPublishCallbackOptions(w http.ResponseWriter, r *http.Request)
+	// Set the publish callback
+	// (POST /admin/config/publishcallback)
+	SetPublishCallback(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/reconnectgraceperiod)
+	SetReconnectGracePeriodOptions(w http.ResponseWriter, r *http.Request)
+	// Set how long to wait for the broadcaster to reconnect
+	// (POST /admin/config/reconnectgraceperiod)
+	SetReconnectGracePeriod(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/restream)
+	SetRestreamDestinationsOptions(w http.ResponseWriter, r *http.Request)
+	// Set the restream destinations
+	// (POST /admin/config/restream)
+	SetRestreamDestinations(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/rtmps)
+	SetRTMPSConfigOptions(w http.ResponseWriter, r *http.Request)
+	// Configure the RTMPS listener
+	// (POST /admin/config/rtmps)
+	SetRTMPSConfig(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/rtmpserverport)
 	SetRTMPServerPortOptions(w http.ResponseWriter, r *http.Request)
 	// Update RTMP post
 	// (POST /admin/config/rtmpserverport)
 	SetRTMPServerPort(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/rtmptakeover)
+	SetRTMPTakeoverOptions(w http.ResponseWriter, r *http.Request)
+	// Set how a new RTMP publisher may take over the running stream
+	// (POST /admin/config/rtmptakeover)
+	SetRTMPTakeover(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/s3)
 	SetS3ConfigurationOptions(w http.ResponseWriter, r *http.Request)
 	// Update S3 configuration
@@ -303,6 +414,12 @@ type ServerInterface interface {
 	// (POST /admin/config/sockethostoverride)
 	SetSocketHostOverride(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/srtserverport)
+	SetSRTServerPortOptions(w http.ResponseWriter, r *http.Request)
+	// Update SRT port
+	// (POST /admin/config/srtserverport)
+	SetSRTServerPort(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/streamkeys)
 	SetStreamKeysOptions(w http.ResponseWriter, r *http.Request)
 	// Set an array of valid stream keys
@@ -345,6 +462,12 @@ type ServerInterface interface {
 	// (POST /admin/config/videoservingendpoint)
 	SetVideoServingEndpoint(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/webrtcport)
+	SetWebRTCServerPortOptions(w http.ResponseWriter, r *http.Request)
+	// Update the UDP port of WHIP WebRTC media
+	// (POST /admin/config/webrtcport)
+	SetWebRTCServerPort(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/webserverip)
 	SetWebServerIPOptions(w http.ResponseWriter, r *http.Request)
 	// Update server IP address
@@ -434,12 +557,90 @@ type ServerInterface interface {
 
 	// (OPTIONS /admin/logs/warnings)
 	GetWarningsOptions(w http.ResponseWriter, r *http.Request)
+	// Get inbound stream health metrics
+	// (GET /admin/metrics/inbound)
+	GetInboundStreamMetrics(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/metrics/inbound)
+	GetInboundStreamMetricsOptions(w http.ResponseWriter, r *http.Request)
 	// Get video playback metrics
 	// (GET /admin/metrics/video)
 	GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request)
 
 	// (OPTIONS /admin/metrics/video)
 	GetVideoPlaybackMetricsOptions(w http.ResponseWriter, r *http.Request)
+	// Get the pubkey and role the request was authenticated with
+	// (GET /admin/nostr/access)
+	GetAdminAccess(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/access)
+	GetAdminAccessOptions(w http.ResponseWriter, r *http.Request)
+	// Get the Nostr pubkeys with a role on the admin API
+	// (GET /admin/nostr/admins)
+	GetAdminPubkeys(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/admins)
+	GetAdminPubkeysOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/admins/remove)
+	RemoveAdminPubkeyOptions(w http.ResponseWriter, r *http.Request)
+	// Remove the role a Nostr pubkey has on the admin API
+	// (POST /admin/nostr/admins/remove)
+	RemoveAdminPubkey(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/admins/set)
+	SetAdminPubkeyRoleOptions(w http.ResponseWriter, r *http.Request)
+	// Give a Nostr pubkey a role on the admin API
+	// (POST /admin/nostr/admins/set)
+	SetAdminPubkeyRole(w http.ResponseWriter, r *http.Request)
+	// Get the Nostr identity the server signs events with
+	// (GET /admin/nostr/identity)
+	GetNostrIdentity(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/identity)
+	GetNostrIdentityOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/identity/import)
+	ImportNostrIdentityOptions(w http.ResponseWriter, r *http.Request)
+	// Replace the server Nostr key with an existing nsec or hex private key
+	// (POST /admin/nostr/identity/import)
+	ImportNostrIdentity(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/identity/rotate)
+	RotateNostrIdentityOptions(w http.ResponseWriter, r *http.Request)
+	// Replace the server Nostr key with a newly generated one
+	// (POST /admin/nostr/identity/rotate)
+	RotateNostrIdentity(w http.ResponseWriter, r *http.Request)
+	// Get a paginated list of past broadcasts and their recordings
+	// (GET /admin/nostr/recordings)
+	GetNostrRecordings(w http.ResponseWriter, r *http.Request, params GetNostrRecordingsParams)
+
+	// (OPTIONS /admin/nostr/recordings)
+	GetNostrRecordingsOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/recordings/delete)
+	DeleteNostrRecordingAnnouncementOptions(w http.ResponseWriter, r *http.Request)
+	// Delete the NIP-71 video events announcing a recording
+	// (POST /admin/nostr/recordings/delete)
+	DeleteNostrRecordingAnnouncement(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/recordings/update)
+	UpdateNostrRecordingOptions(w http.ResponseWriter, r *http.Request)
+	// Update a recording and announce it as a NIP-71 video event
+	// (POST /admin/nostr/recordings/update)
+	UpdateNostrRecording(w http.ResponseWriter, r *http.Request)
+	// Get the connection health of each Nostr relay
+	// (GET /admin/nostr/relays)
+	GetNostrRelayHealth(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/relays)
+	GetNostrRelayHealthOptions(w http.ResponseWriter, r *http.Request)
+	// Get the total of the zaps sent to each stream
+	// (GET /admin/nostr/zaps)
+	GetNostrZapTotals(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/nostr/zaps)
+	GetNostrZapTotalsOptions(w http.ResponseWriter, r *http.Request)
 	// Endpoint to interface with Prometheus
 	// (DELETE /admin/prometheus)
 	DeletePrometheusAPI(w http.ResponseWriter, r *http.Request)
@@ -533,6 +734,15 @@ type ServerInterface interface {
 	// Handle the redirect from an IndieAuth server to continue the auth flow
 	// (GET /auth/indieauth/callback)
 	HandleIndieAuthRedirect(w http.ResponseWriter, r *http.Request, params HandleIndieAuthRedirectParams)
+	// Register a Nostr auth challenge
+	// (POST /auth/nostr)
+	RegisterNostrAuthChallenge(w http.ResponseWriter, r *http.Request, params RegisterNostrAuthChallengeParams)
+	// Set the NIP-05 identifier of a Nostr authenticated chat user
+	// (POST /auth/nostr/nip05)
+	SetNostrNIP05(w http.ResponseWriter, r *http.Request, params SetNostrNIP05Params)
+	// Verify a signed Nostr auth challenge
+	// (POST /auth/nostr/verify)
+	VerifyNostrAuthChallenge(w http.ResponseWriter, r *http.Request, params VerifyNostrAuthChallengeParams)
 	// Handles the IndieAuth auth endpoint
 	// (GET /auth/provider/indieauth)
 	HandleIndieAuthEndpointGet(w http.ResponseWriter, r *http.Request, params HandleIndieAuthEndpointGetParams)
@@ -629,6 +839,9 @@ type ServerInterface interface {
 	// Get a user's details
 	// (GET /moderation/chat/user/{userId})
 	GetUserDetails(w http.ResponseWriter, r *http.Request, userId string, params GetUserDetailsParams)
+	// Unregister from notifications
+	// (DELETE /notifications/register)
+	UnregisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params UnregisterForLiveNotificationsParams)
 	// Register for notifications
 	// (POST /notifications/register)
 	RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params RegisterForLiveNotificationsParams)
@@ -689,6 +902,28 @@ func (_ Unimplemented) DeleteExternalAPIUser(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a paginated list of the changes made through the admin API
+// (GET /admin/audit)
+func (_ Unimplemented) GetAdminAuditLog(w http.ResponseWriter, r *http.Request, params GetAdminAuditLogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/audit)
+func (_ Unimplemented) GetAdminAuditLogOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a paginated list of the broadcasts and the stream keys that started them
+// (GET /admin/broadcasts)
+func (_ Unimplemented) GetBroadcastHistory(w http.ResponseWriter, r *http.Request, params GetBroadcastHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/broadcasts)
+func (_ Unimplemented) GetBroadcastHistoryOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a detailed list of currently connected chat clients
 // (GET /admin/chat/clients)
 func (_ Unimplemented) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
@@ -722,6 +957,39 @@ func (_ Unimplemented) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get all Nostr pubkeys banned from chat
+// (GET /admin/chat/nostr/bans)
+func (_ Unimplemented) GetNostrPubkeyBans(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/nostr/bans)
+func (_ Unimplemented) GetNostrPubkeyBansOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/nostr/bans/create)
+func (_ Unimplemented) BanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Ban a Nostr pubkey from chat
+// (POST /admin/chat/nostr/bans/create)
+func (_ Unimplemented) BanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/nostr/bans/remove)
+func (_ Unimplemented) UnbanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a Nostr pubkey ban
+// (POST /admin/chat/nostr/bans/remove)
+func (_ Unimplemented) UnbanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a list of disabled users
 // (GET /admin/chat/users/disabled)
 func (_ Unimplemented) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
@@ -799,6 +1067,23 @@ func (_ Unimplemented) UpdateUserModerator(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Return the hex Nostr pubkey authorized for admin access
+// (GET /admin/config/adminnostrpubkey)
+func (_ Unimplemented) GetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/adminnostrpubkey)
+func (_ Unimplemented) SetAdminNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the hex Nostr pubkey authorized for admin access
+// (POST /admin/config/adminnostrpubkey)
+func (_ Unimplemented) SetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/adminpass)
 func (_ Unimplemented) SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1063,6 +1348,72 @@ func (_ Unimplemented) SetServerName(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/nostr/legacyvideoevents)
+func (_ Unimplemented) SetNostrLegacyVideoEventsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set if recordings are also announced with kind 34235 video events
+// (POST /admin/config/nostr/legacyvideoevents)
+func (_ Unimplemented) SetNostrLegacyVideoEvents(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/nostr/lightningaddress)
+func (_ Unimplemented) SetNostrLightningAddressOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the lightning address zaps to the stream are forwarded to
+// (POST /admin/config/nostr/lightningaddress)
+func (_ Unimplemented) SetNostrLightningAddress(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/nostr/nip05names)
+func (_ Unimplemented) SetNostrNIP05NamesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the extra NIP-05 names served for the server
+// (POST /admin/config/nostr/nip05names)
+func (_ Unimplemented) SetNostrNIP05Names(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/nostr/relayenabled)
+func (_ Unimplemented) SetNostrRelayEnabledOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Enable or disable the built-in Nostr relay for the stream
+// (POST /admin/config/nostr/relayenabled)
+func (_ Unimplemented) SetNostrRelayEnabled(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/nostr/relays)
+func (_ Unimplemented) SetNostrRelaysOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the Nostr relays the server publishes events to
+// (POST /admin/config/nostr/relays)
+func (_ Unimplemented) SetNostrRelays(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/nostr/walletconnect)
+func (_ Unimplemented) SetNostrWalletConnectOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Connect the streamer's wallet with Nostr Wallet Connect
+// (POST /admin/config/nostr/walletconnect)
+func (_ Unimplemented) SetNostrWalletConnect(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/notifications/browser)
 func (_ Unimplemented) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1085,6 +1436,17 @@ func (_ Unimplemented) SetDiscordNotificationConfiguration(w http.ResponseWriter
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/notifications/nostr)
+func (_ Unimplemented) SetNostrNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure Nostr direct message notifications
+// (POST /admin/config/notifications/nostr)
+func (_ Unimplemented) SetNostrNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/nsfw)
 func (_ Unimplemented) SetNSFWOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1118,6 +1480,50 @@ func (_ Unimplemented) SetExtraPageContent(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/publishcallback)
+func (_ Unimplemented) SetPublishCallbackOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the publish callback
+// (POST /admin/config/publishcallback)
+func (_ Unimplemented) SetPublishCallback(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/reconnectgraceperiod)
+func (_ Unimplemented) SetReconnectGracePeriodOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set how long to wait for the broadcaster to reconnect
+// (POST /admin/config/reconnectgraceperiod)
+func (_ Unimplemented) SetReconnectGracePeriod(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/restream)
+func (_ Unimplemented) SetRestreamDestinationsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the restream destinations
+// (POST /admin/config/restream)
+func (_ Unimplemented) SetRestreamDestinations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/rtmps)
+func (_ Unimplemented) SetRTMPSConfigOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure the RTMPS listener
+// (POST /admin/config/rtmps)
+func (_ Unimplemented) SetRTMPSConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/rtmpserverport)
 func (_ Unimplemented) SetRTMPServerPortOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1129,6 +1535,17 @@ func (_ Unimplemented) SetRTMPServerPort(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/rtmptakeover)
+func (_ Unimplemented) SetRTMPTakeoverOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set how a new RTMP publisher may take over the running stream
+// (POST /admin/config/rtmptakeover)
+func (_ Unimplemented) SetRTMPTakeover(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/s3)
 func (_ Unimplemented) SetS3ConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1184,6 +1601,17 @@ func (_ Unimplemented) SetSocketHostOverride(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/srtserverport)
+func (_ Unimplemented) SetSRTServerPortOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update SRT port
+// (POST /admin/config/srtserverport)
+func (_ Unimplemented) SetSRTServerPort(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/streamkeys)
 func (_ Unimplemented) SetStreamKeysOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1261,6 +1689,17 @@ func (_ Unimplemented) SetVideoServingEndpoint(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/webrtcport)
+func (_ Unimplemented) SetWebRTCServerPortOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the UDP port of WHIP WebRTC media
+// (POST /admin/config/webrtcport)
+func (_ Unimplemented) SetWebRTCServerPort(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/webserverip)
 func (_ Unimplemented) SetWebServerIPOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1426,6 +1865,17 @@ func (_ Unimplemented) GetWarningsOptions(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get inbound stream health metrics
+// (GET /admin/metrics/inbound)
+func (_ Unimplemented) GetInboundStreamMetrics(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/metrics/inbound)
+func (_ Unimplemented) GetInboundStreamMetricsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get video playback metrics
 // (GET /admin/metrics/video)
 func (_ Unimplemented) GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
@@ -1437,6 +1887,138 @@ func (_ Unimplemented) GetVideoPlaybackMetricsOptions(w http.ResponseWriter, r *
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the pubkey and role the request was authenticated with
+// (GET /admin/nostr/access)
+func (_ Unimplemented) GetAdminAccess(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/access)
+func (_ Unimplemented) GetAdminAccessOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the Nostr pubkeys with a role on the admin API
+// (GET /admin/nostr/admins)
+func (_ Unimplemented) GetAdminPubkeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/admins)
+func (_ Unimplemented) GetAdminPubkeysOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/admins/remove)
+func (_ Unimplemented) RemoveAdminPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove the role a Nostr pubkey has on the admin API
+// (POST /admin/nostr/admins/remove)
+func (_ Unimplemented) RemoveAdminPubkey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/admins/set)
+func (_ Unimplemented) SetAdminPubkeyRoleOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Give a Nostr pubkey a role on the admin API
+// (POST /admin/nostr/admins/set)
+func (_ Unimplemented) SetAdminPubkeyRole(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the Nostr identity the server signs events with
+// (GET /admin/nostr/identity)
+func (_ Unimplemented) GetNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/identity)
+func (_ Unimplemented) GetNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/identity/import)
+func (_ Unimplemented) ImportNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the server Nostr key with an existing nsec or hex private key
+// (POST /admin/nostr/identity/import)
+func (_ Unimplemented) ImportNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/identity/rotate)
+func (_ Unimplemented) RotateNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the server Nostr key with a newly generated one
+// (POST /admin/nostr/identity/rotate)
+func (_ Unimplemented) RotateNostrIdentity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a paginated list of past broadcasts and their recordings
+// (GET /admin/nostr/recordings)
+func (_ Unimplemented) GetNostrRecordings(w http.ResponseWriter, r *http.Request, params GetNostrRecordingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/recordings)
+func (_ Unimplemented) GetNostrRecordingsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/recordings/delete)
+func (_ Unimplemented) DeleteNostrRecordingAnnouncementOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete the NIP-71 video events announcing a recording
+// (POST /admin/nostr/recordings/delete)
+func (_ Unimplemented) DeleteNostrRecordingAnnouncement(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/recordings/update)
+func (_ Unimplemented) UpdateNostrRecordingOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a recording and announce it as a NIP-71 video event
+// (POST /admin/nostr/recordings/update)
+func (_ Unimplemented) UpdateNostrRecording(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the connection health of each Nostr relay
+// (GET /admin/nostr/relays)
+func (_ Unimplemented) GetNostrRelayHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/relays)
+func (_ Unimplemented) GetNostrRelayHealthOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the total of the zaps sent to each stream
+// (GET /admin/nostr/zaps)
+func (_ Unimplemented) GetNostrZapTotals(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/nostr/zaps)
+func (_ Unimplemented) GetNostrZapTotalsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Endpoint to interface with Prometheus
 // (DELETE /admin/prometheus)
 func (_ Unimplemented) DeletePrometheusAPI(w http.ResponseWriter, r *http.Request) {
@@ -1612,6 +2194,24 @@ func (_ Unimplemented) HandleIndieAuthRedirect(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Register a Nostr auth challenge
+// (POST /auth/nostr)
+func (_ Unimplemented) RegisterNostrAuthChallenge(w http.ResponseWriter, r *http.Request, params RegisterNostrAuthChallengeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the NIP-05 identifier of a Nostr authenticated chat user
+// (POST /auth/nostr/nip05)
+func (_ Unimplemented) SetNostrNIP05(w http.ResponseWriter, r *http.Request, params SetNostrNIP05Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify a signed Nostr auth challenge
+// (POST /auth/nostr/verify)
+func (_ Unimplemented) VerifyNostrAuthChallenge(w http.ResponseWriter, r *http.Request, params VerifyNostrAuthChallengeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Handles the IndieAuth auth endpoint
 // (GET /auth/provider/indieauth)
 func (_ Unimplemented) HandleIndieAuthEndpointGet(w http.ResponseWriter, r *http.Request, params HandleIndieAuthEndpointGetParams) {
@@ -1794,6 +2394,12 @@ func (_ Unimplemented) GetUserDetails(w http.ResponseWriter, r *http.Request, us
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Unregister from notifications
+// (DELETE /notifications/register)
+func (_ Unimplemented) UnregisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params UnregisterForLiveNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Register for notifications
 // (POST /notifications/register)
 func (_ Unimplemented) RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params RegisterForLiveNotificationsParams) {
@@ -1847,10 +2453,13 @@ type MiddlewareFunc func(http.Handler) http.Handler
 
 // GetExternalAPIUsers operation middleware
 func (siw *ServerInterfaceWrapper) GetExternalAPIUsers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetExternalAPIUsers(w, r)
 	}))
@@ -1859,12 +2468,11 @@ func (siw *ServerInterfaceWrapper) GetExternalAPIUsers(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetExternalAPIUsersOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetExternalAPIUsersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetExternalAPIUsersOptions(w, r)
@@ -1874,12 +2482,11 @@ func (siw *ServerInterfaceWrapper) GetExternalAPIUsersOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // CreateExternalAPIUserOptions operation middleware
 func (siw *ServerInterfaceWrapper) CreateExternalAPIUserOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateExternalAPIUserOptions(w, r)
@@ -1889,15 +2496,18 @@ func (siw *ServerInterfaceWrapper) CreateExternalAPIUserOptions(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // CreateExternalAPIUser operation middleware
 func (siw *ServerInterfaceWrapper) CreateExternalAPIUser(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateExternalAPIUser(w, r)
 	}))
@@ -1906,12 +2516,11 @@ func (siw *ServerInterfaceWrapper) CreateExternalAPIUser(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeleteExternalAPIUserOptions operation middleware
 func (siw *ServerInterfaceWrapper) DeleteExternalAPIUserOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteExternalAPIUserOptions(w, r)
@@ -1921,15 +2530,18 @@ func (siw *ServerInterfaceWrapper) DeleteExternalAPIUserOptions(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeleteExternalAPIUser operation middleware
 func (siw *ServerInterfaceWrapper) DeleteExternalAPIUser(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteExternalAPIUser(w, r)
 	}))
@@ -1938,30 +2550,142 @@ func (siw *ServerInterfaceWrapper) DeleteExternalAPIUser(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetConnectedChatClients operation middleware
-func (siw *ServerInterfaceWrapper) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
+// GetAdminAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditLogParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnectedChatClients(w, r)
+		siw.Handler.GetAdminAuditLog(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetConnectedChatClientsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
+// GetAdminAuditLogOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAuditLogOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminAuditLogOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBroadcastHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastHistoryParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBroadcastHistoryOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastHistoryOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBroadcastHistoryOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConnectedChatClients operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnectedChatClients(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConnectedChatClientsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetConnectedChatClientsOptions(w, r)
 	}))
@@ -1970,15 +2694,18 @@ func (siw *ServerInterfaceWrapper) GetConnectedChatClientsOptions(w http.Respons
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetChatMessagesAdmin operation middleware
 func (siw *ServerInterfaceWrapper) GetChatMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetChatMessagesAdmin(w, r)
 	}))
@@ -1987,12 +2714,11 @@ func (siw *ServerInterfaceWrapper) GetChatMessagesAdmin(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetChatMessagesAdminOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetChatMessagesAdminOptions(w, r)
@@ -2002,12 +2728,11 @@ func (siw *ServerInterfaceWrapper) GetChatMessagesAdminOptions(w http.ResponseWr
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateMessageVisibilityAdminOptions operation middleware
 func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateMessageVisibilityAdminOptions(w, r)
@@ -2017,15 +2742,18 @@ func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdminOptions(w http.Re
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateMessageVisibilityAdmin operation middleware
 func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateMessageVisibilityAdmin(w, r)
 	}))
@@ -2034,15 +2762,120 @@ func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdmin(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
+}
+
+// GetNostrPubkeyBans operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrPubkeyBans(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNostrPubkeyBans(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNostrPubkeyBansOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrPubkeyBansOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNostrPubkeyBansOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BanNostrPubkeyOptions operation middleware
+func (siw *ServerInterfaceWrapper) BanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BanNostrPubkeyOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BanNostrPubkey operation middleware
+func (siw *ServerInterfaceWrapper) BanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BanNostrPubkey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnbanNostrPubkeyOptions operation middleware
+func (siw *ServerInterfaceWrapper) UnbanNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnbanNostrPubkeyOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnbanNostrPubkey operation middleware
+func (siw *ServerInterfaceWrapper) UnbanNostrPubkey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnbanNostrPubkey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
 // GetDisabledUsers operation middleware
 func (siw *ServerInterfaceWrapper) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetDisabledUsers(w, r)
 	}))
@@ -2051,12 +2884,11 @@ func (siw *ServerInterfaceWrapper) GetDisabledUsers(w http.ResponseWriter, r *ht
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetDisabledUsersOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetDisabledUsersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetDisabledUsersOptions(w, r)
@@ -2066,15 +2898,18 @@ func (siw *ServerInterfaceWrapper) GetDisabledUsersOptions(w http.ResponseWriter
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetIPAddressBans operation middleware
 func (siw *ServerInterfaceWrapper) GetIPAddressBans(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetIPAddressBans(w, r)
 	}))
@@ -2083,12 +2918,11 @@ func (siw *ServerInterfaceWrapper) GetIPAddressBans(w http.ResponseWriter, r *ht
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetIPAddressBansOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetIPAddressBansOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetIPAddressBansOptions(w, r)
@@ -2098,12 +2932,11 @@ func (siw *ServerInterfaceWrapper) GetIPAddressBansOptions(w http.ResponseWriter
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // BanIPAddressOptions operation middleware
 func (siw *ServerInterfaceWrapper) BanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.BanIPAddressOptions(w, r)
@@ -2113,15 +2946,18 @@ func (siw *ServerInterfaceWrapper) BanIPAddressOptions(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // BanIPAddress operation middleware
 func (siw *ServerInterfaceWrapper) BanIPAddress(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.BanIPAddress(w, r)
 	}))
@@ -2130,12 +2966,11 @@ func (siw *ServerInterfaceWrapper) BanIPAddress(w http.ResponseWriter, r *http.R
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UnbanIPAddressOptions operation middleware
 func (siw *ServerInterfaceWrapper) UnbanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UnbanIPAddressOptions(w, r)
@@ -2145,15 +2980,18 @@ func (siw *ServerInterfaceWrapper) UnbanIPAddressOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UnbanIPAddress operation middleware
 func (siw *ServerInterfaceWrapper) UnbanIPAddress(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UnbanIPAddress(w, r)
 	}))
@@ -2162,15 +3000,18 @@ func (siw *ServerInterfaceWrapper) UnbanIPAddress(w http.ResponseWriter, r *http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetModerators operation middleware
 func (siw *ServerInterfaceWrapper) GetModerators(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetModerators(w, r)
 	}))
@@ -2179,12 +3020,11 @@ func (siw *ServerInterfaceWrapper) GetModerators(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetModeratorsOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetModeratorsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetModeratorsOptions(w, r)
@@ -2194,12 +3034,11 @@ func (siw *ServerInterfaceWrapper) GetModeratorsOptions(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateUserEnabledAdminOptions operation middleware
 func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateUserEnabledAdminOptions(w, r)
@@ -2209,15 +3048,18 @@ func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdminOptions(w http.Response
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateUserEnabledAdmin operation middleware
 func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateUserEnabledAdmin(w, r)
 	}))
@@ -2226,12 +3068,11 @@ func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdmin(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateUserModeratorOptions operation middleware
 func (siw *ServerInterfaceWrapper) UpdateUserModeratorOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateUserModeratorOptions(w, r)
@@ -2241,15 +3082,18 @@ func (siw *ServerInterfaceWrapper) UpdateUserModeratorOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateUserModerator operation middleware
 func (siw *ServerInterfaceWrapper) UpdateUserModerator(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.UpdateUserModerator(w, r)
 	}))
@@ -2258,12 +3102,65 @@ func (siw *ServerInterfaceWrapper) UpdateUserModerator(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminNostrPubkey operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminNostrPubkey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetAdminNostrPubkeyOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminNostrPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetAdminNostrPubkeyOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetAdminNostrPubkey operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminNostrPubkey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetAdminNostrPubkey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
 // SetAdminPasswordOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetAdminPasswordOptions(w, r)
@@ -2273,15 +3170,18 @@ func (siw *ServerInterfaceWrapper) SetAdminPasswordOptions(w http.ResponseWriter
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetAdminPassword operation middleware
 func (siw *ServerInterfaceWrapper) SetAdminPassword(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetAdminPassword(w, r)
 	}))
@@ -2290,12 +3190,11 @@ func (siw *ServerInterfaceWrapper) SetAdminPassword(w http.ResponseWriter, r *ht
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetCustomColorVariableValuesOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetCustomColorVariableValuesOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetCustomColorVariableValuesOptions(w, r)
@@ -2305,15 +3204,18 @@ func (siw *ServerInterfaceWrapper) SetCustomColorVariableValuesOptions(w http.Re
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetCustomColorVariableValues operation middleware
 func (siw *ServerInterfaceWrapper) SetCustomColorVariableValues(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetCustomColorVariableValues(w, r)
 	}))
@@ -2322,12 +3224,11 @@ func (siw *ServerInterfaceWrapper) SetCustomColorVariableValues(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatDisabledOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetChatDisabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatDisabledOptions(w, r)
@@ -2337,15 +3238,18 @@ func (siw *ServerInterfaceWrapper) SetChatDisabledOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatDisabled operation middleware
 func (siw *ServerInterfaceWrapper) SetChatDisabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatDisabled(w, r)
 	}))
@@ -2354,12 +3258,11 @@ func (siw *ServerInterfaceWrapper) SetChatDisabled(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetEnableEstablishedChatUserModeOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserModeOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetEnableEstablishedChatUserModeOptions(w, r)
@@ -2369,15 +3272,18 @@ func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserModeOptions(w htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetEnableEstablishedChatUserMode operation middleware
 func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserMode(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetEnableEstablishedChatUserMode(w, r)
 	}))
@@ -2386,12 +3292,11 @@ func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserMode(w http.Respo
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetForbiddenUsernameListOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetForbiddenUsernameListOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetForbiddenUsernameListOptions(w, r)
@@ -2401,15 +3306,18 @@ func (siw *ServerInterfaceWrapper) SetForbiddenUsernameListOptions(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetForbiddenUsernameList operation middleware
 func (siw *ServerInterfaceWrapper) SetForbiddenUsernameList(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetForbiddenUsernameList(w, r)
 	}))
@@ -2418,12 +3326,11 @@ func (siw *ServerInterfaceWrapper) SetForbiddenUsernameList(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatJoinMessagesEnabledOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatJoinMessagesEnabledOptions(w, r)
@@ -2433,15 +3340,18 @@ func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabledOptions(w http.Resp
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatJoinMessagesEnabled operation middleware
 func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatJoinMessagesEnabled(w, r)
 	}))
@@ -2450,12 +3360,11 @@ func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabled(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatSlurFilterEnabledOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatSlurFilterEnabledOptions(w, r)
@@ -2465,15 +3374,18 @@ func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabledOptions(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatSlurFilterEnabled operation middleware
 func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatSlurFilterEnabled(w, r)
 	}))
@@ -2482,12 +3394,11 @@ func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabled(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatSpamProtectionEnabledOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatSpamProtectionEnabledOptions(w, r)
@@ -2497,15 +3408,18 @@ func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabledOptions(w http.Re
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetChatSpamProtectionEnabled operation middleware
 func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetChatSpamProtectionEnabled(w, r)
 	}))
@@ -2514,12 +3428,11 @@ func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabled(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetSuggestedUsernameListOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetSuggestedUsernameListOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetSuggestedUsernameListOptions(w, r)
@@ -2529,15 +3442,18 @@ func (siw *ServerInterfaceWrapper) SetSuggestedUsernameListOptions(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetSuggestedUsernameList operation middleware
 func (siw *ServerInterfaceWrapper) SetSuggestedUsernameList(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetSuggestedUsernameList(w, r)
 	}))
@@ -2546,12 +3462,11 @@ func (siw *ServerInterfaceWrapper) SetSuggestedUsernameList(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetCustomJavascriptOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetCustomJavascriptOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetCustomJavascriptOptions(w, r)
@@ -2561,15 +3476,18 @@ func (siw *ServerInterfaceWrapper) SetCustomJavascriptOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetCustomJavascript operation middleware
 func (siw *ServerInterfaceWrapper) SetCustomJavascript(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetCustomJavascript(w, r)
 	}))
@@ -2578,12 +3496,11 @@ func (siw *ServerInterfaceWrapper) SetCustomJavascript(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetCustomStylesOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetCustomStylesOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetCustomStylesOptions(w, r)
@@ -2593,15 +3510,18 @@ func (siw *ServerInterfaceWrapper) SetCustomStylesOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetCustomStyles operation middleware
 func (siw *ServerInterfaceWrapper) SetCustomStyles(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetCustomStyles(w, r)
 	}))
@@ -2610,12 +3530,11 @@ func (siw *ServerInterfaceWrapper) SetCustomStyles(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetDirectoryEnabledOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetDirectoryEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetDirectoryEnabledOptions(w, r)
@@ -2625,15 +3544,18 @@ func (siw *ServerInterfaceWrapper) SetDirectoryEnabledOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetDirectoryEnabled operation middleware
 func (siw *ServerInterfaceWrapper) SetDirectoryEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetDirectoryEnabled(w, r)
 	}))
@@ -2642,12 +3564,11 @@ func (siw *ServerInterfaceWrapper) SetDirectoryEnabled(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetDisableSearchIndexingOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetDisableSearchIndexingOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetDisableSearchIndexingOptions(w, r)
@@ -2657,15 +3578,18 @@ func (siw *ServerInterfaceWrapper) SetDisableSearchIndexingOptions(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetDisableSearchIndexing operation middleware
 func (siw *ServerInterfaceWrapper) SetDisableSearchIndexing(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetDisableSearchIndexing(w, r)
 	}))
@@ -2674,12 +3598,11 @@ func (siw *ServerInterfaceWrapper) SetDisableSearchIndexing(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetExternalActionsOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetExternalActionsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetExternalActionsOptions(w, r)
@@ -2689,15 +3612,18 @@ func (siw *ServerInterfaceWrapper) SetExternalActionsOptions(w http.ResponseWrit
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetExternalActions operation middleware
 func (siw *ServerInterfaceWrapper) SetExternalActions(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetExternalActions(w, r)
 	}))
@@ -2706,12 +3632,11 @@ func (siw *ServerInterfaceWrapper) SetExternalActions(w http.ResponseWriter, r *
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationBlockDomainsOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationBlockDomainsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationBlockDomainsOptions(w, r)
@@ -2721,15 +3646,18 @@ func (siw *ServerInterfaceWrapper) SetFederationBlockDomainsOptions(w http.Respo
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationBlockDomains operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationBlockDomains(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationBlockDomains(w, r)
 	}))
@@ -2738,12 +3666,11 @@ func (siw *ServerInterfaceWrapper) SetFederationBlockDomains(w http.ResponseWrit
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationEnabledOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationEnabledOptions(w, r)
@@ -2753,15 +3680,18 @@ func (siw *ServerInterfaceWrapper) SetFederationEnabledOptions(w http.ResponseWr
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationEnabled operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationEnabled(w, r)
 	}))
@@ -2770,12 +3700,11 @@ func (siw *ServerInterfaceWrapper) SetFederationEnabled(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationGoLiveMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationGoLiveMessageOptions(w, r)
@@ -2785,15 +3714,18 @@ func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessageOptions(w http.Resp
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationGoLiveMessage operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationGoLiveMessage(w, r)
 	}))
@@ -2802,12 +3734,11 @@ func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessage(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationActivityPrivateOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationActivityPrivateOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationActivityPrivateOptions(w, r)
@@ -2817,15 +3748,18 @@ func (siw *ServerInterfaceWrapper) SetFederationActivityPrivateOptions(w http.Re
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationActivityPrivate operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationActivityPrivate(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationActivityPrivate(w, r)
 	}))
@@ -2834,12 +3768,11 @@ func (siw *ServerInterfaceWrapper) SetFederationActivityPrivate(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationShowEngagementOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationShowEngagementOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationShowEngagementOptions(w, r)
@@ -2849,15 +3782,18 @@ func (siw *ServerInterfaceWrapper) SetFederationShowEngagementOptions(w http.Res
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationShowEngagement operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationShowEngagement(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationShowEngagement(w, r)
 	}))
@@ -2866,12 +3802,11 @@ func (siw *ServerInterfaceWrapper) SetFederationShowEngagement(w http.ResponseWr
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationUsernameOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationUsernameOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationUsernameOptions(w, r)
@@ -2881,15 +3816,18 @@ func (siw *ServerInterfaceWrapper) SetFederationUsernameOptions(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFederationUsername operation middleware
 func (siw *ServerInterfaceWrapper) SetFederationUsername(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFederationUsername(w, r)
 	}))
@@ -2898,12 +3836,11 @@ func (siw *ServerInterfaceWrapper) SetFederationUsername(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFfmpegPathOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetFfmpegPathOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFfmpegPathOptions(w, r)
@@ -2913,15 +3850,18 @@ func (siw *ServerInterfaceWrapper) SetFfmpegPathOptions(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetFfmpegPath operation middleware
 func (siw *ServerInterfaceWrapper) SetFfmpegPath(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetFfmpegPath(w, r)
 	}))
@@ -2930,12 +3870,11 @@ func (siw *ServerInterfaceWrapper) SetFfmpegPath(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetHideViewerCountOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetHideViewerCountOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetHideViewerCountOptions(w, r)
@@ -2945,15 +3884,18 @@ func (siw *ServerInterfaceWrapper) SetHideViewerCountOptions(w http.ResponseWrit
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetHideViewerCount operation middleware
 func (siw *ServerInterfaceWrapper) SetHideViewerCount(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetHideViewerCount(w, r)
 	}))
@@ -2962,12 +3904,11 @@ func (siw *ServerInterfaceWrapper) SetHideViewerCount(w http.ResponseWriter, r *
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetLogoOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetLogoOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetLogoOptions(w, r)
@@ -2977,15 +3918,18 @@ func (siw *ServerInterfaceWrapper) SetLogoOptions(w http.ResponseWriter, r *http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetLogo operation middleware
 func (siw *ServerInterfaceWrapper) SetLogo(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetLogo(w, r)
 	}))
@@ -2994,12 +3938,11 @@ func (siw *ServerInterfaceWrapper) SetLogo(w http.ResponseWriter, r *http.Reques
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetServerNameOptions operation middleware
 func (siw *ServerInterfaceWrapper) SetServerNameOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetServerNameOptions(w, r)
@@ -3009,15 +3952,18 @@ func (siw *ServerInterfaceWrapper) SetServerNameOptions(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SetServerName operation middleware
 func (siw *ServerInterfaceWrapper) SetServerName(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SetServerName(w, r)
 	}))
@@ -3026,1142 +3972,2148 @@ func (siw *ServerInterfaceWrapper) SetServerName(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetBrowserNotificationConfigurationOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrLegacyVideoEventsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrLegacyVideoEventsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetBrowserNotificationConfigurationOptions(w, r)
+		siw.Handler.SetNostrLegacyVideoEventsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetBrowserNotificationConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+// SetNostrLegacyVideoEvents operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrLegacyVideoEvents(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetBrowserNotificationConfiguration(w, r)
+		siw.Handler.SetNostrLegacyVideoEvents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDiscordNotificationConfigurationOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrLightningAddressOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrLightningAddressOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDiscordNotificationConfigurationOptions(w, r)
+		siw.Handler.SetNostrLightningAddressOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDiscordNotificationConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+// SetNostrLightningAddress operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrLightningAddress(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDiscordNotificationConfiguration(w, r)
+		siw.Handler.SetNostrLightningAddress(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetNSFWOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetNSFWOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrNIP05NamesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrNIP05NamesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetNSFWOptions(w, r)
+		siw.Handler.SetNostrNIP05NamesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetNSFW operation middleware
-func (siw *ServerInterfaceWrapper) SetNSFW(w http.ResponseWriter, r *http.Request) {
+// SetNostrNIP05Names operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrNIP05Names(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetNSFW(w, r)
+		siw.Handler.SetNostrNIP05Names(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomOfflineMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomOfflineMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrRelayEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrRelayEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomOfflineMessageOptions(w, r)
+		siw.Handler.SetNostrRelayEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomOfflineMessage operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomOfflineMessage(w http.ResponseWriter, r *http.Request) {
+// SetNostrRelayEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrRelayEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomOfflineMessage(w, r)
+		siw.Handler.SetNostrRelayEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetExtraPageContentOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetExtraPageContentOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrRelaysOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrRelaysOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetExtraPageContentOptions(w, r)
+		siw.Handler.SetNostrRelaysOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetExtraPageContent operation middleware
-func (siw *ServerInterfaceWrapper) SetExtraPageContent(w http.ResponseWriter, r *http.Request) {
+// SetNostrRelays operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrRelays(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetExtraPageContent(w, r)
+		siw.Handler.SetNostrRelays(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetRTMPServerPortOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetRTMPServerPortOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrWalletConnectOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrWalletConnectOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetRTMPServerPortOptions(w, r)
+		siw.Handler.SetNostrWalletConnectOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetRTMPServerPort operation middleware
-func (siw *ServerInterfaceWrapper) SetRTMPServerPort(w http.ResponseWriter, r *http.Request) {
+// SetNostrWalletConnect operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrWalletConnect(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetRTMPServerPort(w, r)
+		siw.Handler.SetNostrWalletConnect(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetS3ConfigurationOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetS3ConfigurationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetBrowserNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetS3ConfigurationOptions(w, r)
+		siw.Handler.SetBrowserNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetS3Configuration operation middleware
-func (siw *ServerInterfaceWrapper) SetS3Configuration(w http.ResponseWriter, r *http.Request) {
+// SetBrowserNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetS3Configuration(w, r)
+		siw.Handler.SetBrowserNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerSummaryOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerSummaryOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetDiscordNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerSummaryOptions(w, r)
+		siw.Handler.SetDiscordNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerSummary operation middleware
-func (siw *ServerInterfaceWrapper) SetServerSummary(w http.ResponseWriter, r *http.Request) {
+// SetDiscordNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerSummary(w, r)
+		siw.Handler.SetDiscordNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerURLOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerURLOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerURLOptions(w, r)
+		siw.Handler.SetNostrNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerURL operation middleware
-func (siw *ServerInterfaceWrapper) SetServerURL(w http.ResponseWriter, r *http.Request) {
+// SetNostrNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerURL(w, r)
+		siw.Handler.SetNostrNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocialHandlesOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetSocialHandlesOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNSFWOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNSFWOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocialHandlesOptions(w, r)
+		siw.Handler.SetNSFWOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocialHandles operation middleware
-func (siw *ServerInterfaceWrapper) SetSocialHandles(w http.ResponseWriter, r *http.Request) {
+// SetNSFW operation middleware
+func (siw *ServerInterfaceWrapper) SetNSFW(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocialHandles(w, r)
+		siw.Handler.SetNSFW(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocketHostOverrideOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetSocketHostOverrideOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetCustomOfflineMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomOfflineMessageOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocketHostOverrideOptions(w, r)
+		siw.Handler.SetCustomOfflineMessageOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocketHostOverride operation middleware
-func (siw *ServerInterfaceWrapper) SetSocketHostOverride(w http.ResponseWriter, r *http.Request) {
+// SetCustomOfflineMessage operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomOfflineMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocketHostOverride(w, r)
+		siw.Handler.SetCustomOfflineMessage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamKeysOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamKeysOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetExtraPageContentOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetExtraPageContentOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamKeysOptions(w, r)
+		siw.Handler.SetExtraPageContentOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamKeys operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamKeys(w http.ResponseWriter, r *http.Request) {
+// SetExtraPageContent operation middleware
+func (siw *ServerInterfaceWrapper) SetExtraPageContent(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamKeys(w, r)
+		siw.Handler.SetExtraPageContent(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamTitleOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamTitleOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetPublishCallbackOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetPublishCallbackOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamTitleOptions(w, r)
+		siw.Handler.SetPublishCallbackOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamTitle operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamTitle(w http.ResponseWriter, r *http.Request) {
+// SetPublishCallback operation middleware
+func (siw *ServerInterfaceWrapper) SetPublishCallback(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamTitle(w, r)
+		siw.Handler.SetPublishCallback(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetTagsOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetTagsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetReconnectGracePeriodOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetReconnectGracePeriodOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetTagsOptions(w, r)
+		siw.Handler.SetReconnectGracePeriodOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetTags operation middleware
-func (siw *ServerInterfaceWrapper) SetTags(w http.ResponseWriter, r *http.Request) {
+// SetReconnectGracePeriod operation middleware
+func (siw *ServerInterfaceWrapper) SetReconnectGracePeriod(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetTags(w, r)
+		siw.Handler.SetReconnectGracePeriod(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoCodecOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoCodecOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetRestreamDestinationsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetRestreamDestinationsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoCodecOptions(w, r)
+		siw.Handler.SetRestreamDestinationsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoCodec operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoCodec(w http.ResponseWriter, r *http.Request) {
+// SetRestreamDestinations operation middleware
+func (siw *ServerInterfaceWrapper) SetRestreamDestinations(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoCodec(w, r)
+		siw.Handler.SetRestreamDestinations(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamLatencyLevelOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamLatencyLevelOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetRTMPSConfigOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPSConfigOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamLatencyLevelOptions(w, r)
+		siw.Handler.SetRTMPSConfigOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamLatencyLevel operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamLatencyLevel(w http.ResponseWriter, r *http.Request) {
+// SetRTMPSConfig operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPSConfig(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamLatencyLevel(w, r)
+		siw.Handler.SetRTMPSConfig(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamOutputVariantsOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamOutputVariantsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetRTMPServerPortOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPServerPortOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamOutputVariantsOptions(w, r)
+		siw.Handler.SetRTMPServerPortOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamOutputVariants operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
+// SetRTMPServerPort operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPServerPort(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamOutputVariants(w, r)
+		siw.Handler.SetRTMPServerPort(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoServingEndpointOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoServingEndpointOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetRTMPTakeoverOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPTakeoverOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoServingEndpointOptions(w, r)
+		siw.Handler.SetRTMPTakeoverOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoServingEndpoint operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoServingEndpoint(w http.ResponseWriter, r *http.Request) {
+// SetRTMPTakeover operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPTakeover(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoServingEndpoint(w, r)
+		siw.Handler.SetRTMPTakeover(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerIPOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerIPOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetS3ConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetS3ConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerIPOptions(w, r)
+		siw.Handler.SetS3ConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerIP operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerIP(w http.ResponseWriter, r *http.Request) {
+// SetS3Configuration operation middleware
+func (siw *ServerInterfaceWrapper) SetS3Configuration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerIP(w, r)
+		siw.Handler.SetS3Configuration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerPortOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerPortOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetServerSummaryOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerSummaryOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerPortOptions(w, r)
+		siw.Handler.SetServerSummaryOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerPort operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerPort(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetServerSummary operation middleware
+func (siw *ServerInterfaceWrapper) SetServerSummary(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerSummary(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerURLOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerURLOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerURLOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerURL operation middleware
+func (siw *ServerInterfaceWrapper) SetServerURL(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerURL(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocialHandlesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSocialHandlesOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocialHandlesOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocialHandles operation middleware
+func (siw *ServerInterfaceWrapper) SetSocialHandles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocialHandles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocketHostOverrideOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSocketHostOverrideOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocketHostOverrideOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocketHostOverride operation middleware
+func (siw *ServerInterfaceWrapper) SetSocketHostOverride(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocketHostOverride(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSRTServerPortOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSRTServerPortOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSRTServerPortOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSRTServerPort operation middleware
+func (siw *ServerInterfaceWrapper) SetSRTServerPort(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSRTServerPort(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamKeysOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamKeysOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamKeysOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamKeys operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamTitleOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamTitleOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamTitleOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamTitle operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamTitle(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamTitle(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTagsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetTagsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTagsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTags operation middleware
+func (siw *ServerInterfaceWrapper) SetTags(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoCodecOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoCodecOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoCodecOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoCodec operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoCodec(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoCodec(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamLatencyLevelOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamLatencyLevelOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamLatencyLevelOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamLatencyLevel operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamLatencyLevel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamLatencyLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamOutputVariantsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamOutputVariantsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamOutputVariantsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamOutputVariants operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamOutputVariants(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoServingEndpointOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoServingEndpointOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoServingEndpointOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoServingEndpoint operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoServingEndpoint(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoServingEndpoint(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebRTCServerPortOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetWebRTCServerPortOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebRTCServerPortOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebRTCServerPort operation middleware
+func (siw *ServerInterfaceWrapper) SetWebRTCServerPort(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebRTCServerPort(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerIPOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerIPOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerIPOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerIP operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerIP(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerIP(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerPortOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerPortOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerPortOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerPort operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerPort(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerPort(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerWelcomeMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerWelcomeMessageOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerWelcomeMessageOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerWelcomeMessage operation middleware
+func (siw *ServerInterfaceWrapper) SetServerWelcomeMessage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerWelcomeMessage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisconnectInboundConnection operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisconnectInboundConnection(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisconnectInboundConnectionOptions operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectInboundConnectionOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisconnectInboundConnectionOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomEmojiOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomEmojiOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomEmoji operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomEmoji(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomEmoji(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadCustomEmojiOptions operation middleware
+func (siw *ServerInterfaceWrapper) UploadCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadCustomEmojiOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadCustomEmoji operation middleware
+func (siw *ServerInterfaceWrapper) UploadCustomEmoji(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadCustomEmoji(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFederatedActions operation middleware
+func (siw *ServerInterfaceWrapper) GetFederatedActions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederatedActionsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFederatedActions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFederatedActionsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetFederatedActionsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFederatedActionsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendFederatedMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SendFederatedMessageOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendFederatedMessageOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendFederatedMessage operation middleware
+func (siw *ServerInterfaceWrapper) SendFederatedMessage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendFederatedMessage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFollowersAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetFollowersAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFollowersAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFollowersAdmin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFollowersAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetFollowersAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerPort(w, r)
+		siw.Handler.GetFollowersAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerWelcomeMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerWelcomeMessageOptions(w http.ResponseWriter, r *http.Request) {
+// ApproveFollowerOptions operation middleware
+func (siw *ServerInterfaceWrapper) ApproveFollowerOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveFollowerOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveFollower operation middleware
+func (siw *ServerInterfaceWrapper) ApproveFollower(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerWelcomeMessageOptions(w, r)
+		siw.Handler.ApproveFollower(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerWelcomeMessage operation middleware
-func (siw *ServerInterfaceWrapper) SetServerWelcomeMessage(w http.ResponseWriter, r *http.Request) {
+// GetBlockedAndRejectedFollowers operation middleware
+func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerWelcomeMessage(w, r)
+		siw.Handler.GetBlockedAndRejectedFollowers(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DisconnectInboundConnection operation middleware
-func (siw *ServerInterfaceWrapper) DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
+// GetBlockedAndRejectedFollowersOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowersOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBlockedAndRejectedFollowersOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPendingFollowRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetPendingFollowRequests(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DisconnectInboundConnection(w, r)
+		siw.Handler.GetPendingFollowRequests(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DisconnectInboundConnectionOptions operation middleware
-func (siw *ServerInterfaceWrapper) DisconnectInboundConnectionOptions(w http.ResponseWriter, r *http.Request) {
+// GetPendingFollowRequestsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPendingFollowRequestsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPendingFollowRequestsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHardwareStats operation middleware
+func (siw *ServerInterfaceWrapper) GetHardwareStats(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DisconnectInboundConnectionOptions(w, r)
+		siw.Handler.GetHardwareStats(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteCustomEmojiOptions operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
+// GetHardwareStatsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetHardwareStatsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHardwareStatsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCustomEmojiOptions(w, r)
+		siw.Handler.GetLogs(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteCustomEmoji operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCustomEmoji(w http.ResponseWriter, r *http.Request) {
+// GetLogsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetLogsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWarnings operation middleware
+func (siw *ServerInterfaceWrapper) GetWarnings(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCustomEmoji(w, r)
+		siw.Handler.GetWarnings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UploadCustomEmojiOptions operation middleware
-func (siw *ServerInterfaceWrapper) UploadCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
+// GetWarningsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWarningsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWarningsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInboundStreamMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetInboundStreamMetrics(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadCustomEmojiOptions(w, r)
+		siw.Handler.GetInboundStreamMetrics(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UploadCustomEmoji operation middleware
-func (siw *ServerInterfaceWrapper) UploadCustomEmoji(w http.ResponseWriter, r *http.Request) {
+// GetInboundStreamMetricsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetInboundStreamMetricsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInboundStreamMetricsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVideoPlaybackMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadCustomEmoji(w, r)
+		siw.Handler.GetVideoPlaybackMetrics(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFederatedActions operation middleware
-func (siw *ServerInterfaceWrapper) GetFederatedActions(w http.ResponseWriter, r *http.Request) {
+// GetVideoPlaybackMetricsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetricsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVideoPlaybackMetricsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminAccess operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAccess(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminAccess(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminAccessOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAccessOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminAccessOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminPubkeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPubkeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFederatedActionsParams
+	r = r.WithContext(ctx)
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminPubkeys(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+// GetAdminPubkeysOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPubkeysOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminPubkeysOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveAdminPubkeyOptions operation middleware
+func (siw *ServerInterfaceWrapper) RemoveAdminPubkeyOptions(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFederatedActions(w, r, params)
+		siw.Handler.RemoveAdminPubkeyOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFederatedActionsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetFederatedActionsOptions(w http.ResponseWriter, r *http.Request) {
+// RemoveAdminPubkey operation middleware
+func (siw *ServerInterfaceWrapper) RemoveAdminPubkey(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFederatedActionsOptions(w, r)
+		siw.Handler.RemoveAdminPubkey(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SendFederatedMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SendFederatedMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetAdminPubkeyRoleOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminPubkeyRoleOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendFederatedMessageOptions(w, r)
+		siw.Handler.SetAdminPubkeyRoleOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SendFederatedMessage operation middleware
-func (siw *ServerInterfaceWrapper) SendFederatedMessage(w http.ResponseWriter, r *http.Request) {
+// SetAdminPubkeyRole operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminPubkeyRole(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendFederatedMessage(w, r)
+		siw.Handler.SetAdminPubkeyRole(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFollowersAdmin operation middleware
-func (siw *ServerInterfaceWrapper) GetFollowersAdmin(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetNostrIdentity operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrIdentity(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFollowersAdminParams
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFollowersAdmin(w, r, params)
+		siw.Handler.GetNostrIdentity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFollowersAdminOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetFollowersAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetNostrIdentityOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFollowersAdminOptions(w, r)
+		siw.Handler.GetNostrIdentityOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ApproveFollowerOptions operation middleware
-func (siw *ServerInterfaceWrapper) ApproveFollowerOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// ImportNostrIdentityOptions operation middleware
+func (siw *ServerInterfaceWrapper) ImportNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveFollowerOptions(w, r)
+		siw.Handler.ImportNostrIdentityOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ApproveFollower operation middleware
-func (siw *ServerInterfaceWrapper) ApproveFollower(w http.ResponseWriter, r *http.Request) {
+// ImportNostrIdentity operation middleware
+func (siw *ServerInterfaceWrapper) ImportNostrIdentity(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveFollower(w, r)
+		siw.Handler.ImportNostrIdentity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetBlockedAndRejectedFollowers operation middleware
-func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// RotateNostrIdentityOptions operation middleware
+func (siw *ServerInterfaceWrapper) RotateNostrIdentityOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBlockedAndRejectedFollowers(w, r)
+		siw.Handler.RotateNostrIdentityOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetBlockedAndRejectedFollowersOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowersOptions(w http.ResponseWriter, r *http.Request) {
+// RotateNostrIdentity operation middleware
+func (siw *ServerInterfaceWrapper) RotateNostrIdentity(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBlockedAndRejectedFollowersOptions(w, r)
+		siw.Handler.RotateNostrIdentity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetPendingFollowRequests operation middleware
-func (siw *ServerInterfaceWrapper) GetPendingFollowRequests(w http.ResponseWriter, r *http.Request) {
+// GetNostrRecordings operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrRecordings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNostrRecordingsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPendingFollowRequests(w, r)
+		siw.Handler.GetNostrRecordings(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetPendingFollowRequestsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetPendingFollowRequestsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetNostrRecordingsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrRecordingsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPendingFollowRequestsOptions(w, r)
+		siw.Handler.GetNostrRecordingsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetHardwareStats operation middleware
-func (siw *ServerInterfaceWrapper) GetHardwareStats(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// DeleteNostrRecordingAnnouncementOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNostrRecordingAnnouncementOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHardwareStats(w, r)
+		siw.Handler.DeleteNostrRecordingAnnouncementOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetHardwareStatsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetHardwareStatsOptions(w http.ResponseWriter, r *http.Request) {
+// DeleteNostrRecordingAnnouncement operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNostrRecordingAnnouncement(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHardwareStatsOptions(w, r)
+		siw.Handler.DeleteNostrRecordingAnnouncement(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// UpdateNostrRecordingOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNostrRecordingOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogs(w, r)
+		siw.Handler.UpdateNostrRecordingOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetLogsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetLogsOptions(w http.ResponseWriter, r *http.Request) {
+// UpdateNostrRecording operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNostrRecording(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogsOptions(w, r)
+		siw.Handler.UpdateNostrRecording(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWarnings operation middleware
-func (siw *ServerInterfaceWrapper) GetWarnings(w http.ResponseWriter, r *http.Request) {
+// GetNostrRelayHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrRelayHealth(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWarnings(w, r)
+		siw.Handler.GetNostrRelayHealth(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWarningsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetWarningsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetNostrRelayHealthOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrRelayHealthOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWarningsOptions(w, r)
+		siw.Handler.GetNostrRelayHealthOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetVideoPlaybackMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
+// GetNostrZapTotals operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrZapTotals(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVideoPlaybackMetrics(w, r)
+		siw.Handler.GetNostrZapTotals(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetVideoPlaybackMetricsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetricsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetNostrZapTotalsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetNostrZapTotalsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVideoPlaybackMetricsOptions(w, r)
+		siw.Handler.GetNostrZapTotalsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeletePrometheusAPI operation middleware
 func (siw *ServerInterfaceWrapper) DeletePrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeletePrometheusAPI(w, r)
@@ -4171,12 +6123,11 @@ func (siw *ServerInterfaceWrapper) DeletePrometheusAPI(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetPrometheusAPI operation middleware
 func (siw *ServerInterfaceWrapper) GetPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetPrometheusAPI(w, r)
@@ -4186,12 +6137,11 @@ func (siw *ServerInterfaceWrapper) GetPrometheusAPI(w http.ResponseWriter, r *ht
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // OptionsPrometheusAPI operation middleware
 func (siw *ServerInterfaceWrapper) OptionsPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.OptionsPrometheusAPI(w, r)
@@ -4201,12 +6151,11 @@ func (siw *ServerInterfaceWrapper) OptionsPrometheusAPI(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // PostPrometheusAPI operation middleware
 func (siw *ServerInterfaceWrapper) PostPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.PostPrometheusAPI(w, r)
@@ -4216,12 +6165,11 @@ func (siw *ServerInterfaceWrapper) PostPrometheusAPI(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // PutPrometheusAPI operation middleware
 func (siw *ServerInterfaceWrapper) PutPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.PutPrometheusAPI(w, r)
@@ -4231,15 +6179,18 @@ func (siw *ServerInterfaceWrapper) PutPrometheusAPI(w http.ResponseWriter, r *ht
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetServerConfig operation middleware
 func (siw *ServerInterfaceWrapper) GetServerConfig(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetServerConfig(w, r)
 	}))
@@ -4248,12 +6199,11 @@ func (siw *ServerInterfaceWrapper) GetServerConfig(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetServerConfigOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetServerConfigOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetServerConfigOptions(w, r)
@@ -4263,15 +6213,18 @@ func (siw *ServerInterfaceWrapper) GetServerConfigOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // StatusAdmin operation middleware
 func (siw *ServerInterfaceWrapper) StatusAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.StatusAdmin(w, r)
 	}))
@@ -4280,12 +6233,11 @@ func (siw *ServerInterfaceWrapper) StatusAdmin(w http.ResponseWriter, r *http.Re
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // StatusAdminOptions operation middleware
 func (siw *ServerInterfaceWrapper) StatusAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.StatusAdminOptions(w, r)
@@ -4295,15 +6247,18 @@ func (siw *ServerInterfaceWrapper) StatusAdminOptions(w http.ResponseWriter, r *
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // AutoUpdateForceQuit operation middleware
 func (siw *ServerInterfaceWrapper) AutoUpdateForceQuit(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AutoUpdateForceQuit(w, r)
 	}))
@@ -4312,12 +6267,11 @@ func (siw *ServerInterfaceWrapper) AutoUpdateForceQuit(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // AutoUpdateForceQuitOptions operation middleware
 func (siw *ServerInterfaceWrapper) AutoUpdateForceQuitOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AutoUpdateForceQuitOptions(w, r)
@@ -4327,15 +6281,18 @@ func (siw *ServerInterfaceWrapper) AutoUpdateForceQuitOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // AutoUpdateOptions operation middleware
 func (siw *ServerInterfaceWrapper) AutoUpdateOptions(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AutoUpdateOptions(w, r)
 	}))
@@ -4344,12 +6301,11 @@ func (siw *ServerInterfaceWrapper) AutoUpdateOptions(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // AutoUpdateOptionsOptions operation middleware
 func (siw *ServerInterfaceWrapper) AutoUpdateOptionsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AutoUpdateOptionsOptions(w, r)
@@ -4359,15 +6315,18 @@ func (siw *ServerInterfaceWrapper) AutoUpdateOptionsOptions(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // AutoUpdateStart operation middleware
 func (siw *ServerInterfaceWrapper) AutoUpdateStart(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AutoUpdateStart(w, r)
 	}))
@@ -4376,12 +6335,11 @@ func (siw *ServerInterfaceWrapper) AutoUpdateStart(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // AutoUpdateStartOptions operation middleware
 func (siw *ServerInterfaceWrapper) AutoUpdateStartOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.AutoUpdateStartOptions(w, r)
@@ -4391,15 +6349,18 @@ func (siw *ServerInterfaceWrapper) AutoUpdateStartOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetActiveViewers operation middleware
 func (siw *ServerInterfaceWrapper) GetActiveViewers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetActiveViewers(w, r)
 	}))
@@ -4408,12 +6369,11 @@ func (siw *ServerInterfaceWrapper) GetActiveViewers(w http.ResponseWriter, r *ht
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetActiveViewersOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetActiveViewersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetActiveViewersOptions(w, r)
@@ -4423,17 +6383,20 @@ func (siw *ServerInterfaceWrapper) GetActiveViewersOptions(w http.ResponseWriter
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetViewersOverTime operation middleware
 func (siw *ServerInterfaceWrapper) GetViewersOverTime(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetViewersOverTimeParams
 
@@ -4453,12 +6416,11 @@ func (siw *ServerInterfaceWrapper) GetViewersOverTime(w http.ResponseWriter, r *
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetViewersOverTimeOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetViewersOverTimeOptions(w, r)
@@ -4468,15 +6430,18 @@ func (siw *ServerInterfaceWrapper) GetViewersOverTimeOptions(w http.ResponseWrit
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetWebhooks operation middleware
 func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetWebhooks(w, r)
 	}))
@@ -4485,12 +6450,11 @@ func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Re
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetWebhooksOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetWebhooksOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetWebhooksOptions(w, r)
@@ -4500,12 +6464,11 @@ func (siw *ServerInterfaceWrapper) GetWebhooksOptions(w http.ResponseWriter, r *
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // CreateWebhookOptions operation middleware
 func (siw *ServerInterfaceWrapper) CreateWebhookOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateWebhookOptions(w, r)
@@ -4515,15 +6478,18 @@ func (siw *ServerInterfaceWrapper) CreateWebhookOptions(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // CreateWebhook operation middleware
 func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateWebhook(w, r)
 	}))
@@ -4532,12 +6498,11 @@ func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeleteWebhookOptions operation middleware
 func (siw *ServerInterfaceWrapper) DeleteWebhookOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteWebhookOptions(w, r)
@@ -4547,15 +6512,18 @@ func (siw *ServerInterfaceWrapper) DeleteWebhookOptions(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeleteWebhook operation middleware
 func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteWebhook(w, r)
 	}))
@@ -4564,15 +6532,18 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ResetYPRegistration operation middleware
 func (siw *ServerInterfaceWrapper) ResetYPRegistration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ResetYPRegistration(w, r)
 	}))
@@ -4581,12 +6552,11 @@ func (siw *ServerInterfaceWrapper) ResetYPRegistration(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ResetYPRegistrationOptions operation middleware
 func (siw *ServerInterfaceWrapper) ResetYPRegistrationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ResetYPRegistrationOptions(w, r)
@@ -4596,12 +6566,11 @@ func (siw *ServerInterfaceWrapper) ResetYPRegistrationOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterFediverseOTPRequest operation middleware
 func (siw *ServerInterfaceWrapper) RegisterFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4631,32 +6600,132 @@ func (siw *ServerInterfaceWrapper) RegisterFediverseOTPRequest(w http.ResponseWr
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // VerifyFediverseOTPRequest operation middleware
 func (siw *ServerInterfaceWrapper) VerifyFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VerifyFediverseOTPRequest(w, r)
+		siw.Handler.VerifyFediverseOTPRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartIndieAuthFlow operation middleware
+func (siw *ServerInterfaceWrapper) StartIndieAuthFlow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartIndieAuthFlowParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartIndieAuthFlow(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HandleIndieAuthRedirect operation middleware
+func (siw *ServerInterfaceWrapper) HandleIndieAuthRedirect(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HandleIndieAuthRedirectParams
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleIndieAuthRedirect(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterNostrAuthChallenge operation middleware
+func (siw *ServerInterfaceWrapper) RegisterNostrAuthChallenge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegisterNostrAuthChallengeParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterNostrAuthChallenge(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// StartIndieAuthFlow operation middleware
-func (siw *ServerInterfaceWrapper) StartIndieAuthFlow(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNostrNIP05 operation middleware
+func (siw *ServerInterfaceWrapper) SetNostrNIP05(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params StartIndieAuthFlowParams
+	var params SetNostrNIP05Params
 
 	// ------------- Required query parameter "accessToken" -------------
 
@@ -4674,59 +6743,61 @@ func (siw *ServerInterfaceWrapper) StartIndieAuthFlow(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartIndieAuthFlow(w, r, params)
+		siw.Handler.SetNostrNIP05(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// HandleIndieAuthRedirect operation middleware
-func (siw *ServerInterfaceWrapper) HandleIndieAuthRedirect(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// VerifyNostrAuthChallenge operation middleware
+func (siw *ServerInterfaceWrapper) VerifyNostrAuthChallenge(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params HandleIndieAuthRedirectParams
+	var params VerifyNostrAuthChallengeParams
 
-	// ------------- Required query parameter "state" -------------
+	// ------------- Required query parameter "accessToken" -------------
 
-	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleIndieAuthRedirect(w, r, params)
+		siw.Handler.VerifyNostrAuthChallenge(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // HandleIndieAuthEndpointGet operation middleware
 func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointGet(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params HandleIndieAuthEndpointGetParams
 
@@ -4798,12 +6869,11 @@ func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointGet(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // HandleIndieAuthEndpointPost operation middleware
 func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointPost(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.HandleIndieAuthEndpointPost(w, r)
@@ -4813,12 +6883,11 @@ func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointPost(w http.ResponseWr
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetChatMessages operation middleware
 func (siw *ServerInterfaceWrapper) GetChatMessages(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4848,12 +6917,11 @@ func (siw *ServerInterfaceWrapper) GetChatMessages(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateMessageVisibility operation middleware
 func (siw *ServerInterfaceWrapper) UpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4883,12 +6951,11 @@ func (siw *ServerInterfaceWrapper) UpdateMessageVisibility(w http.ResponseWriter
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterAnonymousChatUserOptions operation middleware
 func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUserOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.RegisterAnonymousChatUserOptions(w, r)
@@ -4898,12 +6965,11 @@ func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUserOptions(w http.Respo
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterAnonymousChatUser operation middleware
 func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4939,12 +7005,11 @@ func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUser(w http.ResponseWrit
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateUserEnabled operation middleware
 func (siw *ServerInterfaceWrapper) UpdateUserEnabled(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4974,12 +7039,11 @@ func (siw *ServerInterfaceWrapper) UpdateUserEnabled(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetWebConfig operation middleware
 func (siw *ServerInterfaceWrapper) GetWebConfig(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetWebConfig(w, r)
@@ -4989,12 +7053,11 @@ func (siw *ServerInterfaceWrapper) GetWebConfig(w http.ResponseWriter, r *http.R
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetCustomEmojiList operation middleware
 func (siw *ServerInterfaceWrapper) GetCustomEmojiList(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetCustomEmojiList(w, r)
@@ -5004,12 +7067,11 @@ func (siw *ServerInterfaceWrapper) GetCustomEmojiList(w http.ResponseWriter, r *
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetFollowers operation middleware
 func (siw *ServerInterfaceWrapper) GetFollowers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5040,15 +7102,18 @@ func (siw *ServerInterfaceWrapper) GetFollowers(w http.ResponseWriter, r *http.R
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetChatMessages operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetChatMessages(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetChatMessages(w, r)
 	}))
@@ -5057,12 +7122,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetChatMessages(w http.ResponseWriter
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetChatMessagesOptions operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetChatMessagesOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetChatMessagesOptions(w, r)
@@ -5072,12 +7136,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetChatMessagesOptions(w http.Respons
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendChatActionOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendChatActionOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendChatActionOptions(w, r)
@@ -5087,15 +7150,18 @@ func (siw *ServerInterfaceWrapper) SendChatActionOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendChatAction operation middleware
 func (siw *ServerInterfaceWrapper) SendChatAction(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendChatAction(w, r)
 	}))
@@ -5104,12 +7170,11 @@ func (siw *ServerInterfaceWrapper) SendChatAction(w http.ResponseWriter, r *http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalUpdateMessageVisibilityOptions operation middleware
 func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibilityOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalUpdateMessageVisibilityOptions(w, r)
@@ -5119,15 +7184,18 @@ func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibilityOptions(w http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalUpdateMessageVisibility operation middleware
 func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalUpdateMessageVisibility(w, r)
 	}))
@@ -5136,12 +7204,11 @@ func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibility(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendIntegrationChatMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendIntegrationChatMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendIntegrationChatMessageOptions(w, r)
@@ -5151,13 +7218,18 @@ func (siw *ServerInterfaceWrapper) SendIntegrationChatMessageOptions(w http.Resp
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendIntegrationChatMessage operation middleware
 func (siw *ServerInterfaceWrapper) SendIntegrationChatMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendIntegrationChatMessage(w, r)
 	}))
@@ -5166,12 +7238,11 @@ func (siw *ServerInterfaceWrapper) SendIntegrationChatMessage(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendSystemMessageOptions(w, r)
@@ -5181,15 +7252,18 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageOptions(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessage operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendSystemMessage(w, r)
 	}))
@@ -5198,12 +7272,11 @@ func (siw *ServerInterfaceWrapper) SendSystemMessage(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessageToConnectedClientOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClientOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5224,12 +7297,11 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClientOptions(w h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessageToConnectedClient operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClient(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5242,8 +7314,12 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClient(w http.Res
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendSystemMessageToConnectedClient(w, r, clientId)
 	}))
@@ -5252,12 +7328,11 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClient(w http.Res
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendUserMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendUserMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendUserMessageOptions(w, r)
@@ -5267,15 +7342,18 @@ func (siw *ServerInterfaceWrapper) SendUserMessageOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendUserMessage operation middleware
 func (siw *ServerInterfaceWrapper) SendUserMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendUserMessage(w, r)
 	}))
@@ -5284,15 +7362,18 @@ func (siw *ServerInterfaceWrapper) SendUserMessage(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetConnectedChatClients operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetConnectedChatClients(w, r)
 	}))
@@ -5301,12 +7382,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClients(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetConnectedChatClientsOptions operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetConnectedChatClientsOptions(w, r)
@@ -5316,12 +7396,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClientsOptions(w http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetUserDetails operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetUserDetails(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5334,8 +7413,12 @@ func (siw *ServerInterfaceWrapper) ExternalGetUserDetails(w http.ResponseWriter,
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetUserDetails(w, r, userId)
 	}))
@@ -5344,15 +7427,18 @@ func (siw *ServerInterfaceWrapper) ExternalGetUserDetails(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetStatus operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetStatus(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetStatus(w, r)
 	}))
@@ -5361,12 +7447,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetStatus(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalSetStreamTitleOptions operation middleware
 func (siw *ServerInterfaceWrapper) ExternalSetStreamTitleOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalSetStreamTitleOptions(w, r)
@@ -5376,15 +7461,18 @@ func (siw *ServerInterfaceWrapper) ExternalSetStreamTitleOptions(w http.Response
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalSetStreamTitle operation middleware
 func (siw *ServerInterfaceWrapper) ExternalSetStreamTitle(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalSetStreamTitle(w, r)
 	}))
@@ -5393,12 +7481,11 @@ func (siw *ServerInterfaceWrapper) ExternalSetStreamTitle(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ReportPlaybackMetrics operation middleware
 func (siw *ServerInterfaceWrapper) ReportPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ReportPlaybackMetrics(w, r)
@@ -5408,12 +7495,11 @@ func (siw *ServerInterfaceWrapper) ReportPlaybackMetrics(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetUserDetails operation middleware
 func (siw *ServerInterfaceWrapper) GetUserDetails(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5452,12 +7538,45 @@ func (siw *ServerInterfaceWrapper) GetUserDetails(w http.ResponseWriter, r *http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
+}
+
+// UnregisterForLiveNotifications operation middleware
+func (siw *ServerInterfaceWrapper) UnregisterForLiveNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnregisterForLiveNotificationsParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnregisterForLiveNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterForLiveNotifications operation middleware
 func (siw *ServerInterfaceWrapper) RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5487,12 +7606,11 @@ func (siw *ServerInterfaceWrapper) RegisterForLiveNotifications(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // Ping operation middleware
 func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.Ping(w, r)
@@ -5502,12 +7620,11 @@ func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request)
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // RemoteFollow operation middleware
 func (siw *ServerInterfaceWrapper) RemoteFollow(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.RemoteFollow(w, r)
@@ -5517,12 +7634,11 @@ func (siw *ServerInterfaceWrapper) RemoteFollow(w http.ResponseWriter, r *http.R
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetAllSocialPlatforms operation middleware
 func (siw *ServerInterfaceWrapper) GetAllSocialPlatforms(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetAllSocialPlatforms(w, r)
@@ -5532,12 +7648,11 @@ func (siw *ServerInterfaceWrapper) GetAllSocialPlatforms(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetStatus(w, r)
@@ -5547,12 +7662,11 @@ func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Requ
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetVideoStreamOutputVariants operation middleware
 func (siw *ServerInterfaceWrapper) GetVideoStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetVideoStreamOutputVariants(w, r)
@@ -5562,12 +7676,11 @@ func (siw *ServerInterfaceWrapper) GetVideoStreamOutputVariants(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetYPResponse operation middleware
 func (siw *ServerInterfaceWrapper) GetYPResponse(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetYPResponse(w, r)
@@ -5577,7 +7690,7 @@ func (siw *ServerInterfaceWrapper) GetYPResponse(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 type UnescapedCookieParamError struct {
@@ -5711,6 +7824,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/accesstokens/delete", wrapper.DeleteExternalAPIUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/audit", wrapper.GetAdminAuditLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/audit", wrapper.GetAdminAuditLogOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/broadcasts", wrapper.GetBroadcastHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/broadcasts", wrapper.GetBroadcastHistoryOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/chat/clients", wrapper.GetConnectedChatClients)
 	})
@@ -5729,6 +7854,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/chat/messagevisibility", wrapper.UpdateMessageVisibilityAdmin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/nostr/bans", wrapper.GetNostrPubkeyBans)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/nostr/bans", wrapper.GetNostrPubkeyBansOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/nostr/bans/create", wrapper.BanNostrPubkeyOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/nostr/bans/create", wrapper.BanNostrPubkey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/nostr/bans/remove", wrapper.UnbanNostrPubkeyOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/nostr/bans/remove", wrapper.UnbanNostrPubkey)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/chat/users/disabled", wrapper.GetDisabledUsers)
 	})
@@ -5771,6 +7914,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/chat/users/setmoderator", wrapper.UpdateUserModerator)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/config/adminnostrpubkey", wrapper.GetAdminNostrPubkey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/adminnostrpubkey", wrapper.SetAdminNostrPubkeyOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/adminnostrpubkey", wrapper.SetAdminNostrPubkey)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/adminpass", wrapper.SetAdminPasswordOptions)
 	})
@@ -5915,6 +8067,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/name", wrapper.SetServerName)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/nostr/legacyvideoevents", wrapper.SetNostrLegacyVideoEventsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/nostr/legacyvideoevents", wrapper.SetNostrLegacyVideoEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/nostr/lightningaddress", wrapper.SetNostrLightningAddressOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/nostr/lightningaddress", wrapper.SetNostrLightningAddress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/nostr/nip05names", wrapper.SetNostrNIP05NamesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/nostr/nip05names", wrapper.SetNostrNIP05Names)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/nostr/relayenabled", wrapper.SetNostrRelayEnabledOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/nostr/relayenabled", wrapper.SetNostrRelayEnabled)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/nostr/relays", wrapper.SetNostrRelaysOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/nostr/relays", wrapper.SetNostrRelays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/nostr/walletconnect", wrapper.SetNostrWalletConnectOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/nostr/walletconnect", wrapper.SetNostrWalletConnect)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/notifications/browser", wrapper.SetBrowserNotificationConfigurationOptions)
 	})
@@ -5927,6 +8115,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/notifications/discord", wrapper.SetDiscordNotificationConfiguration)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/nostr", wrapper.SetNostrNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/nostr", wrapper.SetNostrNotificationConfiguration)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/nsfw", wrapper.SetNSFWOptions)
 	})
@@ -5945,12 +8139,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/pagecontent", wrapper.SetExtraPageContent)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/publishcallback", wrapper.SetPublishCallbackOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/publishcallback", wrapper.SetPublishCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/reconnectgraceperiod", wrapper.SetReconnectGracePeriodOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/reconnectgraceperiod", wrapper.SetReconnectGracePeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/restream", wrapper.SetRestreamDestinationsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/restream", wrapper.SetRestreamDestinations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/rtmps", wrapper.SetRTMPSConfigOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/rtmps", wrapper.SetRTMPSConfig)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/rtmpserverport", wrapper.SetRTMPServerPortOptions)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/rtmpserverport", wrapper.SetRTMPServerPort)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/rtmptakeover", wrapper.SetRTMPTakeoverOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/rtmptakeover", wrapper.SetRTMPTakeover)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/s3", wrapper.SetS3ConfigurationOptions)
 	})
@@ -5981,6 +8205,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/sockethostoverride", wrapper.SetSocketHostOverride)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/srtserverport", wrapper.SetSRTServerPortOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/srtserverport", wrapper.SetSRTServerPort)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/streamkeys", wrapper.SetStreamKeysOptions)
 	})
@@ -6023,6 +8253,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/videoservingendpoint", wrapper.SetVideoServingEndpoint)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/webrtcport", wrapper.SetWebRTCServerPortOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/webrtcport", wrapper.SetWebRTCServerPort)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/webserverip", wrapper.SetWebServerIPOptions)
 	})
@@ -6113,12 +8349,90 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/logs/warnings", wrapper.GetWarningsOptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/metrics/inbound", wrapper.GetInboundStreamMetrics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/metrics/inbound", wrapper.GetInboundStreamMetricsOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/metrics/video", wrapper.GetVideoPlaybackMetrics)
 	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/metrics/video", wrapper.GetVideoPlaybackMetricsOptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/nostr/access", wrapper.GetAdminAccess)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/access", wrapper.GetAdminAccessOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/nostr/admins", wrapper.GetAdminPubkeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/admins", wrapper.GetAdminPubkeysOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/admins/remove", wrapper.RemoveAdminPubkeyOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/nostr/admins/remove", wrapper.RemoveAdminPubkey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/admins/set", wrapper.SetAdminPubkeyRoleOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/nostr/admins/set", wrapper.SetAdminPubkeyRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/nostr/identity", wrapper.GetNostrIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/identity", wrapper.GetNostrIdentityOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/identity/import", wrapper.ImportNostrIdentityOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/nostr/identity/import", wrapper.ImportNostrIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/identity/rotate", wrapper.RotateNostrIdentityOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/nostr/identity/rotate", wrapper.RotateNostrIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/nostr/recordings", wrapper.GetNostrRecordings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/recordings", wrapper.GetNostrRecordingsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/recordings/delete", wrapper.DeleteNostrRecordingAnnouncementOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/nostr/recordings/delete", wrapper.DeleteNostrRecordingAnnouncement)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/recordings/update", wrapper.UpdateNostrRecordingOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/nostr/recordings/update", wrapper.UpdateNostrRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/nostr/relays", wrapper.GetNostrRelayHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/relays", wrapper.GetNostrRelayHealthOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/nostr/zaps", wrapper.GetNostrZapTotals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/nostr/zaps", wrapper.GetNostrZapTotalsOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/admin/prometheus", wrapper.DeletePrometheusAPI)
 	})
@@ -6212,6 +8526,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/auth/indieauth/callback", wrapper.HandleIndieAuthRedirect)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/nostr", wrapper.RegisterNostrAuthChallenge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/nostr/nip05", wrapper.SetNostrNIP05)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/nostr/verify", wrapper.VerifyNostrAuthChallenge)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/auth/provider/indieauth", wrapper.HandleIndieAuthEndpointGet)
 	})
@@ -6308,6 +8631,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/moderation/chat/user/{userId}", wrapper.GetUserDetails)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/notifications/register", wrapper.UnregisterForLiveNotifications)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/notifications/register", wrapper.RegisterForLiveNotifications)
 	})
@@ -86,3 +86,7 @@ func (*ServerInterfaceImpl) ReportPlaybackMetrics(w http.ResponseWriter, r *http
 func (*ServerInterfaceImpl) RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params generated.RegisterForLiveNotificationsParams) {
 	middleware.RequireUserAccessToken(RegisterForLiveNotifications)(w, r)
 }
+
+func (*ServerInterfaceImpl) UnregisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params generated.UnregisterForLiveNotificationsParams) {
+	middleware.RequireUserAccessToken(RegisterForLiveNotifications)(w, r)
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TekkadanPlays/oni/nostr/zaps"
+	"github.com/TekkadanPlays/oni/webserver/router/middleware"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+	"github.com/go-chi/chi/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+// How long the lightning provider behind the stream's address has to respond.
+const lightningAddressTimeout = 10 * time.Second
+
+// GetLightningAddress returns the LNURL-pay parameters of the stream's
+// lud16 lightning address.
+func GetLightningAddress(w http.ResponseWriter, r *http.Request) {
+	middleware.EnableCors(w)
+
+	if !isStreamLightningAddress(r) {
+		writeLNURLError(w, http.StatusNotFound, "unknown lightning address")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), lightningAddressTimeout)
+	defer cancel()
+
+	params, err := zaps.StreamPayParams(ctx)
+	if err != nil {
+		log.Debugln("Unable to serve the stream lightning address", err)
+		writeLNURLError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, params)
+}
+
+// LightningAddressCallback returns an invoice to pay or zap the stream.
+func LightningAddressCallback(w http.ResponseWriter, r *http.Request) {
+	middleware.EnableCors(w)
+
+	if !isStreamLightningAddress(r) {
+		writeLNURLError(w, http.StatusNotFound, "unknown lightning address")
+		return
+	}
+
+	query := r.URL.Query()
+	amount, err := strconv.ParseInt(query.Get("amount"), 10, 64)
+	if err != nil || amount <= 0 {
+		writeLNURLError(w, http.StatusBadRequest, "amount must be a positive number of millisats")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), lightningAddressTimeout)
+	defer cancel()
+
+	invoice, err := zaps.RequestStreamInvoice(ctx, amount, query.Get("nostr"), query.Get("comment"))
+	if err != nil {
+		writeLNURLError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, zaps.InvoiceResponse{
+		PaymentRequest: invoice,
+		Routes:         []string{},
+	})
+}
+
+func isStreamLightningAddress(r *http.Request) bool {
+	return strings.EqualFold(chi.URLParam(r, "username"), zaps.Username()) && zaps.LightningAddress() != ""
+}
+
+// writeLNURLError writes an error in the format LNURL wallets expect.
+func writeLNURLError(w http.ResponseWriter, status int, reason string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(zaps.ErrorResponse{Status: "ERROR", Reason: reason}); err != nil {
+		log.Errorln(err)
+	}
+}
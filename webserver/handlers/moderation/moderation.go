@@ -27,6 +27,7 @@ func GetUserDetails(w http.ResponseWriter, r *http.Request) {
 
 	type response struct {
 		User             *models.User              `json:"user"`
+		NostrProfile     *models.NostrProfile      `json:"nostrProfile,omitempty"`
 		ConnectedClients []connectedClient         `json:"connectedClients"`
 		Messages         []events.UserMessageEvent `json:"messages"`
 	}
@@ -67,6 +68,7 @@ func GetUserDetails(w http.ResponseWriter, r *http.Request) {
 
 	res := response{
 		User:             u,
+		NostrProfile:     userRepository.GetNostrProfile(uid),
 		ConnectedClients: clients,
 		Messages:         messages,
 	}
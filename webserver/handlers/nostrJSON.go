@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/TekkadanPlays/oni/nostr/nip05"
+	"github.com/TekkadanPlays/oni/webserver/router/middleware"
+	webutils "github.com/TekkadanPlays/oni/webserver/utils"
+)
+
+// GetNostrJSON returns the NIP-05 identifiers served by this server.
+func GetNostrJSON(w http.ResponseWriter, r *http.Request) {
+	// NIP-05 requires the document to be readable by web clients.
+	middleware.EnableCors(w)
+	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	webutils.WriteResponse(w, nip05.Document(r.URL.Query().Get("name")))
+}
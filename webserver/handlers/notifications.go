@@ -5,7 +5,9 @@ import (
 	"net/http"
 
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
 	"github.com/TekkadanPlays/oni/notifications"
+	"github.com/TekkadanPlays/oni/persistence/userrepository"
 
 	"github.com/TekkadanPlays/oni/utils"
 
@@ -14,9 +16,9 @@ import (
 )
 
 // RegisterForLiveNotifications will register a channel + destination to be
-// notified when a stream goes live.
+// notified when a stream goes live, or remove it when sent as a DELETE.
 func RegisterForLiveNotifications(u models.User, w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
+	if r.Method != http.MethodPost && r.Method != http.MethodDelete {
 		webutils.WriteSimpleResponse(w, false, r.Method+" not supported")
 		return
 	}
@@ -37,16 +39,47 @@ func RegisterForLiveNotifications(u models.User, w http.ResponseWriter, r *http.
 	}
 
 	// Make sure the requested channel is one we want to handle.
-	validTypes := []string{notifications.BrowserPushNotification}
+	validTypes := []string{notifications.BrowserPushNotification, notifications.NostrNotification}
 	_, validChannel := utils.FindInSlice(validTypes, req.Channel)
 	if !validChannel {
 		webutils.WriteSimpleResponse(w, false, "invalid notification channel: "+req.Channel)
 		return
 	}
 
+	// Nostr destinations are pubkeys, accepted as npub or hex and stored as hex.
+	if req.Channel == notifications.NostrNotification {
+		pubkey, err := nostr.ParsePublicKey(req.Destination)
+		if err != nil {
+			webutils.WriteSimpleResponse(w, false, "invalid nostr pubkey: "+err.Error())
+			return
+		}
+		req.Destination = pubkey
+
+		// Chat users can only manage notifications for the pubkey they
+		// linked with Nostr auth.
+		if linked := userrepository.Get().GetAuthForUser(u.ID, models.Nostr); linked != pubkey {
+			webutils.WriteSimpleResponse(w, false, "notifications can only be sent to your linked nostr pubkey")
+			return
+		}
+	}
+
+	// Remove any existing registration so a destination is only notified once.
+	if err := notifications.RemoveNotificationForChannel(req.Channel, req.Destination); err != nil {
+		log.Errorln(err)
+		webutils.WriteSimpleResponse(w, false, "unable to remove notification")
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		webutils.WriteSimpleResponse(w, true, "unregistered from notifications")
+		return
+	}
+
 	if err := notifications.AddNotification(req.Channel, req.Destination); err != nil {
 		log.Errorln(err)
 		webutils.WriteSimpleResponse(w, false, "unable to save notification")
 		return
 	}
+
+	webutils.WriteSimpleResponse(w, true, "registered for notifications")
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"sort"
 
+	"github.com/TekkadanPlays/oni/core"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	webutils "github.com/TekkadanPlays/oni/webserver/utils"
 )
@@ -25,6 +26,11 @@ func GetVideoStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
 	configRepository := configrepository.Get()
 	outputVariants := configRepository.GetStreamOutputVariants()
 
+	// A broadcast may use other variants than the configured ones.
+	if broadcast := core.GetCurrentBroadcast(); broadcast != nil {
+		outputVariants = broadcast.OutputSettings
+	}
+
 	streamSortVariants := make([]variantsSort, len(outputVariants))
 	for i, variant := range outputVariants {
 		variantSort := variantsSort{
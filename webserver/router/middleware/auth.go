@@ -1,11 +1,17 @@
 package middleware
 
 import (
+	"context"
 	"crypto/subtle"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/TekkadanPlays/oni/config"
 	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/adminrepository"
 	"github.com/TekkadanPlays/oni/persistence/authrepository"
 	"github.com/TekkadanPlays/oni/persistence/configrepository"
 	"github.com/TekkadanPlays/oni/persistence/userrepository"
@@ -19,9 +25,35 @@ type ExternalAccessTokenHandlerFunc func(models.ExternalAPIUser, http.ResponseWr
 // UserAccessTokenHandlerFunc is a function that is called after validing user access.
 type UserAccessTokenHandlerFunc func(models.User, http.ResponseWriter, *http.Request)
 
-// RequireAdminAuth wraps a handler requiring either HTTP basic auth (stream key)
-// or a Bearer token containing a hex Nostr pubkey matching the configured admin pubkey.
+// nostrAuthVerifier validates NIP-98 signed admin requests and remembers
+// previously used events so they cannot be replayed.
+var nostrAuthVerifier = nostr.NewHTTPAuthVerifier(nostr.DefaultHTTPAuthWindow)
+
+// AdminAccess is who made an authenticated admin API request.
+type AdminAccess struct {
+	// Pubkey is empty for requests made with the admin password.
+	Pubkey string
+	Role   models.AdminRole
+}
+
+type adminAccessContextKey struct{}
+
+// GetAdminAccess will return who made an admin API request.
+func GetAdminAccess(r *http.Request) AdminAccess {
+	access, _ := r.Context().Value(adminAccessContextKey{}).(AdminAccess)
+	return access
+}
+
+// RequireAdminAuth wraps a handler requiring either HTTP basic auth (admin
+// password) or a NIP-98 signed Nostr event from a pubkey with the admin role.
 func RequireAdminAuth(handler http.HandlerFunc) http.HandlerFunc {
+	return RequireAdminRole(models.AdminRoleAdmin, handler)
+}
+
+// RequireAdminRole wraps a handler requiring either HTTP basic auth (admin
+// password), which has the owner role, or a NIP-98 signed Nostr event from
+// a pubkey with at least the given role. Changes are recorded in the audit log.
+func RequireAdminRole(role models.AdminRole, handler http.HandlerFunc) http.HandlerFunc {
 	configRepository := configrepository.Get()
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
@@ -34,29 +66,111 @@ func RequireAdminAuth(handler http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		// Try Bearer token auth first (Nostr pubkey)
-		authHeader := r.Header.Get("Authorization")
-		if strings.HasPrefix(authHeader, "Bearer ") {
-			token := authHeader[len("Bearer "):]
-			adminPubkey := configRepository.GetAdminNostrPubkey()
-			if adminPubkey != "" && len(token) == 64 && subtle.ConstantTimeCompare([]byte(token), []byte(adminPubkey)) == 1 {
-				handler(w, r)
+		var access AdminAccess
+
+		// Try NIP-98 Nostr HTTP auth first.
+		if strings.HasPrefix(r.Header.Get("Authorization"), nostr.HTTPAuthScheme+" ") {
+			pubkey, ok := verifyAdminNostrAuth(r, configRepository)
+			if !ok {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
-		}
 
-		// Fall back to HTTP Basic Auth (stream key as password)
-		username := "admin"
-		password := configRepository.GetAdminPassword()
-		user, pass, ok := r.BasicAuth()
+			access = AdminAccess{Pubkey: pubkey, Role: adminRoleForPubkey(pubkey, configRepository)}
+			if !access.Role.IsValid() {
+				log.Debugln("Nostr admin authentication from unknown pubkey", pubkey)
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			if !access.Role.Allows(role) {
+				log.Debugln("Nostr admin", pubkey, "with role", access.Role, "denied access to", r.URL.Path)
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+		} else {
+			// Fall back to HTTP Basic Auth (stream key as password)
+			username := "admin"
+			password := configRepository.GetAdminPassword()
+			user, pass, ok := r.BasicAuth()
 
-		if !ok || subtle.ConstantTimeCompare([]byte(user), []byte(username)) != 1 || utils.CompareHash(password, pass) != nil {
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			log.Debugln("Failed admin authentication")
-			return
+			if !ok || subtle.ConstantTimeCompare([]byte(user), []byte(username)) != 1 || utils.CompareHash(password, pass) != nil {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				log.Debugln("Failed admin authentication")
+				return
+			}
+
+			access = AdminAccess{Role: models.AdminRoleOwner}
 		}
 
+		serveAudited(access, handler, w, r.WithContext(context.WithValue(r.Context(), adminAccessContextKey{}, access)))
+	}
+}
+
+// adminRoleForPubkey returns the role of the pubkey on the admin API. The
+// pubkey configured as the server's admin is always an owner.
+func adminRoleForPubkey(pubkey string, configRepository configrepository.ConfigRepository) models.AdminRole {
+	if adminPubkey := configRepository.GetAdminNostrPubkey(); adminPubkey != "" && strings.EqualFold(pubkey, adminPubkey) {
+		return models.AdminRoleOwner
+	}
+
+	if admin := adminrepository.Get().GetAdminPubkey(pubkey); admin != nil {
+		return admin.Role
+	}
+
+	return ""
+}
+
+// verifyAdminNostrAuth verifies the NIP-98 event of the request was signed
+// for this exact request and returns the pubkey that signed it.
+func verifyAdminNostrAuth(r *http.Request, configRepository configrepository.ConfigRepository) (string, bool) {
+	// The event must be signed for the URL the admin configured, as the
+	// host a request names can be chosen by whoever sends it. Only servers
+	// without one fall back to the URL of the request.
+	urls := []string{nostr.RequestURL(r)}
+	if serverURL := configRepository.GetServerURL(); serverURL != "" {
+		urls = []string{strings.TrimSuffix(serverURL, "/") + r.URL.RequestURI()}
+	}
+
+	event, err := nostrAuthVerifier.VerifyRequest(r, urls...)
+	if err != nil {
+		log.Debugln("Failed Nostr admin authentication:", err)
+		return "", false
+	}
+
+	return strings.ToLower(event.PubKey), true
+}
+
+// statusRecorder keeps the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// serveAudited runs the handler, recording requests that can make changes
+// in the admin audit log.
+func serveAudited(access AdminAccess, handler http.HandlerFunc, w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
 		handler(w, r)
+		return
+	}
+
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	handler(recorder, r)
+
+	if err := adminrepository.Get().AddAuditEntry(&models.AdminAuditEntry{
+		Timestamp: time.Now(),
+		Pubkey:    access.Pubkey,
+		Role:      access.Role,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Status:    recorder.status,
+	}); err != nil {
+		log.Errorln("error saving admin audit entry", err)
 	}
 }
 
@@ -76,6 +190,21 @@ func RequireExternalAPIAccessToken(scope string, handler ExternalAccessTokenHand
 			return
 		}
 
+		// Pubkeys with a role on the admin API can act as integrations.
+		if strings.HasPrefix(r.Header.Get("Authorization"), nostr.HTTPAuthScheme+" ") {
+			integration, access, ok := nostrIntegrationForRequest(r, scope)
+			if !ok {
+				accessDenied(w)
+				return
+			}
+
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+			serveAudited(access, func(w http.ResponseWriter, r *http.Request) {
+				handler(*integration, w, r)
+			}, w, r)
+			return
+		}
+
 		authHeader := r.Header.Get("Authorization")
 		token := ""
 		if strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
@@ -107,6 +236,52 @@ func RequireExternalAPIAccessToken(scope string, handler ExternalAccessTokenHand
 	})
 }
 
+// integrationScopes are the external API scopes given to each admin role.
+// Admin access is only given to the roles that may use the admin API, the
+// integration routes needing it do the same as their admin counterparts.
+var integrationScopes = map[models.AdminRole][]string{
+	models.AdminRoleIntegration: {models.ScopeCanSendChatMessages, models.ScopeCanSendSystemMessages},
+	models.AdminRoleModerator:   {models.ScopeCanSendChatMessages, models.ScopeCanSendSystemMessages},
+	models.AdminRoleAdmin:       {models.ScopeCanSendChatMessages, models.ScopeCanSendSystemMessages, models.ScopeHasAdminAccess},
+}
+
+// nostrIntegrationForRequest verifies the NIP-98 event of the request and
+// returns the integration for its pubkey if its role grants the scope.
+func nostrIntegrationForRequest(r *http.Request, scope string) (*models.ExternalAPIUser, AdminAccess, bool) {
+	configRepository := configrepository.Get()
+
+	pubkey, ok := verifyAdminNostrAuth(r, configRepository)
+	if !ok {
+		return nil, AdminAccess{}, false
+	}
+
+	access := AdminAccess{Pubkey: pubkey, Role: adminRoleForPubkey(pubkey, configRepository)}
+	scopes := integrationScopes[access.Role]
+	if access.Role.Allows(models.AdminRoleAdmin) {
+		scopes = integrationScopes[models.AdminRoleAdmin]
+	}
+	if !slices.Contains(scopes, scope) {
+		log.Debugln("Nostr integration", pubkey, "with role", access.Role, "denied scope", scope)
+		return nil, access, false
+	}
+
+	name := pubkey
+	if admin := adminrepository.Get().GetAdminPubkey(pubkey); admin != nil && admin.Label != "" {
+		name = admin.Label
+	} else if npub, err := nostr.EncodePublicKey(pubkey); err == nil {
+		name = npub[:12]
+	}
+
+	return &models.ExternalAPIUser{
+		ID:           pubkey,
+		DisplayName:  name,
+		Type:         "API",
+		Scopes:       scopes,
+		DisplayColor: int(pubkey[0]) % (config.MaxUserColor + 1),
+		IsBot:        true,
+	}, access, true
+}
+
 // RequireUserAccessToken will validate a provided user's access token and make sure the associated user is enabled.
 // Not to be used for validating 3rd party access.
 func RequireUserAccessToken(handler UserAccessTokenHandlerFunc) http.HandlerFunc {
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/models"
+	"github.com/TekkadanPlays/oni/nostr"
+	"github.com/TekkadanPlays/oni/persistence/adminrepository"
+	"github.com/TekkadanPlays/oni/persistence/configrepository"
+)
+
+const serverURL = "https://example.com"
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "oni-middleware-test")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := data.SetupPersistence(filepath.Join(dir, "test.db")); err != nil {
+		panic(err)
+	}
+	_ = configrepository.Get().SetServerURL(serverURL)
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// keyWithRole creates a private key and gives its pubkey the role on the
+// admin API.
+func keyWithRole(t *testing.T, role models.AdminRole) string {
+	t.Helper()
+
+	privateKey, err := nostr.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, _ := nostr.GetPublicKey(privateKey)
+	if err := adminrepository.Get().SetAdminPubkey(&models.AdminPubkey{Pubkey: pubkey, Role: role}); err != nil {
+		t.Fatal(err)
+	}
+
+	return privateKey
+}
+
+func TestIntegrationAdminAccessRequiresAdminRole(t *testing.T) {
+	const path = "/api/integrations/streamtitle"
+	body := []byte(`{"value":"new title"}`)
+
+	handler := RequireExternalAPIAccessToken(models.ScopeHasAdminAccess, func(integration models.ExternalAPIUser, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		role   models.AdminRole
+		status int
+	}{
+		{models.AdminRoleIntegration, http.StatusUnauthorized},
+		{models.AdminRoleModerator, http.StatusUnauthorized},
+		{models.AdminRoleAdmin, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.role), func(t *testing.T) {
+			header, err := nostr.CreateHTTPAuthHeader(keyWithRole(t, test.role), http.MethodPost, serverURL+path, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest(http.MethodPost, serverURL+path, bytes.NewReader(body))
+			r.Header.Set("Authorization", header)
+			w := httptest.NewRecorder()
+			handler(w, r)
+
+			if w.Code != test.status {
+				t.Errorf("expected status %d, got %d", test.status, w.Code)
+			}
+		})
+	}
+}
@@ -18,8 +18,9 @@ import (
 	"github.com/TekkadanPlays/oni/config"
 	"github.com/TekkadanPlays/oni/core/chat"
 	"github.com/TekkadanPlays/oni/core/data"
+	"github.com/TekkadanPlays/oni/core/whip"
+	"github.com/TekkadanPlays/oni/nostr/relayserver"
 	"github.com/TekkadanPlays/oni/webserver/handlers"
-	"github.com/TekkadanPlays/oni/webserver/handlers/admin"
 	"github.com/TekkadanPlays/oni/webserver/router/middleware"
 )
 
@@ -39,6 +40,13 @@ func Start(enableVerboseLogging bool) error {
 	// websocket
 	r.HandleFunc("/ws", chat.HandleClientConnection)
 
+	// built-in Nostr relay for the stream
+	r.HandleFunc(relayserver.Path, relayserver.HandleConnection)
+
+	// WHIP broadcasters publish and end their streams here
+	r.HandleFunc(whip.Path, whip.HandleRequest)
+	r.HandleFunc(whip.Path+"/{id}", whip.HandleRequest)
+
 	// serve files
 	fs := http.FileServer(http.Dir(config.PublicFilesPath))
 	r.Handle("/public/*", http.StripPrefix("/public/", fs))
@@ -58,11 +66,6 @@ func Start(enableVerboseLogging bool) error {
 	// The primary web app.
 	r.HandleFunc("/*", handlers.IndexHandler)
 
-	// Admin Nostr pubkey config (manual routes, not in OpenAPI spec)
-	// POST is temporarily unauthenticated for initial bootstrap setup
-	r.Post("/api/admin/config/adminnostrpubkey", admin.SetAdminNostrPubkey)
-	r.Get("/api/admin/config/adminnostrpubkey", middleware.RequireAdminAuth(admin.GetAdminNostrPubkey))
-
 	// mount the api
 	r.Mount("/api/", handlers.New().Handler())
 
@@ -142,6 +145,13 @@ func addStaticFileEndpoints(r chi.Router) {
 	// x-nodeinfo v2
 	r.HandleFunc("/.well-known/x-nodeinfo2", aphandlers.XNodeInfo2Controller)
 
+	// NIP-05 identifiers
+	r.HandleFunc("/.well-known/nostr.json", handlers.GetNostrJSON)
+
+	// Lightning address of the stream for NIP-57 zaps
+	r.HandleFunc("/.well-known/lnurlp/{username}", handlers.GetLightningAddress)
+	r.HandleFunc("/lnurlp/{username}/callback", handlers.LightningAddressCallback)
+
 	// Nodeinfo v2
 	r.HandleFunc("/nodeinfo/2.0", aphandlers.NodeInfoV2Controller)
 